@@ -36,4 +36,22 @@ type ServerConfigurations struct {
 	DbAdapter     string
 //KANAG: is this to be bool 
 	DbSslMode     string
+	ImageLoader   string
+	RegistryAddr  string
+	RegistrySslNotEnabled bool
+	DockerPort    string
+	DockerCaCert  string
+	DockerClientCert string
+	DockerClientKey  string
+	SnapshotClass string
+	SnapshotCacheDir string
+	PackageTrustStore string
+	PackageManifestNotEnforced bool
+	LcmcontrollerAddr string
+	LcmcontrollerSslNotEnabled bool
+	LcmcontrollerCaCert string
+	LcmcontrollerTokenFile string
+	PluginName    string
+	AdvertiseAddr string
+	VimTypes      string
 }
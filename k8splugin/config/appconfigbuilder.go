@@ -37,9 +37,12 @@ import (
 
 // Ak sk and appInsId info
 type AppAuthConfigBuilder struct {
-	AppInsId string
-	Ak       string
-	Sk       string
+	AppInsId   string
+	Ak         string
+	Sk         string
+	Namespace  string
+	PackageId  string
+	Parameters map[string]interface{}
 }
 
 // Constructor to Application configuration
@@ -154,9 +157,25 @@ func (appAuthCfg *AppAuthConfigBuilder) addAppAuthCfgInValuesFile(configPath str
 		log.Error("Failed to unmarshal appAuthConfig")
 		return err
 	}
-	appConfig := appAuthConfig["appconfig"]
+	// Deployment parameters override the chart values, except the app config owned by lcm
+	for key, value := range appAuthCfg.Parameters {
+		if key == util.AppConfig {
+			log.Warn("Ignoring app config in deployment parameters")
+			continue
+		}
+		mergeValue(appAuthConfig, key, value)
+	}
+
+	appConfig := appAuthConfig[util.AppConfig]
 	appConfig1 := appConfig.(map[string]interface{})
 	appConfig1["appnamespace"] = util.Default
+	if appAuthCfg.Namespace != "" {
+		appConfig1["appnamespace"] = appAuthCfg.Namespace
+	}
+	// Package is kept in the release values so that it can be restored on rollback
+	if appAuthCfg.PackageId != "" {
+		appConfig1["apppackageid"] = appAuthCfg.PackageId
+	}
 	akskInfo := appConfig1["aksk"]
 	akskConfig := akskInfo.(map[string]interface{})
 	akskConfig["appInsId"] = appAuthCfg.AppInsId
@@ -178,6 +197,19 @@ func (appAuthCfg *AppAuthConfigBuilder) addAppAuthCfgInValuesFile(configPath str
 	return nil
 }
 
+// Merge value into values, nested maps are merged recursively
+func mergeValue(values map[string]interface{}, key string, value interface{}) {
+	valueMap, isValueMap := value.(map[string]interface{})
+	currentMap, isCurrentMap := values[key].(map[string]interface{})
+	if isValueMap && isCurrentMap {
+		for k, v := range valueMap {
+			mergeValue(currentMap, k, v)
+		}
+		return
+	}
+	values[key] = value
+}
+
 // create a tar file
 func (_ *AppAuthConfigBuilder) createTarFile(source, target string) error {
 	filename := filepath.Base(source)
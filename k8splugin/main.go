@@ -22,6 +22,7 @@ import (
 	_ "k8splugin/config"
 	_ "k8splugin/models"
 	_ "k8splugin/pgdb"
+	"k8splugin/pkg/registration"
 	"k8splugin/pkg/server"
 	"k8splugin/util"
 )
@@ -47,6 +48,14 @@ func main() {
 //KANAG: throw error from below method call, instead of doing force exiting there, also check for retured error
 	grpcServer := server.NewServerGRPC(serverConfig)
 
+	// Register plugin in lcmcontroller
+	registrar, err := registration.NewRegistrar(&config.Server)
+	if err != nil {
+		log.Errorf("Plugin registration is not started")
+	} else if registrar != nil {
+		registrar.Start()
+	}
+
 	// Start listening
 	err = grpcServer.Listen()
 	if err != nil {
@@ -18,19 +18,24 @@ package models
 
 import (
 	"github.com/astaxie/beego/orm"
+	"time"
 )
 
 // Init application info record
 func init() {
 	orm.RegisterModel(new(AppInstanceInfo))
 	orm.RegisterModel(new(AppPackage))
+	orm.RegisterModel(new(ContainerImage))
 }
 
 // Application instance info record
 type AppInstanceInfo struct {
-	AppInsId   string `orm:"pk"`
-	HostIp     string
-	WorkloadId string
+	AppInsId          string `orm:"pk"`
+	HostIp            string
+	WorkloadId        string
+	Namespace         string
+	Parameters        string `orm:"type(text)"`
+	AutoscalingPolicy string `orm:"type(text)"`
 }
 
 // Application package info record
@@ -42,9 +47,104 @@ type AppPackage struct {
 	DockerImages  string
 }
 
+// Container image snapshot record
+type ContainerImage struct {
+	ImageId       string `orm:"pk"`
+	AppInsId      string
+	HostIp        string
+	NodeIp        string
+	PodName       string
+	ContainerName string
+	ImageName     string
+	Status        string
+	Size          int64
+	SnapshotType  string
+	Namespace     string
+	// Names of csi volume snapshots, separated by comma
+	VolumeSnapshots string
+}
+
+// Container image snapshot information, as returned by query vm image
+type ImageInfo struct {
+	ImageId       string `json:"imageId"`
+	ImageName     string `json:"imageName"`
+	AppInstanceId string `json:"appInstanceId"`
+	Status        string `json:"status"`
+	SumChunkNum   int64  `json:"sumChunkNum"`
+	ChunkSize     int64  `json:"chunkSize"`
+	ImageSize     int64  `json:"imageSize"`
+}
+
+// Release revision information
+type ReleaseRevision struct {
+	Revision    int    `json:"revision"`
+	Updated     string `json:"updated"`
+	Status      string `json:"status"`
+	Chart       string `json:"chart"`
+	AppVersion  string `json:"appVersion"`
+	Description string `json:"description"`
+}
+
+// Revision of a release restored by rollback
+type RestoredRevision struct {
+	PackageId string
+	Ak        string
+	Sk        string
+}
+
 // Application Information
 type AppInfo struct {
-	Pods []PodInfo `json:"pods"`
+	Pods      []PodInfo      `json:"pods"`
+	Workloads []WorkloadInfo `json:"workloads,omitempty"`
+	Resources []ResourceInfo `json:"resources,omitempty"`
+}
+
+// Resource of release with its status, namespace is empty for cluster scoped resources
+type ResourceInfo struct {
+	Group     string `json:"group"`
+	Version   string `json:"version"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Message   string `json:"message,omitempty"`
+}
+
+// Health summary of application instance
+type HealthSummary struct {
+	Status  string   `json:"status"`
+	Reasons []string `json:"reasons"`
+}
+
+// Workload Information of deployments and stateful sets
+type WorkloadInfo struct {
+	Kind          string `json:"kind"`
+	Name          string `json:"name"`
+	Replicas      int32  `json:"replicas"`
+	ReadyReplicas int32  `json:"readyReplicas"`
+}
+
+// Autoscaling policy of deployments and stateful sets, utilization targets are percentage of requests
+type AutoscalingPolicy struct {
+	MinReplicas             int32 `json:"minReplicas"`
+	MaxReplicas             int32 `json:"maxReplicas"`
+	TargetCpuUtilization    int32 `json:"targetCpuUtilization,omitempty"`
+	TargetMemoryUtilization int32 `json:"targetMemoryUtilization,omitempty"`
+}
+
+// Deploy options, release which is not ready when waiting times out is uninstalled
+type DeployOptions struct {
+	WaitForReady bool
+	ReadyTimeout time.Duration
+}
+
+// Log options of application pod, since time is nil when all logs are requested
+type LogOptions struct {
+	PodName       string
+	ContainerName string
+	TailLines     int64
+	SinceTime     *time.Time
+	Follow        bool
 }
 
 // Pod Information
@@ -67,23 +167,71 @@ type ContainerStats struct {
 	DiskUsage string `json:"diskusage"`
 }
 
-// Label Selector
-type LabelSelector struct {
-	Label []Label
+// Workload events information, events of pods are also kept as messages for older clients
+type WorkloadEventsInfo struct {
+	Events      []WorkloadEvent `json:"events"`
+	PodDescInfo []PodDescInfo   `json:"pods"`
+}
+
+// Event of application resource
+type WorkloadEvent struct {
+	Kind           string `json:"kind"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Reason         string `json:"reason"`
+	Message        string `json:"message"`
+	Count          int32  `json:"count"`
+	FirstTimestamp string `json:"firstTimestamp"`
+	LastTimestamp  string `json:"lastTimestamp"`
+}
+
+// Workload event filter
+type EventFilter struct {
+	SinceTime *time.Time
+	Type      string
+}
+
+// Endpoints of services and ingresses of application
+type EndpointsInfo struct {
+	Services  []ServiceEndpoint `json:"services"`
+	Ingresses []IngressEndpoint `json:"ingresses"`
+}
+
+// Service endpoint, load balancer addresses are IPs or host names assigned by the load balancer
+type ServiceEndpoint struct {
+	Name                  string        `json:"name"`
+	Namespace             string        `json:"namespace"`
+	Type                  string        `json:"type"`
+	ClusterIP             string        `json:"clusterIp"`
+	ExternalIPs           []string      `json:"externalIps,omitempty"`
+	LoadBalancerAddresses []string      `json:"loadBalancerAddresses,omitempty"`
+	Ports                 []ServicePort `json:"ports"`
+}
+
+// Service port, node port is zero when service is not exposed on nodes
+type ServicePort struct {
+	Name       string `json:"name,omitempty"`
+	Protocol   string `json:"protocol"`
+	Port       int32  `json:"port"`
+	TargetPort string `json:"targetPort"`
+	NodePort   int32  `json:"nodePort,omitempty"`
 }
 
-// Label Info
-type Label struct {
-	Kind     string
-	Selector string
+// Ingress endpoint, addresses are IPs or host names assigned by the ingress controller
+type IngressEndpoint struct {
+	Name      string        `json:"name"`
+	Namespace string        `json:"namespace"`
+	Addresses []string      `json:"addresses,omitempty"`
+	Paths     []IngressPath `json:"paths"`
 }
 
-//KANAG: use better namings: both PodDescribeInfo and PodDescInfo mean the same
-//KANAG: until the code is read completely. one option could be with xxxList vs xxx 
-//KANAG: same applicable above
-// Pod information
-type PodDescribeInfo struct {
-	PodDescInfo []PodDescInfo `json:"pods"`
+// Ingress path, empty host matches all hosts
+type IngressPath struct {
+	Host        string `json:"host,omitempty"`
+	Path        string `json:"path"`
+	Tls         bool   `json:"tls"`
+	ServiceName string `json:"serviceName"`
+	ServicePort string `json:"servicePort"`
 }
 
 // Pod Description Info
@@ -24,4 +24,5 @@ type Database interface {
 	//KANAG: its better to callout the 2nd param used for data id in below methods
 	ReadData(data interface{}, cols ...string) (err error)
 	DeleteData(data interface{}, cols ...string) (err error)
+	QueryTable(tableName string, container interface{}, field string, container1 ...interface{}) (num int64, err error)
 }
@@ -76,6 +76,17 @@ func (db *PgDb) DeleteData(data interface{}, cols ...string) (err error) {
 	return err
 }
 
+// Query all records of table, records are filtered by the field when field is given
+func (db *PgDb) QueryTable(tableName string, container interface{}, field string,
+	container1 ...interface{}) (num int64, err error) {
+	if field != "" {
+		num, err = db.ormer.QueryTable(tableName).Filter(field, container1...).All(container)
+	} else {
+		num, err = db.ormer.QueryTable(tableName).All(container)
+	}
+	return num, err
+}
+
 // Init database
 func (db *PgDb) InitDatabase(dbSslMode string) error {
 	dbUser := util.GetDbUser()
@@ -0,0 +1,183 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	autoscalingv2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
+	"k8splugin/models"
+	"k8splugin/util"
+	"strings"
+)
+
+// Create or update horizontal pod autoscalers of deployments and stateful sets of the release manifest,
+// autoscalers of workloads which are no longer part of the release are deleted
+func ApplyAutoscalers(clientset kubernetes.Interface, releaseManifest string, namespace string, appInsId string,
+	policy *models.AutoscalingPolicy) error {
+	manifest, err := splitManifestYaml([]byte(releaseManifest))
+	if err != nil {
+		return err
+	}
+
+	applied := make(map[string]bool)
+	for _, resource := range manifest {
+		if resource.Kind != util.Deployment && resource.Kind != util.StatefulSet {
+			continue
+		}
+		// Autoscalers are kept in the release namespace
+		if getResourceNamespace(resource, namespace) != namespace {
+			log.Warn("skip autoscaling of " + resource.Kind + " " + resource.Metadata.Name +
+				" outside release namespace")
+			continue
+		}
+
+		autoscaler := getAutoscaler(resource, namespace, appInsId, policy)
+		err = createOrUpdateAutoscaler(clientset, autoscaler)
+		if err != nil {
+			log.Error("failed to apply autoscaler " + autoscaler.Name)
+			return err
+		}
+		applied[autoscaler.Name] = true
+	}
+
+	if len(applied) == 0 {
+		return errors.New("release has no deployment or stateful set to autoscale")
+	}
+	return deleteAutoscalers(clientset, namespace, appInsId, applied)
+}
+
+// Delete horizontal pod autoscalers of the application instance
+func DeleteAutoscalers(clientset kubernetes.Interface, namespace string, appInsId string) error {
+	return deleteAutoscalers(clientset, namespace, appInsId, nil)
+}
+
+// Check metrics server is serving resource metrics, which autoscalers depend on
+func CheckMetricsServer(mc metrics.Interface, namespace string) error {
+	_, err := mc.MetricsV1beta1().PodMetricses(namespace).List(context.Background(),
+		metav1.ListOptions{Limit: 1})
+	if err != nil {
+		log.Error("metrics server is not available")
+		return errors.New(util.MetricsServerNotAvailable)
+	}
+	return nil
+}
+
+// Create autoscaler or update spec of existing autoscaler
+func createOrUpdateAutoscaler(clientset kubernetes.Interface, autoscaler *autoscalingv2.HorizontalPodAutoscaler) error {
+	autoscalers := clientset.AutoscalingV2beta2().HorizontalPodAutoscalers(autoscaler.Namespace)
+	existing, err := autoscalers.Get(context.Background(), autoscaler.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = autoscalers.Create(context.Background(), autoscaler, metav1.CreateOptions{})
+		if err == nil {
+			log.Info("Created autoscaler " + autoscaler.Name)
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	existing.Labels = autoscaler.Labels
+	existing.Spec = autoscaler.Spec
+	_, err = autoscalers.Update(context.Background(), existing, metav1.UpdateOptions{})
+	if err == nil {
+		log.Info("Updated autoscaler " + autoscaler.Name)
+	}
+	return err
+}
+
+// Delete autoscalers of the application instance except the given ones
+func deleteAutoscalers(clientset kubernetes.Interface, namespace string, appInsId string,
+	keep map[string]bool) error {
+	autoscalers := clientset.AutoscalingV2beta2().HorizontalPodAutoscalers(namespace)
+	list, err := autoscalers.List(context.Background(), metav1.ListOptions{
+		LabelSelector: util.AppInstanceLabel + "=" + appInsId,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, autoscaler := range list.Items {
+		if keep[autoscaler.Name] {
+			continue
+		}
+		err = autoscalers.Delete(context.Background(), autoscaler.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			log.Error("failed to delete autoscaler " + autoscaler.Name)
+			return err
+		}
+		log.Info("Deleted autoscaler " + autoscaler.Name)
+	}
+	return nil
+}
+
+// Get autoscaler of the workload for the policy
+func getAutoscaler(resource Manifest, namespace string, appInsId string,
+	policy *models.AutoscalingPolicy) *autoscalingv2.HorizontalPodAutoscaler {
+	minReplicas := policy.MinReplicas
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      strings.ToLower(resource.Kind) + "-" + resource.Metadata.Name,
+			Namespace: namespace,
+			Labels:    map[string]string{util.AppInstanceLabel: appInsId},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       resource.Kind,
+				Name:       resource.Metadata.Name,
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: policy.MaxReplicas,
+			Metrics:     getAutoscalingMetrics(policy),
+		},
+	}
+}
+
+// Get resource utilization metrics of the policy
+func getAutoscalingMetrics(policy *models.AutoscalingPolicy) []autoscalingv2.MetricSpec {
+	metricSpecs := make([]autoscalingv2.MetricSpec, 0, 2)
+	if policy.TargetCpuUtilization > 0 {
+		metricSpecs = append(metricSpecs, getUtilizationMetric(corev1.ResourceCPU, policy.TargetCpuUtilization))
+	}
+	if policy.TargetMemoryUtilization > 0 {
+		metricSpecs = append(metricSpecs, getUtilizationMetric(corev1.ResourceMemory,
+			policy.TargetMemoryUtilization))
+	}
+	return metricSpecs
+}
+
+// Get average utilization metric of the resource
+func getUtilizationMetric(resource corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: resource,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+}
@@ -17,14 +17,28 @@
 package adapter
 
 import (
+	"context"
+	"io"
 	"k8splugin/models"
 	"k8splugin/pgdb"
 )
 
 // Client APIs
 type ClientIntf interface {
-	Deploy(appPkgRecord *models.AppPackage, appInsId string, ak string, sk string, db pgdb.Database) (string, error)
-	UnDeploy(relName string) error
-	Query(relName string) (string, error)
-	WorkloadEvents(relName string) (string, error)
+	Deploy(appPkgRecord *models.AppPackage, appInsId string, ak string, sk string, namespace string,
+		parameters map[string]interface{}, options *models.DeployOptions, db pgdb.Database) (string, error)
+	Upgrade(appPkgRecord *models.AppPackage, relName string, namespace string, appInsId string, ak string,
+		sk string, parameters map[string]interface{}) (string, error)
+	Rollback(relName string, namespace string, revision int) (*models.RestoredRevision, error)
+	History(relName string, namespace string) (string, error)
+	UnDeploy(relName string, namespace string) error
+	Query(relName string, namespace string) (string, error)
+	Health(relName string, namespace string) (models.HealthSummary, error)
+	WorkloadEvents(relName string, namespace string, filter *models.EventFilter) (string, error)
+	Endpoints(relName string, namespace string) (string, error)
+	Scale(relName string, namespace string, replicas int32) error
+	SetAutoscaling(relName string, namespace string, appInsId string, policy *models.AutoscalingPolicy) error
+	DeleteAutoscaling(namespace string, appInsId string) error
+	WorkloadLogs(ctx context.Context, relName string, namespace string, options *models.LogOptions,
+		writer io.Writer) error
 }
@@ -0,0 +1,261 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8splugin/models"
+	"k8splugin/util"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const ingressResource = "ingresses"
+
+// Ingress of networking.k8s.io/v1 or of the earlier beta versions, backend has service of v1 or service name and
+// port of beta versions
+type ingress struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Spec     struct {
+		Backend        *ingressBackend `json:"backend"`
+		DefaultBackend *ingressBackend `json:"defaultBackend"`
+		TLS            []struct {
+			Hosts []string `json:"hosts"`
+		} `json:"tls"`
+		Rules []struct {
+			Host string `json:"host"`
+			HTTP *struct {
+				Paths []struct {
+					Path    string         `json:"path"`
+					Backend ingressBackend `json:"backend"`
+				} `json:"paths"`
+			} `json:"http"`
+		} `json:"rules"`
+	} `json:"spec"`
+	Status struct {
+		LoadBalancer v1.LoadBalancerStatus `json:"loadBalancer"`
+	} `json:"status"`
+}
+
+// Backend of ingress path
+type ingressBackend struct {
+	ServiceName string             `json:"serviceName"`
+	ServicePort intstr.IntOrString `json:"servicePort"`
+	Service     *struct {
+		Name string `json:"name"`
+		Port struct {
+			Name   string `json:"name"`
+			Number int32  `json:"number"`
+		} `json:"port"`
+	} `json:"service"`
+}
+
+// Get endpoints of services and ingresses of the release, resources which are removed from the cluster are skipped.
+// Ingresses are read with the api version of the manifest, which fails when the cluster does not serve it
+func GetEndpoints(clientset kubernetes.Interface, dynamicClient dynamic.Interface, releaseManifest string,
+	namespace string) (models.EndpointsInfo, error) {
+	endpointsInfo := models.EndpointsInfo{Services: []models.ServiceEndpoint{},
+		Ingresses: []models.IngressEndpoint{}}
+
+	manifest, err := splitManifestYaml([]byte(releaseManifest))
+	if err != nil {
+		return endpointsInfo, err
+	}
+
+	for _, resource := range manifest {
+		name := resource.Metadata.Name
+		resourceNamespace := getResourceNamespace(resource, namespace)
+		switch resource.Kind {
+		case util.Service:
+			service, err := clientset.CoreV1().Services(resourceNamespace).Get(context.Background(), name,
+				metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				log.Warn("service " + name + " of release does not exist")
+				continue
+			}
+			if err != nil {
+				return endpointsInfo, err
+			}
+			endpointsInfo.Services = append(endpointsInfo.Services, getServiceEndpoint(service))
+		case util.Ingress:
+			// Not found error of api which is not served would otherwise be taken as removed ingress
+			err = checkApiAvailable(clientset.Discovery(), resource.APIVersion, ingressResource)
+			if err != nil {
+				return endpointsInfo, err
+			}
+			ingress, err := getIngress(dynamicClient, resource.APIVersion, resourceNamespace, name)
+			if apierrors.IsNotFound(err) {
+				log.Warn("ingress " + name + " of release does not exist")
+				continue
+			}
+			if err != nil {
+				return endpointsInfo, err
+			}
+			endpointsInfo.Ingresses = append(endpointsInfo.Ingresses, getIngressEndpoint(ingress))
+		}
+	}
+	return endpointsInfo, nil
+}
+
+// Check whether resource of api version is served by the cluster
+func checkApiAvailable(discoveryClient discovery.DiscoveryInterface, apiVersion string, resource string) error {
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(apiVersion)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		for _, apiResource := range resources.APIResources {
+			if apiResource.Name == resource {
+				return nil
+			}
+		}
+	}
+	return errors.New(resource + " of api version " + apiVersion + " are not available in the cluster")
+}
+
+// Get ingress of the api version
+func getIngress(dynamicClient dynamic.Interface, apiVersion string, namespace string, name string) (*ingress,
+	error) {
+	groupVersion, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	object, err := dynamicClient.Resource(groupVersion.WithResource(ingressResource)).Namespace(namespace).Get(
+		context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	data, err := object.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var ingress ingress
+	err = json.Unmarshal(data, &ingress)
+	if err != nil {
+		return nil, err
+	}
+	return &ingress, nil
+}
+
+// Get endpoint of service with its ports and addresses
+func getServiceEndpoint(service *v1.Service) models.ServiceEndpoint {
+	endpoint := models.ServiceEndpoint{
+		Name:                  service.Name,
+		Namespace:             service.Namespace,
+		Type:                  string(service.Spec.Type),
+		ClusterIP:             service.Spec.ClusterIP,
+		ExternalIPs:           service.Spec.ExternalIPs,
+		LoadBalancerAddresses: getLoadBalancerAddresses(service.Status.LoadBalancer),
+		Ports:                 []models.ServicePort{},
+	}
+	for _, port := range service.Spec.Ports {
+		endpoint.Ports = append(endpoint.Ports, models.ServicePort{
+			Name:       port.Name,
+			Protocol:   string(port.Protocol),
+			Port:       port.Port,
+			TargetPort: port.TargetPort.String(),
+			NodePort:   port.NodePort,
+		})
+	}
+	return endpoint
+}
+
+// Get endpoint of ingress with the paths of its rules
+func getIngressEndpoint(ingress *ingress) models.IngressEndpoint {
+	endpoint := models.IngressEndpoint{
+		Name:      ingress.Metadata.Name,
+		Namespace: ingress.Metadata.Namespace,
+		Addresses: getLoadBalancerAddresses(ingress.Status.LoadBalancer),
+		Paths:     []models.IngressPath{},
+	}
+
+	tlsHosts := make(map[string]bool)
+	for _, tls := range ingress.Spec.TLS {
+		// Certificate without hosts is used for all hosts of the ingress
+		if len(tls.Hosts) == 0 {
+			tlsHosts[""] = true
+		}
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+
+	defaultBackend := ingress.Spec.DefaultBackend
+	if defaultBackend == nil {
+		defaultBackend = ingress.Spec.Backend
+	}
+	if defaultBackend != nil {
+		serviceName, servicePort := defaultBackend.getService()
+		endpoint.Paths = append(endpoint.Paths, models.IngressPath{Path: "/", ServiceName: serviceName,
+			ServicePort: servicePort})
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			serviceName, servicePort := path.Backend.getService()
+			ingressPath := models.IngressPath{
+				Host:        rule.Host,
+				Path:        path.Path,
+				Tls:         tlsHosts[rule.Host],
+				ServiceName: serviceName,
+				ServicePort: servicePort,
+			}
+			if ingressPath.Path == "" {
+				ingressPath.Path = "/"
+			}
+			endpoint.Paths = append(endpoint.Paths, ingressPath)
+		}
+	}
+	return endpoint
+}
+
+// Get service name and port of ingress backend
+func (b *ingressBackend) getService() (string, string) {
+	if b.Service == nil {
+		return b.ServiceName, b.ServicePort.String()
+	}
+	if b.Service.Port.Name != "" {
+		return b.Service.Name, b.Service.Port.Name
+	}
+	return b.Service.Name, strconv.Itoa(int(b.Service.Port.Number))
+}
+
+// Get IPs or host names of load balancer ingress points
+func getLoadBalancerAddresses(status v1.LoadBalancerStatus) []string {
+	var addresses []string
+	for _, ingress := range status.Ingress {
+		if ingress.IP != "" {
+			addresses = append(addresses, ingress.IP)
+		} else if ingress.Hostname != "" {
+			addresses = append(addresses, ingress.Hostname)
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adapter
+
+import (
+	"context"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"k8splugin/models"
+	"k8splugin/util"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Get events of every resource in the inventory of the release and of pods of its workloads with their persistent
+// volume claims, events are sorted by the time they are last seen
+func GetWorkloadEvents(clientset kubernetes.Interface, releaseManifest string, namespace string,
+	filter *models.EventFilter) (models.WorkloadEventsInfo, error) {
+	var eventsInfo models.WorkloadEventsInfo
+
+	inventory, err := decodeManifest(releaseManifest)
+	if err != nil {
+		return eventsInfo, err
+	}
+
+	objects := make(map[string]bool)
+	for _, object := range inventory {
+		objects[object.GetKind()+"/"+object.GetName()] = true
+	}
+
+	eventsInfo.Events = []models.WorkloadEvent{}
+	pods, err := getReleasePods(clientset, inventory, namespace, objects)
+	if err != nil || len(objects) == 0 {
+		return eventsInfo, err
+	}
+
+	// Events are listed once for the namespace, as events of each object would need a request per object
+	events, err := clientset.CoreV1().Events(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return eventsInfo, err
+	}
+
+	podEvents := make(map[string][]string)
+	for _, event := range events.Items {
+		if !objects[event.InvolvedObject.Kind+"/"+event.InvolvedObject.Name] {
+			continue
+		}
+		lastTimestamp := getEventLastTime(&event)
+		if filter != nil && filter.Type != "" && event.Type != filter.Type {
+			continue
+		}
+		if filter != nil && filter.SinceTime != nil && lastTimestamp.Before(*filter.SinceTime) {
+			continue
+		}
+		eventsInfo.Events = append(eventsInfo.Events, models.WorkloadEvent{
+			Kind:           event.InvolvedObject.Kind,
+			Name:           event.InvolvedObject.Name,
+			Type:           event.Type,
+			Reason:         event.Reason,
+			Message:        strings.TrimSpace(event.Message),
+			Count:          event.Count,
+			FirstTimestamp: formatEventTime(event.FirstTimestamp.Time),
+			LastTimestamp:  formatEventTime(lastTimestamp),
+		})
+	}
+	sort.SliceStable(eventsInfo.Events, func(i, j int) bool {
+		return eventsInfo.Events[i].LastTimestamp < eventsInfo.Events[j].LastTimestamp
+	})
+
+	for _, event := range eventsInfo.Events {
+		if event.Kind == util.Pod {
+			podEvents[event.Name] = append(podEvents[event.Name], event.Message)
+		}
+	}
+	for _, pod := range pods {
+		podDescInfo := models.PodDescInfo{PodName: pod.Name, PodEventsInfo: podEvents[pod.Name]}
+		if len(podDescInfo.PodEventsInfo) == 0 {
+			podDescInfo.PodEventsInfo = []string{"Pod is running successfully"}
+		}
+		eventsInfo.PodDescInfo = append(eventsInfo.PodDescInfo, podDescInfo)
+	}
+	return eventsInfo, nil
+}
+
+// Get pods of the release inventory and pods of its workloads, and add them with their persistent volume claims
+// to the objects
+func getReleasePods(clientset kubernetes.Interface, inventory []*unstructured.Unstructured, namespace string,
+	objects map[string]bool) ([]v1.Pod, error) {
+	var pods []v1.Pod
+	for _, object := range inventory {
+		if object.GetKind() != util.Pod {
+			continue
+		}
+		pod, err := clientset.CoreV1().Pods(namespace).Get(context.Background(), object.GetName(),
+			metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, *pod)
+	}
+	for _, selector := range getPodSelectors(inventory) {
+		podList, err := clientset.CoreV1().Pods(namespace).List(context.Background(),
+			metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, podList.Items...)
+	}
+
+	var releasePods []v1.Pod
+	for _, pod := range pods {
+		if containsPod(releasePods, pod.Name) {
+			continue
+		}
+		objects[util.Pod+"/"+pod.Name] = true
+		releasePods = append(releasePods, pod)
+		// Claims of statefulset volume claim templates are not part of the release manifest
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				objects[util.PersistentVolumeClaim+"/"+volume.PersistentVolumeClaim.ClaimName] = true
+			}
+		}
+	}
+	return releasePods, nil
+}
+
+// Get time when event is last seen, events reported by events api may only have the event time
+func getEventLastTime(event *v1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.FirstTimestamp.Time
+}
+
+// Format event time as RFC3339, time which is not set is empty
+func formatEventTime(eventTime time.Time) string {
+	if eventTime.IsZero() {
+		return ""
+	}
+	return eventTime.UTC().Format(time.RFC3339)
+}
+
+// Check pod with the name is in the pods
+func containsPod(pods []v1.Pod, name string) bool {
+	for _, pod := range pods {
+		if pod.Name == name {
+			return true
+		}
+	}
+	return false
+}
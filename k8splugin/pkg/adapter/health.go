@@ -0,0 +1,211 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8splugin/models"
+	"k8splugin/util"
+	"strings"
+	"time"
+)
+
+const (
+	// Containers restarted more times are reported as degraded
+	restartCountThreshold = 5
+	// Warning events seen within the window are reported as degraded
+	warningEventWindow = 10 * time.Minute
+)
+
+// Severity of health status, status of the release is the most severe one found
+var healthSeverity = map[string]int{util.Healthy: 0, util.Progressing: 1, util.Degraded: 2, util.HealthFailed: 3}
+
+// Reasons of container waiting which do not recover without change of the application
+var failingWaitingReasons = map[string]bool{"CrashLoopBackOff": true, "ImagePullBackOff": true,
+	"ErrImagePull": true, "InvalidImageName": true, "CreateContainerConfigError": true}
+
+// Health summary which is updated with each finding
+type healthSummary struct {
+	models.HealthSummary
+}
+
+// Report finding of health check with its reason
+func (h *healthSummary) report(status string, reason string) {
+	if healthSeverity[status] > healthSeverity[h.Status] {
+		h.Status = status
+	}
+	h.Reasons = append(h.Reasons, reason)
+}
+
+// Get health summary of the release from conditions of deployments, stateful sets and daemon sets, readiness and
+// restarts of pods and recent warning events
+func GetHealth(clientset kubernetes.Interface, releaseManifest string, namespace string) (models.HealthSummary,
+	error) {
+	health := &healthSummary{models.HealthSummary{Status: util.Healthy, Reasons: []string{}}}
+
+	manifest, err := splitManifestYaml([]byte(releaseManifest))
+	if err != nil {
+		return health.HealthSummary, err
+	}
+
+	for _, resource := range manifest {
+		err = checkWorkloadHealth(clientset, resource, getResourceNamespace(resource, namespace), health)
+		if err != nil {
+			return health.HealthSummary, err
+		}
+	}
+
+	inventory, err := decodeManifest(releaseManifest)
+	if err != nil {
+		return health.HealthSummary, err
+	}
+	pods, err := getReleasePods(clientset, inventory, namespace, make(map[string]bool))
+	if err != nil {
+		return health.HealthSummary, err
+	}
+	checkPodsHealth(pods, health)
+
+	sinceTime := time.Now().Add(-warningEventWindow)
+	eventsInfo, err := GetWorkloadEvents(clientset, releaseManifest, namespace,
+		&models.EventFilter{SinceTime: &sinceTime, Type: v1.EventTypeWarning})
+	if err != nil {
+		return health.HealthSummary, err
+	}
+	reported := make(map[string]bool)
+	for _, event := range eventsInfo.Events {
+		reason := "warning event " + event.Reason + " of " + strings.ToLower(event.Kind) + " " + event.Name
+		if !reported[reason] {
+			reported[reason] = true
+			health.report(util.Degraded, reason)
+		}
+	}
+	return health.HealthSummary, nil
+}
+
+// Check health of deployment, stateful set or daemon set of the release
+func checkWorkloadHealth(clientset kubernetes.Interface, resource Manifest, namespace string,
+	health *healthSummary) error {
+	name := resource.Metadata.Name
+	workload := strings.ToLower(resource.Kind) + " " + name
+	var err error
+	switch resource.Kind {
+	case util.Deployment:
+		var deployment *appsv1.Deployment
+		deployment, err = clientset.AppsV1().Deployments(namespace).Get(context.Background(), name,
+			metav1.GetOptions{})
+		if err == nil {
+			checkDeploymentHealth(deployment, workload, health)
+		}
+	case util.StatefulSet:
+		var statefulSet *appsv1.StatefulSet
+		statefulSet, err = clientset.AppsV1().StatefulSets(namespace).Get(context.Background(), name,
+			metav1.GetOptions{})
+		if err == nil {
+			replicas := getReplicas(statefulSet.Spec.Replicas)
+			if statefulSet.Status.ReadyReplicas < replicas || (statefulSet.Status.UpdateRevision != "" &&
+				statefulSet.Status.UpdateRevision != statefulSet.Status.CurrentRevision) {
+				health.report(util.Progressing, fmt.Sprintf("%s has %d of %d replicas ready", workload,
+					statefulSet.Status.ReadyReplicas, replicas))
+			}
+		}
+	case util.DaemonSet:
+		var daemonSet *appsv1.DaemonSet
+		daemonSet, err = clientset.AppsV1().DaemonSets(namespace).Get(context.Background(), name,
+			metav1.GetOptions{})
+		if err == nil {
+			desired := daemonSet.Status.DesiredNumberScheduled
+			if daemonSet.Status.NumberReady < desired || daemonSet.Status.UpdatedNumberScheduled < desired {
+				health.report(util.Progressing, fmt.Sprintf("%s has %d of %d pods ready", workload,
+					daemonSet.Status.NumberReady, desired))
+			}
+		}
+	default:
+		return nil
+	}
+
+	if apierrors.IsNotFound(err) {
+		health.report(util.HealthFailed, workload+" does not exist")
+		return nil
+	}
+	return err
+}
+
+// Check health of deployment from its conditions and replicas
+func checkDeploymentHealth(deployment *appsv1.Deployment, workload string, health *healthSummary) {
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+			health.report(util.HealthFailed, workload+" exceeded its progress deadline")
+			return
+		}
+		if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == v1.ConditionTrue {
+			health.report(util.Degraded, workload+" failed to create replicas: "+condition.Message)
+		}
+	}
+
+	replicas := getReplicas(deployment.Spec.Replicas)
+	if deployment.Status.ObservedGeneration < deployment.Generation ||
+		deployment.Status.UpdatedReplicas < replicas || deployment.Status.ReadyReplicas < replicas {
+		health.report(util.Progressing, fmt.Sprintf("%s has %d of %d replicas ready", workload,
+			deployment.Status.ReadyReplicas, replicas))
+	}
+}
+
+// Check readiness and restarts of pods, application without any ready pod and with failing containers is failed
+func checkPodsHealth(pods []v1.Pod, health *healthSummary) {
+	runningPods := 0
+	readyPods := 0
+	failing := false
+	for _, pod := range pods {
+		switch pod.Status.Phase {
+		case v1.PodSucceeded:
+			continue
+		case v1.PodFailed:
+			health.report(util.Degraded, "pod "+pod.Name+" failed "+pod.Status.Reason)
+			continue
+		case v1.PodPending:
+			health.report(util.Progressing, "pod "+pod.Name+" is pending")
+		}
+
+		runningPods++
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == v1.PodReady && condition.Status == v1.ConditionTrue {
+				readyPods++
+			}
+		}
+		for _, container := range pod.Status.ContainerStatuses {
+			if container.State.Waiting != nil && failingWaitingReasons[container.State.Waiting.Reason] {
+				failing = true
+				health.report(util.Degraded, "container "+container.Name+" of pod "+pod.Name+" is in "+
+					container.State.Waiting.Reason)
+			}
+			if container.RestartCount >= restartCountThreshold {
+				health.report(util.Degraded, fmt.Sprintf("container %s of pod %s restarted %d times",
+					container.Name, pod.Name, container.RestartCount))
+			}
+		}
+	}
+
+	if runningPods > 0 && readyPods == 0 && failing {
+		health.report(util.HealthFailed, "no pod of application is ready")
+	}
+}
@@ -22,9 +22,9 @@ import (
 	"errors"
 	"fmt"
 	"github.com/ghodss/yaml"
-	//KANAG: why imported twice ?
-	corev1 "k8s.io/api/core/v1"
+	"io"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
@@ -39,14 +39,15 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/kube"
+	"helm.sh/helm/v3/pkg/release"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/client-go/tools/reference"
-	"k8s.io/kubectl/pkg/scheme"
 )
 
 // Variables to be defined in deployment file
@@ -61,6 +62,9 @@ type HelmClient struct {
 	Kubeconfig string
 }
 
+// Separator of yaml documents in release manifest
+const manifestSeparator = "\n---"
+
 // Manifest file
 type Manifest struct {
 	APIVersion string `yaml:"apiVersion"`
@@ -71,6 +75,7 @@ type Manifest struct {
 		Labels    struct {
 			App       string `yaml:"app"`
 			Component string `yaml:"component"`
+			Release   string `yaml:"mecm-release-name" json:"mecm-release-name"`
 		} `yaml:"labels"`
 	} `yaml:"metadata"`
 	Spec struct {
@@ -83,7 +88,8 @@ type Manifest struct {
 		Template struct {
 			Metadata struct {
 				Labels struct {
-					App string `yaml:"app"`
+					App     string `yaml:"app"`
+					Release string `yaml:"mecm-release-name" json:"mecm-release-name"`
 				} `yaml:"labels"`
 			} `yaml:"metadata"`
 		} `yaml:"template"`
@@ -140,23 +146,28 @@ func (c *HelmClient) getHelmChart(tenantId, hostIp, packageId string) (string, e
 	return artifact, nil
 }
 
-// Install a given helm chart
-func (hc *HelmClient) Deploy(appPkgRecord *models.AppPackage, appInsId, ak, sk string, db pgdb.Database) (string, error) {
-	log.Info("Inside helm client")
-
+// Load helm chart of application package with ak sk values added to the values file
+func (hc *HelmClient) loadChartWithAuthValues(appPkgRecord *models.AppPackage, appInsId, ak, sk, namespace string,
+	parameters map[string]interface{}) (*chart.Chart, error) {
 	helmChart, err := hc.getHelmChart(appPkgRecord.TenantId, appPkgRecord.HostIp, appPkgRecord.PackageId)
+	if err != nil {
+		return nil, err
+	}
 	tarFile, err := os.Open(helmChart)
 	if err != nil {
 		log.Error("Failed to open helm chart tar file")
-		return "", err
+		return nil, err
 	}
 	defer tarFile.Close()
 
 	appAuthCfg := config.NewBuildAppAuthConfig(appInsId, ak, sk)
+	appAuthCfg.Namespace = namespace
+	appAuthCfg.PackageId = appPkgRecord.PackageId
+	appAuthCfg.Parameters = parameters
 	dirName, err := appAuthCfg.AddValues(tarFile)
 	if err != nil {
 		log.Error("Failed to add values in values file")
-		return "", err
+		return nil, err
 	}
 	defer os.Remove(dirName + ".tar.gz")
 	defer  os.RemoveAll(dirName)
@@ -170,11 +181,23 @@ func (hc *HelmClient) Deploy(appPkgRecord *models.AppPackage, appInsId, ak, sk s
 	chart, err := loader.Load(dirName + ".tar.gz")
 	if err != nil {
 		log.Error("Unable to load chart from file")
+		return nil, err
+	}
+	return chart, nil
+}
+
+// Install a given helm chart
+func (hc *HelmClient) Deploy(appPkgRecord *models.AppPackage, appInsId, ak, sk, namespace string,
+	parameters map[string]interface{}, options *models.DeployOptions, db pgdb.Database) (string, error) {
+	log.Info("Inside helm client")
+
+	chart, err := hc.loadChartWithAuthValues(appPkgRecord, appInsId, ak, sk, namespace, parameters)
+	if err != nil {
 		return "", err
 	}
 
-	// Release name will be taken from the name in chart's metadata
-	relName := chart.Metadata.Name
+	// Release name is taken from the name in chart's metadata and the app instance
+	relName := GetReleaseName(chart.Metadata.Name, appInsId)
 
 	appInstanceRecord := &models.AppInstanceInfo{
 		WorkloadId: relName,
@@ -185,25 +208,34 @@ func (hc *HelmClient) Deploy(appPkgRecord *models.AppPackage, appInsId, ak, sk s
 		return "", errors.New("application is already deployed with this release name")
 	}
 
-	// Get release namespace
-	releaseNamespace := util.GetReleaseNamespace()
-
-	// Initialize action config
-	actionConfig := new(action.Configuration)
-	if err := actionConfig.Init(kube.GetConfig(hc.Kubeconfig, "", releaseNamespace), releaseNamespace,
-		util.HelmDriver, func(format string, v ...interface{}) {
-			_ = fmt.Sprintf(format, v)
-		}); err != nil {
-		log.Error(util.ActionConfig)
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
 		return "", err
 	}
 
 	// Prepare chart install action and install chart
 	installer := action.NewInstall(actionConfig)
-	installer.Namespace = releaseNamespace
+	installer.Namespace = namespace
+	installer.CreateNamespace = true
 	installer.ReleaseName = relName
+	installer.PostRenderer = &releaseLabeler{relName: relName}
+	if options != nil && options.WaitForReady {
+		// Atomic install uninstalls the release when workloads are not ready in time
+		installer.Wait = true
+		installer.Atomic = true
+		installer.Timeout = options.ReadyTimeout
+	}
 	rel, err := installer.Run(chart, nil)
 	if err != nil {
+		if installer.Atomic && errors.Is(err, wait.ErrWaitTimeout) {
+			log.Errorf("Workloads of chart are not ready. Err: %s", err)
+			return "", errors.New(util.WorkloadsNotReady)
+		}
+		if isResourceConflict(err) {
+			// Conflict is detected before any resource is created, so there is no release to uninstall
+			log.Errorf("Resources of chart conflict with another release. Err: %s", err)
+			return "", errors.New(util.ResourceNameConflict)
+		}
 		ui := action.NewUninstall(actionConfig)
 		_, uninstallErr := ui.Run(relName)
 		if uninstallErr != nil {
@@ -216,18 +248,134 @@ func (hc *HelmClient) Deploy(appPkgRecord *models.AppPackage, appInsId, ak, sk s
 	return rel.Name, err
 }
 
-// Un-Install a given helm chart
-func (hc *HelmClient) UnDeploy(relName string) error {
-	// Get release namespace
-	releaseNamespace := util.GetReleaseNamespace()
+// Upgrade a given release to the helm chart of application package
+func (hc *HelmClient) Upgrade(appPkgRecord *models.AppPackage, relName, namespace, appInsId, ak, sk string,
+	parameters map[string]interface{}) (string, error) {
+	log.Info("In Upgrade Chart function")
 
-	// Prepare action config and uninstall chart
+	chart, err := hc.loadChartWithAuthValues(appPkgRecord, appInsId, ak, sk, namespace, parameters)
+	if err != nil {
+		return "", err
+	}
+
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
+		return "", err
+	}
+
+	// Prepare chart upgrade action and upgrade release
+	upgrader := action.NewUpgrade(actionConfig)
+	upgrader.Namespace = namespace
+	upgrader.PostRenderer = &releaseLabeler{relName: relName}
+	rel, err := upgrader.Run(relName, chart, nil)
+	if err != nil {
+		log.Errorf("Unable to upgrade chart. Err: %s", err)
+		return "", err
+	}
+	log.Infof("Successfully upgraded chart. Revision: %d", rel.Version)
+	return rel.Name, nil
+}
+
+// Rollback a given release to a revision, zero revision rolls back to the previous one
+func (hc *HelmClient) Rollback(relName, namespace string, revision int) (*models.RestoredRevision, error) {
+	log.Info("In Rollback Chart function")
+
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rb := action.NewRollback(actionConfig)
+	rb.Version = revision
+	err = rb.Run(relName)
+	if err != nil {
+		log.Errorf("Unable to rollback chart. Err: %s", err)
+		return nil, err
+	}
+	log.Info("Successfully rolled back chart")
+
+	// Package and ak sk of the restored revision are returned, so that these can be registered again
+	rel, err := action.NewGet(actionConfig).Run(relName)
+	if err != nil {
+		log.Error("Unable to get rolled back release")
+		return nil, err
+	}
+	return getRestoredRevision(rel), nil
+}
+
+// Get package and ak sk values of the release
+func getRestoredRevision(rel *release.Release) *models.RestoredRevision {
+	restored := &models.RestoredRevision{}
+	if rel.Chart == nil {
+		return restored
+	}
+	appConfig, _ := rel.Chart.Values[util.AppConfig].(map[string]interface{})
+	restored.PackageId, _ = appConfig["apppackageid"].(string)
+	aksk, _ := appConfig["aksk"].(map[string]interface{})
+	restored.Ak, _ = aksk["accesskey"].(string)
+	restored.Sk, _ = aksk["secretkey"].(string)
+	return restored
+}
+
+// Get revision history of a given release
+func (hc *HelmClient) History(relName, namespace string) (string, error) {
+	log.Info("In History Chart function")
+
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
+		return "", err
+	}
+
+	h := action.NewHistory(actionConfig)
+	releases, err := h.Run(relName)
+	if err != nil {
+		log.Error("Unable to get history of chart with release name")
+		return "", err
+	}
+
+	revisions := make([]models.ReleaseRevision, 0, len(releases))
+	for _, rel := range releases {
+		revision := models.ReleaseRevision{
+			Revision: rel.Version,
+		}
+		if rel.Info != nil {
+			revision.Updated = rel.Info.LastDeployed.String()
+			revision.Status = rel.Info.Status.String()
+			revision.Description = rel.Info.Description
+		}
+		if rel.Chart != nil && rel.Chart.Metadata != nil {
+			revision.Chart = rel.Chart.Metadata.Name + "-" + rel.Chart.Metadata.Version
+			revision.AppVersion = rel.Chart.Metadata.AppVersion
+		}
+		revisions = append(revisions, revision)
+	}
+
+	historyJson, err := json.Marshal(revisions)
+	if err != nil {
+		log.Info(util.FailedToJsonMarshal)
+		return "", err
+	}
+	return string(historyJson), nil
+}
+
+// Initialize helm action configuration for the given namespace
+func (hc *HelmClient) getActionConfig(namespace string) (*action.Configuration, error) {
 	actionConfig := new(action.Configuration)
-	if err := actionConfig.Init(kube.GetConfig(hc.Kubeconfig, "", releaseNamespace), releaseNamespace,
+	if err := actionConfig.Init(kube.GetConfig(hc.Kubeconfig, "", namespace), namespace,
 		util.HelmDriver, func(format string, v ...interface{}) {
 			_ = fmt.Sprintf(format, v)
 		}); err != nil {
 		log.Error(util.ActionConfig)
+		return nil, err
+	}
+	return actionConfig, nil
+}
+
+// Un-Install a given helm chart
+func (hc *HelmClient) UnDeploy(relName, namespace string) error {
+	// Prepare action config and uninstall chart
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
 		return err
 	}
 
@@ -242,17 +390,11 @@ func (hc *HelmClient) UnDeploy(relName string) error {
 }
 
 // Query a given chart
-func (hc *HelmClient) Query(relName string) (string, error) {
+func (hc *HelmClient) Query(relName, namespace string) (string, error) {
 	log.Info("In Query Chart function")
 
-	// Get release namespace
-	releaseNamespace := util.GetReleaseNamespace()
-	actionConfig := new(action.Configuration)
-	if err := actionConfig.Init(kube.GetConfig(hc.Kubeconfig, "", releaseNamespace), releaseNamespace,
-		util.HelmDriver, func(format string, v ...interface{}) {
-			_ = fmt.Sprintf(format, v)
-		}); err != nil {
-		log.Error(util.ActionConfig)
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
 		return "", err
 	}
 	s := action.NewStatus(actionConfig)
@@ -261,13 +403,6 @@ func (hc *HelmClient) Query(relName string) (string, error) {
 		log.Error("Unable to query chart with release name")
 		return "", err
 	}
-	manifest, err := splitManifestYaml([]byte(res.Manifest))
-	if err != nil {
-		log.Errorf("Query response processing failed release name: %s. Err: %s",
-			relName, err)
-		return "", err
-	}
-
 	// uses the current context in kubeconfig
 	kubeConfig, err := clientcmd.BuildConfigFromFlags("", hc.Kubeconfig)
 	if err != nil {
@@ -279,14 +414,24 @@ func (hc *HelmClient) Query(relName string) (string, error) {
 		return "", err
 	}
 
-	labelSelector := getLabelSelector(manifest)
+	appInfo, response, err := GetPodStatistics(clientset, kubeConfig, res.Manifest, namespace)
+	if err != nil {
+		log.Errorf("Query response processing failed release name: %s. Err: %s", relName, err)
+		return "", err
+	}
 
-	appInfo, response, err := getResourcesBySelector(labelSelector, clientset, kubeConfig)
+	appInfo.Workloads, err = GetWorkloads(clientset, res.Manifest, namespace)
 	if err != nil {
-		log.Error("Failed to get pod statistics")
+		log.Error("Failed to get workload replicas")
 		return "", err
 	}
 
+	// Pod statistics are responded even when resource inventory can't be built
+	appInfo.Resources, err = getReleaseResources(actionConfig, res.Manifest, namespace)
+	if err != nil {
+		log.Errorf("Failed to get resources of release. Err: %s", err)
+	}
+
 	appInfoJson, err := getJSONResponse(appInfo, response)
 	if err != nil {
 		return "", err
@@ -294,72 +439,219 @@ func (hc *HelmClient) Query(relName string) (string, error) {
 	return appInfoJson, nil
 }
 
-// Get workload description
-func (hc *HelmClient) WorkloadEvents(relName string) (string, error) {
+// Scale deployments and stateful sets of a given release, replicas are reset to chart values on upgrade
+func (hc *HelmClient) Scale(relName, namespace string, replicas int32) error {
+	log.Info("In Scale Chart function")
+
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
+		return err
+	}
+	s := action.NewStatus(actionConfig)
+	res, err := s.Run(relName)
+	if err != nil {
+		log.Error("Unable to query chart with release name")
+		return err
+	}
+
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", hc.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return err
+	}
+
+	err = ScaleWorkloads(clientset, res.Manifest, namespace, replicas)
+	if err != nil {
+		log.Errorf("Unable to scale chart. Err: %s", err)
+		return err
+	}
+	log.Info("Successfully scaled chart")
+	return nil
+}
+
+// Create or update autoscalers of deployments and stateful sets of a given release
+func (hc *HelmClient) SetAutoscaling(relName, namespace, appInsId string, policy *models.AutoscalingPolicy) error {
+	log.Info("In Set Autoscaling function")
+
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
+		return err
+	}
+	s := action.NewStatus(actionConfig)
+	res, err := s.Run(relName)
+	if err != nil {
+		log.Error("Unable to query chart with release name")
+		return err
+	}
+
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", hc.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return err
+	}
+
+	// Autoscalers use the same resource metrics as pod metrics of query
+	mc, err := metrics.NewForConfig(kubeConfig)
+	if err != nil {
+		return err
+	}
+	err = CheckMetricsServer(mc, namespace)
+	if err != nil {
+		return err
+	}
+
+	err = ApplyAutoscalers(clientset, res.Manifest, namespace, appInsId, policy)
+	if err != nil {
+		log.Errorf("Unable to apply autoscalers. Err: %s", err)
+		return err
+	}
+	log.Info("Successfully applied autoscalers")
+	return nil
+}
+
+// Delete autoscalers of a given application instance
+func (hc *HelmClient) DeleteAutoscaling(namespace, appInsId string) error {
+	log.Info("In Delete Autoscaling function")
+
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", hc.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return err
+	}
+
+	err = DeleteAutoscalers(clientset, namespace, appInsId)
+	if err != nil {
+		log.Errorf("Unable to delete autoscalers. Err: %s", err)
+		return err
+	}
+	log.Info("Successfully deleted autoscalers")
+	return nil
+}
+
+// Stream logs of a pod of a given release
+func (hc *HelmClient) WorkloadLogs(ctx context.Context, relName, namespace string, options *models.LogOptions,
+	writer io.Writer) error {
+	log.Info("In Workload logs function")
+
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
+		return err
+	}
+	s := action.NewStatus(actionConfig)
+	res, err := s.Run(relName)
+	if err != nil {
+		log.Error("Unable to query chart with release name")
+		return err
+	}
+
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", hc.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return err
+	}
+
+	// Only pods of the release can be read, namespace may be shared by other applications
+	err = ValidateReleasePod(clientset, res.Manifest, namespace, options.PodName)
+	if err != nil {
+		return err
+	}
+
+	err = StreamPodLogs(ctx, clientset, namespace, options, writer)
+	if err != nil {
+		log.Errorf("Unable to stream pod logs. Err: %s", err)
+		return err
+	}
+	log.Info("Successfully streamed pod logs")
+	return nil
+}
+
+// Get health summary of a given release
+func (hc *HelmClient) Health(relName, namespace string) (models.HealthSummary, error) {
+	log.Info("In Health function")
+
+	clientset, manifest, err := hc.getClientSet(relName, namespace)
+	if err != nil {
+		return models.HealthSummary{}, err
+	}
+
+	health, err := GetHealth(clientset, manifest, namespace)
+	if err != nil {
+		log.Errorf("Unable to get health of release. Err: %s", err)
+		return models.HealthSummary{}, err
+	}
+	log.Info("Health of release is " + health.Status)
+	return health, nil
+}
+
+// Get workload events
+func (hc *HelmClient) WorkloadEvents(relName, namespace string, filter *models.EventFilter) (string, error) {
 	log.Info("In Workload describe function")
-	var podDesc models.PodDescribeInfo
 
-	clientset, manifest, err := hc.getClientSet(relName)
+	clientset, manifest, err := hc.getClientSet(relName, namespace)
 	if nil != err {
 		return "", err
 	}
 
-	labelSelector := getLabelSelector(manifest)
-
-	for _, label := range labelSelector.Label {
-		if label.Kind == util.Pod || label.Kind == util.Deployment {
-			podDesc, err = updatePodDescInfo(podDesc, clientset, label)
-			if err != nil {
-				return "", err
-			}
-		}
+	eventsInfo, err := GetWorkloadEvents(clientset, manifest, namespace, filter)
+	if err != nil {
+		log.Errorf("Unable to get workload events. Err: %s", err)
+		return "", err
 	}
-	podDescInfoJson, err := json.Marshal(podDesc)
+	eventsInfoJson, err := json.Marshal(eventsInfo)
 	if err != nil {
 		log.Info(util.FailedToJsonMarshal)
 		return "", err
 	}
-	return string(podDescInfoJson), nil
+	return string(eventsInfoJson), nil
 }
 
-//KANAG: All kubernetes related util functions cound be made under separate type and its
-//KANAG: member var could be with clientset
-func updatePodDescInfo(podDesc models.PodDescribeInfo, clientset *kubernetes.Clientset,
-	label models.Label) (models.PodDescribeInfo, error) {
-	options := metav1.ListOptions{
-		LabelSelector: label.Selector,
+// Get endpoints of services and ingresses of a given release
+func (hc *HelmClient) Endpoints(relName, namespace string) (string, error) {
+	log.Info("In Endpoints function")
+
+	clientset, manifest, err := hc.getClientSet(relName, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", hc.Kubeconfig)
+	if err != nil {
+		return "", err
 	}
-	pods, err := clientset.CoreV1().Pods(util.Default).List(context.Background(), options)
+	dynamicClient, err := dynamic.NewForConfig(kubeConfig)
 	if err != nil {
-		return podDesc, err
+		return "", err
 	}
-	for _, podItem := range pods.Items {
-		podName := podItem.GetObjectMeta().GetName()
-		pod, err := clientset.CoreV1().Pods(util.Default).Get(context.TODO(), podName, metav1.GetOptions{})
-		if err != nil {
-			return podDesc, err
-		}
 
-		if ref, err := reference.GetReference(scheme.Scheme, pod); err != nil {
-			log.Errorf("Unable to construct reference to '%#v': %v", pod, err)
-			return podDesc, err
-		} else {
-			podDescInfo := getPodDescInfo(ref, pod, clientset, podName)
-			podDesc.PodDescInfo = append(podDesc.PodDescInfo, podDescInfo)
-		}
+	endpointsInfo, err := GetEndpoints(clientset, dynamicClient, manifest, namespace)
+	if err != nil {
+		log.Errorf("Unable to get endpoints of release. Err: %s", err)
+		return "", err
+	}
+	endpointsInfoJson, err := json.Marshal(endpointsInfo)
+	if err != nil {
+		log.Info(util.FailedToJsonMarshal)
+		return "", err
 	}
-	return podDesc, nil
+	return string(endpointsInfoJson), nil
 }
 
-func (hc *HelmClient) getClientSet(relName string) (clientset *kubernetes.Clientset, manifest []Manifest, err error) {
-	// Get release namespace
-	releaseNamespace := util.GetReleaseNamespace()
-	actionConfig := new(action.Configuration)
-	if err = actionConfig.Init(kube.GetConfig(hc.Kubeconfig, "", releaseNamespace), releaseNamespace,
-		util.HelmDriver, func(format string, v ...interface{}) {
-			_ = fmt.Sprintf(format, v)
-		}); err != nil {
-		log.Error(util.ActionConfig)
+func (hc *HelmClient) getClientSet(relName, namespace string) (clientset *kubernetes.Clientset, manifest string,
+	err error) {
+	actionConfig, err := hc.getActionConfig(namespace)
+	if err != nil {
 		return clientset, manifest, err
 	}
 	s := action.NewStatus(actionConfig)
@@ -368,12 +660,7 @@ func (hc *HelmClient) getClientSet(relName string) (clientset *kubernetes.Client
 		log.Error("Unable to query chart with release name")
 		return clientset, manifest, err
 	}
-	manifest, err = splitManifestYaml([]byte(res.Manifest))
-	if err != nil {
-		log.Errorf("Query response processing failed release name: %s. Err: %s",
-			relName, err)
-		return clientset, manifest, err
-	}
+	manifest = res.Manifest
 
 	// uses the current context in kubeconfig
 	kubeConfig, err := clientcmd.BuildConfigFromFlags("", hc.Kubeconfig)
@@ -388,49 +675,35 @@ func (hc *HelmClient) getClientSet(relName string) (clientset *kubernetes.Client
 	return clientset, manifest, nil
 }
 
-func getPodDescInfo(ref *v1.ObjectReference, pod *v1.Pod, clientset *kubernetes.Clientset,
-	podName string) (podDescInfo models.PodDescInfo) {
-	ref.Kind = ""
-	if _, isMirrorPod := pod.Annotations[corev1.MirrorPodAnnotationKey]; isMirrorPod {
-		ref.UID = types.UID(pod.Annotations[corev1.MirrorPodAnnotationKey])
-	}
-	events, _ := clientset.CoreV1().Events(util.Default).Search(scheme.Scheme, ref)
-	podDescInfo.PodName = podName
-	if len(events.Items) == 0 {
-		podDescInfo.PodEventsInfo = append(podDescInfo.PodEventsInfo,
-			"Pod is running successfully")
+// Get resources of release with dynamic client and rest mapper of the cluster
+func getReleaseResources(actionConfig *action.Configuration, releaseManifest string,
+	namespace string) ([]models.ResourceInfo, error) {
+	restConfig, err := actionConfig.RESTClientGetter.ToRESTConfig()
+	if err != nil {
+		return nil, err
 	}
-	for _, e := range events.Items {
-		podDescInfo.PodEventsInfo = append(podDescInfo.PodEventsInfo, strings.TrimSpace(e.Message))
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
 	}
-	return podDescInfo
-}
-
-// Get label selector
-func getLabelSelector(manifest []Manifest) models.LabelSelector {
-	var labelSelector models.LabelSelector
-	var label models.Label
-
-	for i := 0; i < len(manifest); i++ {
-		if manifest[i].Kind == util.Deployment || manifest[i].Kind == util.Pod || manifest[i].Kind == "Service" {
-			appName := manifest[i].Metadata.Name
-			if manifest[i].Metadata.Labels.App != "" {
-				appName = manifest[i].Metadata.Labels.App
-			}
-			pod := "app=" + appName
-			label.Kind = manifest[i].Kind
-			label.Selector = pod
-			labelSelector.Label = append(labelSelector.Label, label)
-		}
+	mapper, err := actionConfig.RESTClientGetter.ToRESTMapper()
+	if err != nil {
+		return nil, err
 	}
-
-	return labelSelector
+	return GetResources(dynamicClient, mapper, releaseManifest, namespace)
 }
 
-// get JSON response
+// get JSON response, resources are kept in response of application which is not running
 func getJSONResponse(appInfo models.AppInfo, response map[string]string) (string, error) {
 	if response != nil {
-		appInfoJson, err := json.Marshal(response)
+		notRunning := make(map[string]interface{})
+		for key, value := range response {
+			notRunning[key] = value
+		}
+		if len(appInfo.Resources) != 0 {
+			notRunning["resources"] = appInfo.Resources
+		}
+		appInfoJson, err := json.Marshal(notRunning)
 		if err != nil {
 			log.Info(util.FailedToJsonMarshal)
 			return "", err
@@ -447,43 +720,59 @@ func getJSONResponse(appInfo models.AppInfo, response map[string]string) (string
 	return string(appInfoJson), nil
 }
 
-// Get resources by selector
-func getResourcesBySelector(labelSelector models.LabelSelector, clientset *kubernetes.Clientset,
-	config *rest.Config) (appInfo models.AppInfo, response map[string]string, err error) {
-
-	for _, label := range labelSelector.Label {
-		if label.Kind == util.Pod || label.Kind == util.Deployment {
-			options := metav1.ListOptions{
-				LabelSelector: label.Selector,
-			}
-
-			pods, err := clientset.CoreV1().Pods(util.Default).List(context.Background(), options)
-			if err != nil {
-				return appInfo, nil, err
-			}
-			if len(pods.Items) == 0 {
-				response := map[string]string{"status": "not running"}
-				return appInfo, response, nil
-			}
-
-			podInfo, err := getPodInfo(pods, clientset, config)
-			if err != nil {
-				return appInfo, nil, err
-			}
-
-			appInfo.Pods = append(appInfo.Pods, podInfo)
+// Get statistics of pods of the release, pods of the release are selected by name and pods of its workloads by
+// labels of their pod templates. Release whose pods are not created yet is reported as not running
+func GetPodStatistics(clientset kubernetes.Interface, config *rest.Config, releaseManifest string,
+	namespace string) (appInfo models.AppInfo, response map[string]string, err error) {
+	inventory, err := decodeManifest(releaseManifest)
+	if err != nil {
+		return appInfo, nil, err
+	}
+
+	var podLists []*v1.PodList
+	for _, object := range inventory {
+		if object.GetKind() != util.Pod {
+			continue
 		}
+		pod, err := clientset.CoreV1().Pods(namespace).Get(context.Background(), object.GetName(),
+			metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return appInfo, map[string]string{"status": "not running"}, nil
+		}
+		if err != nil {
+			return appInfo, nil, err
+		}
+		podLists = append(podLists, &v1.PodList{Items: []v1.Pod{*pod}})
+	}
+	for _, selector := range getPodSelectors(inventory) {
+		pods, err := clientset.CoreV1().Pods(namespace).List(context.Background(),
+			metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return appInfo, nil, err
+		}
+		if len(pods.Items) == 0 {
+			return appInfo, map[string]string{"status": "not running"}, nil
+		}
+		podLists = append(podLists, pods)
 	}
 
+	for _, pods := range podLists {
+		podInfo, err := getPodInfo(pods, clientset, config, namespace)
+		if err != nil {
+			return appInfo, nil, err
+		}
+		appInfo.Pods = append(appInfo.Pods, podInfo)
+	}
 	return appInfo, nil, nil
 }
 
 // Get pod information
-func getPodInfo(pods *v1.PodList, clientset *kubernetes.Clientset, config *rest.Config) (podInfo models.PodInfo, err error) {
+func getPodInfo(pods *v1.PodList, clientset kubernetes.Interface, config *rest.Config,
+	namespace string) (podInfo models.PodInfo, err error) {
 	var containerInfo models.ContainerInfo
 	for _, pod := range pods.Items {
 		podName := pod.GetObjectMeta().GetName()
-		podMetrics, err := getPodMetrics(config, podName)
+		podMetrics, err := getPodMetrics(config, podName, namespace)
 		if err != nil {
 			podInfo.PodName = podName
 			podInfo.PodStatus = string(pod.Status.Phase)
@@ -509,7 +798,7 @@ func getPodInfo(pods *v1.PodList, clientset *kubernetes.Clientset, config *rest.
 }
 
 // Update container information
-func updateContainerInfo(podMetrics *v1beta1.PodMetrics, clientset *kubernetes.Clientset, podInfo models.PodInfo) (models.PodInfo, error) {
+func updateContainerInfo(podMetrics *v1beta1.PodMetrics, clientset kubernetes.Interface, podInfo models.PodInfo) (models.PodInfo, error) {
 	var containerInfo models.ContainerInfo
 	totalCpuUsage, totalMemUsage, totalDiskUsage, err := getTotalCpuDiskMemory(clientset)
 	if err != nil {
@@ -534,13 +823,13 @@ func updateContainerInfo(podMetrics *v1beta1.PodMetrics, clientset *kubernetes.C
 }
 
 // Get Pod metrics
-func getPodMetrics(config *rest.Config, podName string) (podMetrics *v1beta1.PodMetrics, err error) {
+func getPodMetrics(config *rest.Config, podName, namespace string) (podMetrics *v1beta1.PodMetrics, err error) {
 	mc, err := metrics.NewForConfig(config)
 	if err != nil {
 		return podMetrics, err
 	}
 
-	podMetrics, err = mc.MetricsV1beta1().PodMetricses(metav1.NamespaceDefault).Get(context.Background(),
+	podMetrics, err = mc.MetricsV1beta1().PodMetricses(namespace).Get(context.Background(),
 		podName, metav1.GetOptions{})
 	if err != nil {
 		return podMetrics, err
@@ -549,7 +838,7 @@ func getPodMetrics(config *rest.Config, podName string) (podMetrics *v1beta1.Pod
 }
 
 // Get total cpu disk and memory metrics
-func getTotalCpuDiskMemory(clientset *kubernetes.Clientset) (string, string, string, error) {
+func getTotalCpuDiskMemory(clientset kubernetes.Interface) (string, string, string, error) {
 	//these metrics are better to be in numeric with Int
 	var totalDiskUsage string
 	var totalMemUsage string
@@ -580,10 +869,9 @@ func splitManifestYaml(data []byte) (manifest []Manifest, err error) {
 	manifestBuf := []Manifest{}
 
   //KANAG: double check if this works perfectly across windows and linux
-	yamlSeparator := "\n---"
 	yamlString := string(data)
 
-	yamls := strings.Split(yamlString, yamlSeparator)
+	yamls := strings.Split(yamlString, manifestSeparator)
 	for k := 0; k < len(yamls); k++ {
 		var manifest Manifest
 		err := yaml.Unmarshal([]byte(yamls[k]), &manifest)
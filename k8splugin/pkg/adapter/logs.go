@@ -0,0 +1,90 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"io"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8splugin/models"
+	"k8splugin/util"
+)
+
+// Check pod belongs to the release, pods of workloads in the release inventory are matched by the labels of their
+// pod templates
+func ValidateReleasePod(clientset kubernetes.Interface, releaseManifest string, namespace string,
+	podName string) error {
+	inventory, err := decodeManifest(releaseManifest)
+	if err != nil {
+		return err
+	}
+
+	for _, object := range inventory {
+		if object.GetKind() == util.Pod && object.GetName() == podName {
+			return nil
+		}
+	}
+
+	for _, selector := range getPodSelectors(inventory) {
+		pods, err := clientset.CoreV1().Pods(namespace).List(context.Background(),
+			metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return err
+		}
+		for _, pod := range pods.Items {
+			if pod.Name == podName {
+				return nil
+			}
+		}
+	}
+	return errors.New(util.PodDoesNotExist)
+}
+
+// Stream logs of pod container to the writer until the log ends, followed logs end when context is done
+func StreamPodLogs(ctx context.Context, clientset kubernetes.Interface, namespace string,
+	options *models.LogOptions, writer io.Writer) error {
+	logOptions := &v1.PodLogOptions{
+		Container: options.ContainerName,
+		Follow:    options.Follow,
+	}
+	if options.TailLines > 0 {
+		tailLines := options.TailLines
+		logOptions.TailLines = &tailLines
+	}
+	if options.SinceTime != nil {
+		sinceTime := metav1.NewTime(*options.SinceTime)
+		logOptions.SinceTime = &sinceTime
+	}
+
+	logs, err := clientset.CoreV1().Pods(namespace).GetLogs(options.PodName, logOptions).Stream(ctx)
+	if err != nil {
+		log.Error("failed to open logs of pod " + options.PodName)
+		return err
+	}
+	defer logs.Close()
+
+	_, err = io.Copy(writer, logs)
+	if err != nil && ctx.Err() != nil {
+		// Client stopped following the logs
+		return nil
+	}
+	return err
+}
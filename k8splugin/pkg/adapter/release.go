@@ -0,0 +1,106 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adapter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/ghodss/yaml"
+	"k8splugin/util"
+	"strings"
+)
+
+// Kinds of workloads whose pod template is labeled with the release name. Pod template of jobs is immutable,
+// so jobs are not labeled as upgrade of releases installed before labeling would fail
+var podTemplateKinds = map[string]bool{util.Deployment: true, util.StatefulSet: true, util.DaemonSet: true,
+	"ReplicaSet": true}
+
+// Error of helm when a rendered resource already exists and is not owned by the release
+const resourceConflictError = "rendered manifests contain a resource that already exists"
+
+// Get release name of application instance, chart name is suffixed with a short hash of the instance id so that
+// several instances of a package can be deployed on the same cluster. Resources of charts which do not name
+// them after the release still collide in the tenant namespace, such instances are rejected on install
+func GetReleaseName(chartName string, appInsId string) string {
+	hash := sha256.Sum256([]byte(appInsId))
+	suffix := "-" + hex.EncodeToString(hash[:])[:util.ReleaseHashLength]
+
+	name := strings.ToLower(chartName)
+	if maxLength := util.MaxReleaseNameLength - len(suffix); len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+	return name + suffix
+}
+
+// Post renderer which labels pods and pod templates with the release name, so that pods of sibling instances
+// with same app label are not selected
+type releaseLabeler struct {
+	relName string
+}
+
+// Add release label to rendered manifests
+func (r *releaseLabeler) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	modifiedManifests := bytes.NewBuffer(nil)
+	for _, document := range strings.Split(renderedManifests.String(), "\n---") {
+		var resource map[string]interface{}
+		err := yaml.Unmarshal([]byte(document), &resource)
+		if err != nil {
+			return nil, err
+		}
+		if len(resource) == 0 {
+			continue
+		}
+
+		kind, _ := resource["kind"].(string)
+		if kind == util.Pod {
+			setLabel(resource, util.ReleaseLabel, r.relName)
+		} else if spec, ok := resource["spec"].(map[string]interface{}); ok && podTemplateKinds[kind] {
+			if template, ok := spec["template"].(map[string]interface{}); ok {
+				setLabel(template, util.ReleaseLabel, r.relName)
+			}
+		}
+
+		data, err := yaml.Marshal(resource)
+		if err != nil {
+			return nil, err
+		}
+		modifiedManifests.WriteString("---\n")
+		modifiedManifests.Write(data)
+	}
+	return modifiedManifests, nil
+}
+
+// Check whether install failed as a rendered resource is owned by another release
+func isResourceConflict(err error) bool {
+	return strings.Contains(err.Error(), resourceConflictError)
+}
+
+// Set label in metadata of the object
+func setLabel(object map[string]interface{}, key string, value string) {
+	metadata, ok := object["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = make(map[string]interface{})
+		object["metadata"] = metadata
+	}
+	labels, ok := metadata["labels"].(map[string]interface{})
+	if !ok {
+		labels = make(map[string]interface{})
+		metadata["labels"] = labels
+	}
+	labels[key] = value
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ghodss/yaml"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8splugin/models"
+	"k8splugin/util"
+	"strings"
+)
+
+// Get inventory of every resource of the release with its status, resources are decoded as unstructured objects
+// so that kinds which are not known to the plugin such as custom resources are also listed
+func GetResources(dynamicClient dynamic.Interface, mapper meta.RESTMapper, releaseManifest string,
+	namespace string) ([]models.ResourceInfo, error) {
+	objects, err := decodeManifest(releaseManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []models.ResourceInfo{}
+	for _, object := range objects {
+		gvk := object.GroupVersionKind()
+		resource := models.ResourceInfo{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind,
+			Name: object.GetName()}
+
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			// Kind is not served by the cluster, e.g. custom resource whose definition is removed
+			resource.Status = util.ResourceUnknown
+			resource.Message = "kind is not served by the cluster"
+			resources = append(resources, resource)
+			continue
+		}
+
+		var resourceClient dynamic.ResourceInterface = dynamicClient.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			resource.Namespace = object.GetNamespace()
+			if resource.Namespace == "" {
+				resource.Namespace = namespace
+			}
+			resourceClient = dynamicClient.Resource(mapping.Resource).Namespace(resource.Namespace)
+		}
+
+		live, err := resourceClient.Get(context.Background(), resource.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			resource.Status = util.ResourceMissing
+			resources = append(resources, resource)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		resource.Status, resource.Message = getResourceStatus(live)
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// Decode documents of release manifest to unstructured objects, items of lists are decoded as separate objects
+func decodeManifest(releaseManifest string) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	for _, document := range strings.Split(releaseManifest, manifestSeparator) {
+		data, err := yaml.YAMLToJSON([]byte(document))
+		if err != nil {
+			return nil, err
+		}
+		content := make(map[string]interface{})
+		err = json.Unmarshal(data, &content)
+		if err != nil {
+			return nil, err
+		}
+		// Documents with only comments are rendered from templates whose resources are disabled
+		if content["kind"] == nil {
+			continue
+		}
+
+		object := &unstructured.Unstructured{Object: content}
+		if !object.IsList() {
+			objects = append(objects, object)
+			continue
+		}
+		err = object.EachListItem(func(item runtime.Object) error {
+			listItem, ok := item.(*unstructured.Unstructured)
+			if ok {
+				objects = append(objects, listItem)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+// Get status of resource from replicas of workloads, conditions of jobs, phase or ready condition, resources
+// without status are reported as existing
+func getResourceStatus(object *unstructured.Unstructured) (string, string) {
+	switch object.GetKind() {
+	case util.Deployment, util.StatefulSet, "ReplicaSet":
+		replicas, found, _ := unstructured.NestedInt64(object.Object, "spec", "replicas")
+		if !found {
+			replicas = 1
+		}
+		readyReplicas, _, _ := unstructured.NestedInt64(object.Object, "status", "readyReplicas")
+		return getReplicaStatus(readyReplicas, replicas, "replicas")
+	case util.DaemonSet:
+		desired, _, _ := unstructured.NestedInt64(object.Object, "status", "desiredNumberScheduled")
+		ready, _, _ := unstructured.NestedInt64(object.Object, "status", "numberReady")
+		return getReplicaStatus(ready, desired, "pods")
+	case "Job":
+		if condition := getCondition(object, "Failed"); condition != nil && condition["status"] == "True" {
+			message, _ := condition["message"].(string)
+			return util.ResourceFailed, message
+		}
+		if condition := getCondition(object, "Complete"); condition != nil && condition["status"] == "True" {
+			return util.ResourceComplete, ""
+		}
+		return util.ResourceNotReady, "job is running"
+	}
+
+	phase, found, _ := unstructured.NestedString(object.Object, "status", "phase")
+	if found && phase != "" {
+		return phase, ""
+	}
+	for _, conditionType := range []string{"Ready", "Available"} {
+		condition := getCondition(object, conditionType)
+		if condition == nil {
+			continue
+		}
+		if condition["status"] == "True" {
+			return util.ResourceReady, ""
+		}
+		message, _ := condition["message"].(string)
+		return util.ResourceNotReady, message
+	}
+	return util.ResourceExists, ""
+}
+
+// Get status of workload from its ready replicas
+func getReplicaStatus(ready int64, desired int64, unit string) (string, string) {
+	message := fmt.Sprintf("%d of %d %s ready", ready, desired, unit)
+	if ready < desired {
+		return util.ResourceNotReady, message
+	}
+	return util.ResourceReady, message
+}
+
+// Get status condition of resource with the given type
+func getCondition(object *unstructured.Unstructured, conditionType string) map[string]interface{} {
+	conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if ok && condition["type"] == conditionType {
+			return condition
+		}
+	}
+	return nil
+}
+
+// Get label selectors of pods created by workloads of the release from the labels of their pod templates,
+// pods of the release are selected by name instead
+func getPodSelectors(objects []*unstructured.Unstructured) []string {
+	var selectors []string
+	seen := make(map[string]bool)
+	for _, object := range objects {
+		var templateLabels map[string]string
+		switch object.GetKind() {
+		case util.Pod:
+			continue
+		case "CronJob":
+			templateLabels, _, _ = unstructured.NestedStringMap(object.Object, "spec", "jobTemplate", "spec",
+				"template", "metadata", "labels")
+		default:
+			templateLabels, _, _ = unstructured.NestedStringMap(object.Object, "spec", "template", "metadata",
+				"labels")
+		}
+		// Empty selector would select every pod of the namespace
+		if len(templateLabels) == 0 {
+			continue
+		}
+		selector := labels.SelectorFromSet(templateLabels).String()
+		if !seen[selector] {
+			seen[selector] = true
+			selectors = append(selectors, selector)
+		}
+	}
+	return selectors
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8splugin/models"
+	"k8splugin/util"
+	"strconv"
+)
+
+// Scale deployments and stateful sets of the release manifest to the given replicas
+func ScaleWorkloads(clientset kubernetes.Interface, releaseManifest string, namespace string, replicas int32) error {
+	manifest, err := splitManifestYaml([]byte(releaseManifest))
+	if err != nil {
+		return err
+	}
+
+	patch := []byte(`{"spec":{"replicas":` + strconv.Itoa(int(replicas)) + `}}`)
+	scaled := 0
+	for _, resource := range manifest {
+		name := resource.Metadata.Name
+		resourceNamespace := getResourceNamespace(resource, namespace)
+		switch resource.Kind {
+		case util.Deployment:
+			_, err = clientset.AppsV1().Deployments(resourceNamespace).Patch(context.Background(), name,
+				types.MergePatchType, patch, metav1.PatchOptions{})
+		case util.StatefulSet:
+			_, err = clientset.AppsV1().StatefulSets(resourceNamespace).Patch(context.Background(), name,
+				types.MergePatchType, patch, metav1.PatchOptions{})
+		default:
+			continue
+		}
+		if err != nil {
+			log.Error("failed to scale " + resource.Kind + " " + name)
+			return err
+		}
+		log.Info("Scaled " + resource.Kind + " " + name + " to " + strconv.Itoa(int(replicas)) + " replicas")
+		scaled++
+	}
+
+	if scaled == 0 {
+		return errors.New("release has no deployment or stateful set to scale")
+	}
+	return nil
+}
+
+// Get replicas of deployments and stateful sets of the release manifest
+func GetWorkloads(clientset kubernetes.Interface, releaseManifest string,
+	namespace string) ([]models.WorkloadInfo, error) {
+	manifest, err := splitManifestYaml([]byte(releaseManifest))
+	if err != nil {
+		return nil, err
+	}
+
+	workloads := make([]models.WorkloadInfo, 0)
+	for _, resource := range manifest {
+		name := resource.Metadata.Name
+		resourceNamespace := getResourceNamespace(resource, namespace)
+		switch resource.Kind {
+		case util.Deployment:
+			deployment, err := clientset.AppsV1().Deployments(resourceNamespace).Get(context.Background(), name,
+				metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			workloads = append(workloads, models.WorkloadInfo{Kind: resource.Kind, Name: name,
+				Replicas: getReplicas(deployment.Spec.Replicas), ReadyReplicas: deployment.Status.ReadyReplicas})
+		case util.StatefulSet:
+			statefulSet, err := clientset.AppsV1().StatefulSets(resourceNamespace).Get(context.Background(), name,
+				metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			workloads = append(workloads, models.WorkloadInfo{Kind: resource.Kind, Name: name,
+				Replicas: getReplicas(statefulSet.Spec.Replicas), ReadyReplicas: statefulSet.Status.ReadyReplicas})
+		}
+	}
+	return workloads, nil
+}
+
+// Get namespace of manifest resource, resources without namespace are in the release namespace
+func getResourceNamespace(resource Manifest, namespace string) string {
+	if resource.Metadata.Namespace != "" {
+		return resource.Metadata.Namespace
+	}
+	return namespace
+}
+
+// Get desired replicas, kubernetes defaults unset replicas to one
+func getReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
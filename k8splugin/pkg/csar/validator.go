@@ -0,0 +1,380 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package csar validates extracted CSAR application packages. The package is
+// kept identical in lcmcontroller and k8splugin: both are built as separate go
+// modules with their own docker build context and the repository has no shared
+// module, so like the lcmservice definitions it is copied rather than imported.
+// Changes must be applied to both copies.
+package csar
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	ToscaMetaPath   = "TOSCA-Metadata/TOSCA.meta"
+	ChartsPath      = "Artifacts/Deployment/Charts"
+	entryDefinition = "Entry-Definitions"
+	entryManifest   = "ETSI-Entry-Manifest"
+	cmsBegin        = "-----BEGIN CMS-----"
+	vmAppType       = "vm"
+)
+
+// Mandatory keys of TOSCA.meta
+var toscaMetaKeys = []string{"TOSCA-Meta-File-Version", "CSAR-Version", "Created-By", entryDefinition}
+
+// Package validation error
+type ValidationError struct {
+	File    string `json:"file"`
+	Message string `json:"message"`
+}
+
+// Package validation errors
+type ValidationErrors []ValidationError
+
+// Error message of all validation errors
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, validationErr := range e {
+		messages = append(messages, validationErr.File+": "+validationErr.Message)
+	}
+	return "package validation failed: " + strings.Join(messages, "; ")
+}
+
+// Manifest file entry
+type manifestSource struct {
+	source    string
+	algorithm string
+	hash      string
+}
+
+// Package validator options
+type ValidatorOptions struct {
+	// Vm application packages, which have no deployment charts, are accepted
+	VmPackagesAllowed bool
+	// Compatibility with packages created before the manifest was validated, digest mismatches and files
+	// not listed in the manifest are only logged
+	ManifestNotEnforced bool
+	// Files of package directory which are not part of the package, like the archive it is extracted from
+	IgnoredFiles []string
+}
+
+// Package validator
+type Validator struct {
+	trustStore *x509.CertPool
+	options    ValidatorOptions
+}
+
+// Create package validator, signatures are verified only when trust store is given
+func NewValidator(trustStorePath string, options ValidatorOptions) (*Validator, error) {
+	validator := &Validator{options: options}
+	if trustStorePath == "" {
+		return validator, nil
+	}
+
+	trustStoreBytes, err := ioutil.ReadFile(trustStorePath)
+	if err != nil {
+		return nil, errors.New("failed to read package trust store")
+	}
+	validator.trustStore = x509.NewCertPool()
+	if !validator.trustStore.AppendCertsFromPEM(trustStoreBytes) {
+		return nil, errors.New("package trust store has no valid certificate")
+	}
+	return validator, nil
+}
+
+// Validate extracted package, returns nil when package is valid
+func (v *Validator) Validate(packageDir string) error {
+	var validationErrs ValidationErrors
+
+	toscaMeta, err := readKeyValueFile(filepath.Join(packageDir, ToscaMetaPath))
+	if err != nil {
+		validationErrs = append(validationErrs, ValidationError{File: ToscaMetaPath, Message: err.Error()})
+	} else {
+		validationErrs = append(validationErrs, validateToscaMeta(packageDir, toscaMeta)...)
+	}
+
+	manifestPath, err := getManifestPath(packageDir, toscaMeta)
+	if err != nil {
+		validationErrs = append(validationErrs, ValidationError{File: "manifest", Message: err.Error()})
+		return validationErrs
+	}
+	manifestName, _ := filepath.Rel(packageDir, manifestPath)
+
+	manifest, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		validationErrs = append(validationErrs, ValidationError{File: manifestName, Message: "failed to read manifest"})
+		return validationErrs
+	}
+
+	content, signature := splitManifestSignature(manifest)
+	manifestErrs := append(validateDigests(packageDir, content), v.validateListedFiles(packageDir, manifestName,
+		content)...)
+	if v.options.ManifestNotEnforced && len(manifestErrs) != 0 {
+		log.Warn("manifest is not enforced, ignoring " + manifestErrs.Error())
+	} else {
+		validationErrs = append(validationErrs, manifestErrs...)
+	}
+
+	if v.trustStore != nil {
+		err = v.verifySignature(manifestPath, content, signature)
+		if err != nil {
+			validationErrs = append(validationErrs, ValidationError{File: manifestName, Message: err.Error()})
+		}
+	}
+
+	if isVmPackage(content) {
+		if !v.options.VmPackagesAllowed {
+			validationErrs = append(validationErrs, ValidationError{File: manifestName,
+				Message: "vm application packages are not supported"})
+		}
+	} else if !hasCharts(packageDir) {
+		validationErrs = append(validationErrs, ValidationError{File: ChartsPath,
+			Message: "deployment charts are missing"})
+	}
+
+	if len(validationErrs) != 0 {
+		log.Error(validationErrs.Error())
+		return validationErrs
+	}
+	return nil
+}
+
+// Validate TOSCA.meta keys and entry definition
+func validateToscaMeta(packageDir string, toscaMeta map[string]string) ValidationErrors {
+	var validationErrs ValidationErrors
+	for _, key := range toscaMetaKeys {
+		if toscaMeta[key] == "" {
+			validationErrs = append(validationErrs, ValidationError{File: ToscaMetaPath,
+				Message: key + " is missing"})
+		}
+	}
+
+	definition := toscaMeta[entryDefinition]
+	if definition != "" && !fileExists(packageDir, definition) {
+		validationErrs = append(validationErrs, ValidationError{File: ToscaMetaPath,
+			Message: "entry definition " + definition + " does not exist"})
+	}
+	return validationErrs
+}
+
+// Get manifest path from TOSCA.meta, or the manifest in package root directory
+func getManifestPath(packageDir string, toscaMeta map[string]string) (string, error) {
+	if manifest := toscaMeta[entryManifest]; manifest != "" {
+		if !fileExists(packageDir, manifest) {
+			return "", errors.New("entry manifest " + manifest + " does not exist")
+		}
+		return filepath.Join(packageDir, filepath.Clean("/"+manifest)), nil
+	}
+
+	manifests, _ := filepath.Glob(filepath.Join(packageDir, "*.mf"))
+	if len(manifests) != 1 {
+		return "", errors.New("package must contain exactly one manifest file")
+	}
+	return manifests[0], nil
+}
+
+// Split manifest in its content and embedded CMS signature
+func splitManifestSignature(manifest []byte) ([]byte, []byte) {
+	begin := bytes.Index(manifest, []byte(cmsBegin))
+	if begin < 0 {
+		return manifest, nil
+	}
+	return manifest[:begin], manifest[begin:]
+}
+
+// Validate digests of the sources listed in manifest
+func validateDigests(packageDir string, manifest []byte) ValidationErrors {
+	var validationErrs ValidationErrors
+	for _, source := range parseManifestSources(manifest) {
+		if strings.Contains(source.source, "://") {
+			continue
+		}
+		if source.algorithm == "" || source.hash == "" {
+			validationErrs = append(validationErrs, ValidationError{File: source.source,
+				Message: "algorithm or hash is missing in manifest"})
+			continue
+		}
+
+		digest, err := fileDigest(packageDir, source.source, source.algorithm)
+		if err != nil {
+			validationErrs = append(validationErrs, ValidationError{File: source.source, Message: err.Error()})
+			continue
+		}
+		if !strings.EqualFold(digest, source.hash) {
+			validationErrs = append(validationErrs, ValidationError{File: source.source,
+				Message: "digest does not match manifest"})
+		}
+	}
+	return validationErrs
+}
+
+// Validate that every file of the package is listed in manifest, except the manifest with its signature and
+// certificate and TOSCA.meta
+func (v *Validator) validateListedFiles(packageDir string, manifestName string, manifest []byte) ValidationErrors {
+	listed := map[string]bool{
+		filepath.ToSlash(manifestName):                                      true,
+		strings.TrimSuffix(filepath.ToSlash(manifestName), ".mf") + ".cms":  true,
+		strings.TrimSuffix(filepath.ToSlash(manifestName), ".mf") + ".cert": true,
+		ToscaMetaPath: true,
+	}
+	for _, ignored := range v.options.IgnoredFiles {
+		listed[filepath.ToSlash(ignored)] = true
+	}
+	for _, source := range parseManifestSources(manifest) {
+		listed[strings.TrimPrefix(filepath.ToSlash(filepath.Clean("/"+source.source)), "/")] = true
+	}
+
+	var validationErrs ValidationErrors
+	_ = filepath.Walk(packageDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return nil
+		}
+		name, _ := filepath.Rel(packageDir, path)
+		if !listed[filepath.ToSlash(name)] {
+			validationErrs = append(validationErrs, ValidationError{File: filepath.ToSlash(name),
+				Message: "file is not listed in manifest"})
+		}
+		return nil
+	})
+	return validationErrs
+}
+
+// Parse source entries of manifest
+func parseManifestSources(manifest []byte) []manifestSource {
+	var sources []manifestSource
+	scanner := bufio.NewScanner(bytes.NewReader(manifest))
+	for scanner.Scan() {
+		key, value := splitKeyValue(scanner.Text())
+		switch key {
+		case "Source":
+			sources = append(sources, manifestSource{source: value})
+		case "Algorithm":
+			if len(sources) != 0 {
+				sources[len(sources)-1].algorithm = value
+			}
+		case "Hash":
+			if len(sources) != 0 {
+				sources[len(sources)-1].hash = value
+			}
+		}
+	}
+	return sources
+}
+
+// Compute hex encoded digest of package file
+func fileDigest(packageDir, name, algorithm string) (string, error) {
+	var hasher hash.Hash
+	switch strings.ToUpper(strings.ReplaceAll(algorithm, "-", "")) {
+	case "SHA256":
+		hasher = sha256.New()
+	case "SHA384":
+		hasher = sha512.New384()
+	case "SHA512":
+		hasher = sha512.New()
+	default:
+		return "", errors.New("digest algorithm " + algorithm + " is not supported")
+	}
+
+	if !fileExists(packageDir, name) {
+		return "", errors.New("file listed in manifest does not exist")
+	}
+	file, err := os.Open(filepath.Join(packageDir, filepath.Clean("/"+name)))
+	if err != nil {
+		return "", errors.New("failed to read file listed in manifest")
+	}
+	defer file.Close()
+
+	_, err = io.Copy(hasher, file)
+	if err != nil {
+		return "", errors.New("failed to read file listed in manifest")
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// Check whether manifest metadata describes a vm application, which has no charts
+func isVmPackage(manifest []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(manifest))
+	for scanner.Scan() {
+		key, value := splitKeyValue(scanner.Text())
+		if key == "app_type" {
+			return strings.EqualFold(value, vmAppType)
+		}
+	}
+	return false
+}
+
+// Check whether package has at least one deployment chart
+func hasCharts(packageDir string) bool {
+	charts, err := ioutil.ReadDir(filepath.Join(packageDir, ChartsPath))
+	if err != nil {
+		return false
+	}
+	for _, chart := range charts {
+		if chart.Mode().IsRegular() {
+			return true
+		}
+	}
+	return false
+}
+
+// Read file of "key: value" lines
+func readKeyValueFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.New("file is missing")
+	}
+	defer file.Close()
+
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value := splitKeyValue(scanner.Text())
+		if key != "" {
+			values[key] = value
+		}
+	}
+	return values, scanner.Err()
+}
+
+// Split "key: value" line
+func splitKeyValue(line string) (string, string) {
+	parts := strings.SplitN(line, ":", 2)
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+// Check whether regular file exists in package, paths are kept within the package
+func fileExists(packageDir, name string) bool {
+	info, err := os.Stat(filepath.Join(packageDir, filepath.Clean("/"+name)))
+	return err == nil && info.Mode().IsRegular()
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageloader
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+)
+
+// Image entry of docker save archive manifest
+type archiveManifest struct {
+	Config   string   `json:"Config"`
+	RepoTags []string `json:"RepoTags"`
+	Layers   []string `json:"Layers"`
+}
+
+// Image archive in docker save format
+type imageArchive struct {
+	path     string
+	manifest []archiveManifest
+}
+
+// File read from image archive
+type archiveFile struct {
+	io.Reader
+	file *os.File
+}
+
+// Close image archive file
+func (f *archiveFile) Close() error {
+	return f.file.Close()
+}
+
+// Open image archive and read its manifest
+func openImageArchive(archivePath string) (*imageArchive, error) {
+	archive := &imageArchive{path: archivePath}
+	manifestFile, err := archive.open("manifest.json")
+	if err != nil {
+		return nil, err
+	}
+	defer manifestFile.Close()
+
+	manifestBytes, err := ioutil.ReadAll(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(manifestBytes, &archive.manifest)
+	if err != nil || len(archive.manifest) == 0 {
+		return nil, errors.New("image archive manifest is invalid")
+	}
+	return archive, nil
+}
+
+// Get names of the images in image archive
+func (a *imageArchive) repoTags() []string {
+	repoTags := make([]string, 0, len(a.manifest))
+	for _, image := range a.manifest {
+		repoTags = append(repoTags, image.RepoTags...)
+	}
+	return repoTags
+}
+
+// Open file in image archive, caller must close the returned file
+func (a *imageArchive) open(name string) (io.ReadCloser, error) {
+	file, err := os.Open(a.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader = file
+	if strings.HasSuffix(a.path, ".gz") || strings.HasSuffix(a.path, ".tgz") {
+		reader, err = gzip.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		if path.Clean(header.Name) == path.Clean(name) {
+			return &archiveFile{Reader: tarReader, file: file}, nil
+		}
+	}
+	file.Close()
+	return nil, errors.New(name + " is not found in image archive")
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageloader
+
+import (
+	"errors"
+	"k8splugin/conf"
+	"k8splugin/util"
+)
+
+// Get image loader based on configured image loader type
+func GetImageLoader(config *conf.ServerConfigurations) (ImageLoader, error) {
+	dockerPort := config.DockerPort
+	if dockerPort == "" {
+		dockerPort = util.DefaultDockerPort
+	}
+
+	switch config.ImageLoader {
+	case "":
+		return &ReferenceLoader{}, nil
+	case util.RegistryImageLoader:
+		if config.RegistryAddr == "" {
+			return nil, errors.New("registry address is not configured")
+		}
+		return NewRegistryLoader(config.RegistryAddr, config.RegistrySslNotEnabled), nil
+	case util.RuntimeImageLoader:
+		tlsConfig, err := util.GetClientTLSConfig(config.DockerCaCert, config.DockerClientCert,
+			config.DockerClientKey)
+		if err != nil {
+			return nil, err
+		}
+		return NewRuntimeLoader(dockerPort, tlsConfig), nil
+	case util.AllImageLoaders:
+		if config.RegistryAddr == "" {
+			return nil, errors.New("registry address is not configured")
+		}
+		tlsConfig, err := util.GetClientTLSConfig(config.DockerCaCert, config.DockerClientCert,
+			config.DockerClientKey)
+		if err != nil {
+			return nil, err
+		}
+		return NewCompositeLoader(NewRegistryLoader(config.RegistryAddr, config.RegistrySslNotEnabled),
+			NewRuntimeLoader(dockerPort, tlsConfig)), nil
+	default:
+		return nil, errors.New("image loader " + config.ImageLoader + " is not supported")
+	}
+}
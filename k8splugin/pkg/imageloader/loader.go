@@ -0,0 +1,130 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageloader
+
+import (
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"k8splugin/models"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Image loader APIs
+type ImageLoader interface {
+	// Load images of the application package and return names of the images loaded by this loader
+	LoadImages(hostIp string, packagePath string, images []models.SwImageDescriptor) ([]string, error)
+	// Delete images loaded by this loader, images not loaded by the loader are ignored
+	DeleteImages(hostIp string, images []string) error
+}
+
+// Image loader which only records images referenced by the application package
+type ReferenceLoader struct {
+}
+
+// Record image names of the application package
+func (l *ReferenceLoader) LoadImages(_ string, _ string, images []models.SwImageDescriptor) ([]string, error) {
+	imageNames := make([]string, 0, len(images))
+	for _, image := range images {
+		log.WithFields(log.Fields{
+			"image": image.SwImage,
+		}).Info("record image of application package")
+		imageNames = append(imageNames, image.SwImage)
+	}
+	return imageNames, nil
+}
+
+// Nothing to delete as no image is loaded
+func (l *ReferenceLoader) DeleteImages(_ string, _ []string) error {
+	return nil
+}
+
+// Image loader which loads images using all given loaders
+type CompositeLoader struct {
+	loaders []ImageLoader
+}
+
+// Create composite image loader
+func NewCompositeLoader(loaders ...ImageLoader) *CompositeLoader {
+	return &CompositeLoader{loaders: loaders}
+}
+
+// Load images using each of the loaders
+func (l *CompositeLoader) LoadImages(hostIp string, packagePath string,
+	images []models.SwImageDescriptor) ([]string, error) {
+	imageNames := make([]string, 0, len(images))
+	loaded := make(map[string]bool)
+	for _, loader := range l.loaders {
+		names, err := loader.LoadImages(hostIp, packagePath, images)
+		if err != nil {
+			return imageNames, err
+		}
+		for _, name := range names {
+			if !loaded[name] {
+				loaded[name] = true
+				imageNames = append(imageNames, name)
+			}
+		}
+	}
+	return imageNames, nil
+}
+
+// Delete images using each of the loaders
+func (l *CompositeLoader) DeleteImages(hostIp string, images []string) error {
+	var lastErr error
+	for _, loader := range l.loaders {
+		err := loader.DeleteImages(hostIp, images)
+		if err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// Check whether image refers to an image archive bundled in the application package
+func isImageArchive(swImage string) bool {
+	return strings.HasSuffix(swImage, ".tar") || strings.HasSuffix(swImage, ".tar.gz") ||
+		strings.HasSuffix(swImage, ".tgz")
+}
+
+// Get path of image archive in application package, paths are kept within the package
+func getImageArchivePath(packagePath string, swImage string) (string, error) {
+	archivePath := filepath.Join(packagePath, filepath.Clean("/"+swImage))
+	_, err := os.Stat(archivePath)
+	if err != nil {
+		return "", errors.New("image archive " + swImage + " is not found in package")
+	}
+	return archivePath, nil
+}
+
+// Split image reference in repository without registry host and tag
+func splitImageRef(imageRef string) (repository string, tag string) {
+	repository = imageRef
+	tag = "latest"
+	slash := strings.LastIndex(repository, "/")
+	if colon := strings.LastIndex(repository, ":"); colon > slash {
+		tag = repository[colon+1:]
+		repository = repository[:colon]
+	}
+
+	parts := strings.SplitN(repository, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		repository = parts[1]
+	}
+	return repository, tag
+}
@@ -0,0 +1,341 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageloader
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"k8splugin/models"
+	"k8splugin/util"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	manifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	configMediaType   = "application/vnd.docker.container.image.v1+json"
+	layerMediaType    = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+	registryTimeout   = 10 * time.Minute
+)
+
+// Content descriptor of image manifest
+type contentDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Size      int64  `json:"size"`
+	Digest    string `json:"digest"`
+}
+
+// Image manifest, schema version 2
+type imageManifest struct {
+	SchemaVersion int                 `json:"schemaVersion"`
+	MediaType     string              `json:"mediaType"`
+	Config        contentDescriptor   `json:"config"`
+	Layers        []contentDescriptor `json:"layers"`
+}
+
+// Image loader which pushes image archives to a docker registry
+type RegistryLoader struct {
+	registryAddr string
+	baseUrl      string
+	user         string
+	password     string
+	httpClient   *http.Client
+}
+
+// Create registry image loader
+func NewRegistryLoader(registryAddr string, sslNotEnabled bool) *RegistryLoader {
+	scheme := "https://"
+	if sslNotEnabled {
+		scheme = "http://"
+	}
+	return &RegistryLoader{
+		registryAddr: registryAddr,
+		baseUrl:      scheme + registryAddr,
+		user:         util.GetRegistryUser(),
+		password:     util.GetRegistryPassword(),
+		httpClient:   &http.Client{Timeout: registryTimeout},
+	}
+}
+
+// Push image archives of the application package to registry
+func (l *RegistryLoader) LoadImages(_ string, packagePath string,
+	images []models.SwImageDescriptor) ([]string, error) {
+	imageNames := make([]string, 0, len(images))
+	for _, image := range images {
+		// Images only referenced by the package are not pushed, so these are never deleted by this loader
+		if !isImageArchive(image.SwImage) {
+			continue
+		}
+
+		archivePath, err := getImageArchivePath(packagePath, image.SwImage)
+		if err != nil {
+			return imageNames, err
+		}
+		archive, err := openImageArchive(archivePath)
+		if err != nil {
+			return imageNames, err
+		}
+
+		for _, entry := range archive.manifest {
+			for _, repoTag := range entry.RepoTags {
+				repository, tag := splitImageRef(repoTag)
+				log.WithFields(log.Fields{
+					"image":    repoTag,
+					"registry": l.registryAddr,
+				}).Info("push image to registry")
+
+				err = l.pushImage(archive, entry, repository, tag)
+				if err != nil {
+					return imageNames, err
+				}
+				imageNames = append(imageNames, l.registryAddr+"/"+repository+":"+tag)
+			}
+		}
+	}
+	return imageNames, nil
+}
+
+// Delete images pushed to registry
+func (l *RegistryLoader) DeleteImages(_ string, images []string) error {
+	var lastErr error
+	for _, image := range images {
+		if !strings.HasPrefix(image, l.registryAddr+"/") {
+			continue
+		}
+		repository, tag := splitImageRef(strings.TrimPrefix(image, l.registryAddr+"/"))
+		log.WithFields(log.Fields{
+			"image": image,
+		}).Info("delete image from registry")
+
+		err := l.deleteManifest(repository, tag)
+		if err != nil {
+			log.Error("failed to delete image " + image + " from registry")
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// Push image blobs and manifest to registry
+func (l *RegistryLoader) pushImage(archive *imageArchive, entry archiveManifest, repository, tag string) error {
+	config, err := l.pushBlob(archive, entry.Config, repository, configMediaType, false)
+	if err != nil {
+		return err
+	}
+
+	layers := make([]contentDescriptor, 0, len(entry.Layers))
+	for _, layer := range entry.Layers {
+		layerDesc, err := l.pushBlob(archive, layer, repository, layerMediaType, true)
+		if err != nil {
+			return err
+		}
+		layers = append(layers, layerDesc)
+	}
+
+	manifest, err := json.Marshal(imageManifest{
+		SchemaVersion: 2,
+		MediaType:     manifestMediaType,
+		Config:        config,
+		Layers:        layers,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := l.newRequest(http.MethodPut, "/v2/"+repository+"/manifests/"+tag, bytes.NewReader(manifest))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", manifestMediaType)
+	return l.do(req, http.StatusCreated)
+}
+
+// Push blob from image archive to registry unless registry already has it
+func (l *RegistryLoader) pushBlob(archive *imageArchive, name, repository, mediaType string,
+	compress bool) (contentDescriptor, error) {
+	blobFile, desc, err := writeBlobFile(archive, name, mediaType, compress)
+	if err != nil {
+		return desc, err
+	}
+	defer os.Remove(blobFile.Name())
+	defer blobFile.Close()
+
+	req, err := l.newRequest(http.MethodHead, "/v2/"+repository+"/blobs/"+desc.Digest, nil)
+	if err != nil {
+		return desc, err
+	}
+	if l.do(req, http.StatusOK) == nil {
+		return desc, nil
+	}
+
+	req, err = l.newRequest(http.MethodPost, "/v2/"+repository+"/blobs/uploads/", nil)
+	if err != nil {
+		return desc, err
+	}
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return desc, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		return desc, errors.New("failed to start blob upload, registry returned " + resp.Status)
+	}
+
+	uploadUrl, err := l.resolveUploadUrl(resp.Header.Get("Location"), desc.Digest)
+	if err != nil {
+		return desc, err
+	}
+	req, err = l.newRequest(http.MethodPut, "", blobFile)
+	if err != nil {
+		return desc, err
+	}
+	req.URL = uploadUrl
+	req.ContentLength = desc.Size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	return desc, l.do(req, http.StatusCreated)
+}
+
+// Delete image manifest referred by the tag from registry
+func (l *RegistryLoader) deleteManifest(repository, tag string) error {
+	req, err := l.newRequest(http.MethodHead, "/v2/"+repository+"/manifests/"+tag, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", manifestMediaType)
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if resp.StatusCode != http.StatusOK || digest == "" {
+		return errors.New("failed to get image manifest, registry returned " + resp.Status)
+	}
+
+	req, err = l.newRequest(http.MethodDelete, "/v2/"+repository+"/manifests/"+digest, nil)
+	if err != nil {
+		return err
+	}
+	return l.do(req, http.StatusAccepted)
+}
+
+// Resolve blob upload url returned by registry and add blob digest to it
+func (l *RegistryLoader) resolveUploadUrl(location, digest string) (*url.URL, error) {
+	base, err := url.Parse(l.baseUrl)
+	if err != nil {
+		return nil, err
+	}
+	uploadUrl, err := base.Parse(location)
+	if err != nil || location == "" {
+		return nil, errors.New("blob upload location is invalid")
+	}
+	query := uploadUrl.Query()
+	query.Set("digest", digest)
+	uploadUrl.RawQuery = query.Encode()
+	return uploadUrl, nil
+}
+
+// Create registry request
+func (l *RegistryLoader) newRequest(method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, l.baseUrl+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if l.user != "" {
+		req.SetBasicAuth(l.user, l.password)
+	}
+	return req, nil
+}
+
+// Send registry request and check response status
+func (l *RegistryLoader) do(req *http.Request, expectedStatus int) error {
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode != expectedStatus {
+		return errors.New(req.Method + " " + req.URL.Path + " failed, registry returned " + resp.Status)
+	}
+	return nil
+}
+
+// Write blob from image archive to temporary file and compute its descriptor
+func writeBlobFile(archive *imageArchive, name, mediaType string, compress bool) (*os.File,
+	contentDescriptor, error) {
+	desc := contentDescriptor{MediaType: mediaType}
+	archiveFile, err := archive.open(name)
+	if err != nil {
+		return nil, desc, err
+	}
+	defer archiveFile.Close()
+
+	blobFile, err := ioutil.TempFile("", "image-blob")
+	if err != nil {
+		return nil, desc, err
+	}
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	writer := io.MultiWriter(blobFile, hash, counter)
+	if compress {
+		gzipWriter := gzip.NewWriter(writer)
+		_, err = io.Copy(gzipWriter, archiveFile)
+		if err == nil {
+			err = gzipWriter.Close()
+		}
+	} else {
+		_, err = io.Copy(writer, archiveFile)
+	}
+	if err == nil {
+		_, err = blobFile.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		blobFile.Close()
+		os.Remove(blobFile.Name())
+		return nil, desc, err
+	}
+
+	desc.Size = counter.count
+	desc.Digest = "sha256:" + hex.EncodeToString(hash.Sum(nil))
+	return blobFile, desc, nil
+}
+
+// Writer counting the written bytes
+type countingWriter struct {
+	count int64
+}
+
+// Count written bytes
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.count += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageloader
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"k8splugin/models"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	runtimeTimeout = 10 * time.Minute
+	// Prefix of names of images loaded into docker engine, only images with this prefix are deleted
+	runtimeImagePrefix = "docker-engine://"
+)
+
+// Progress message of docker engine image load
+type loadMessage struct {
+	Stream string `json:"stream"`
+	Error  string `json:"error"`
+}
+
+// Image loader which loads image archives into docker engine of the edge host, docker engine is accessed
+// over tls with client certificate authentication
+type RuntimeLoader struct {
+	dockerPort string
+	httpClient *http.Client
+}
+
+// Create runtime image loader
+func NewRuntimeLoader(dockerPort string, tlsConfig *tls.Config) *RuntimeLoader {
+	return &RuntimeLoader{
+		dockerPort: dockerPort,
+		httpClient: &http.Client{Timeout: runtimeTimeout, Transport: &http.Transport{TLSClientConfig: tlsConfig}},
+	}
+}
+
+// Load image archives of the application package into docker engine of the host, names of the images
+// loaded from the archives are returned with the runtime image prefix. Images which are only referenced
+// by the package are not returned, as they are not loaded by this loader
+func (l *RuntimeLoader) LoadImages(hostIp string, packagePath string,
+	images []models.SwImageDescriptor) ([]string, error) {
+	imageNames := make([]string, 0, len(images))
+	for _, image := range images {
+		if !isImageArchive(image.SwImage) {
+			continue
+		}
+
+		archivePath, err := getImageArchivePath(packagePath, image.SwImage)
+		if err != nil {
+			return imageNames, err
+		}
+		archive, err := openImageArchive(archivePath)
+		if err != nil {
+			return imageNames, err
+		}
+
+		log.WithFields(log.Fields{
+			"image archive": image.SwImage,
+			"host":          hostIp,
+		}).Info("load images to host")
+		err = l.loadArchive(hostIp, archivePath)
+		if err != nil {
+			return imageNames, err
+		}
+		for _, repoTag := range archive.repoTags() {
+			imageNames = append(imageNames, runtimeImagePrefix+repoTag)
+		}
+	}
+	return imageNames, nil
+}
+
+// Delete images loaded by this loader from docker engine of the host
+func (l *RuntimeLoader) DeleteImages(hostIp string, images []string) error {
+	var lastErr error
+	for _, loadedImage := range images {
+		if !strings.HasPrefix(loadedImage, runtimeImagePrefix) {
+			continue
+		}
+		image := strings.TrimPrefix(loadedImage, runtimeImagePrefix)
+		log.WithFields(log.Fields{
+			"image": image,
+			"host":  hostIp,
+		}).Info("delete image from host")
+
+		req, err := http.NewRequest(http.MethodDelete, l.engineUrl(hostIp)+"/images/"+image, nil)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp, err := l.httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+			log.Error("failed to delete image " + image + " from host")
+			lastErr = errors.New("failed to delete image " + image + ", docker engine returned " + resp.Status)
+		}
+	}
+	return lastErr
+}
+
+// Load image archive into docker engine
+func (l *RuntimeLoader) loadArchive(hostIp string, archivePath string) error {
+	archiveFile, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer archiveFile.Close()
+
+	req, err := http.NewRequest(http.MethodPost, l.engineUrl(hostIp)+"/images/load?quiet=1", archiveFile)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-tar")
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("failed to load image archive, docker engine returned " + resp.Status)
+	}
+
+	// Load errors are reported in the progress messages
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var message loadMessage
+		err = decoder.Decode(&message)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if message.Error != "" {
+			return errors.New("failed to load image archive: " + message.Error)
+		}
+	}
+}
+
+// Get docker engine url of the host
+func (l *RuntimeLoader) engineUrl(hostIp string) string {
+	return "https://" + hostIp + ":" + l.dockerPort
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registration
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"io/ioutil"
+	"k8splugin/conf"
+	"k8splugin/util"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	pluginsPath               = "/lcmcontroller/v1/plugins"
+	registrationTimeout       = 30 * time.Second
+	registrationRetryInterval = 10 * time.Second
+	maxRegistrationAttempts   = 30
+	defaultVimTypes           = "k8s"
+	accessTokenHeader         = "access_token"
+)
+
+// Plugin registration info, as accepted by the plugin registry of lcmcontroller
+type PluginInfo struct {
+	PluginName   string   `json:"pluginName"`
+	Address      string   `json:"address"`
+	SslEnabled   bool     `json:"sslEnabled"`
+	ServerName   string   `json:"serverName"`
+	VimTypes     []string `json:"vimTypes"`
+	Capabilities []string `json:"capabilities"`
+}
+
+// Registrar which registers the plugin in lcmcontroller
+type Registrar struct {
+	url        string
+	tokenFile  string
+	info       PluginInfo
+	httpClient *http.Client
+}
+
+// Create plugin registrar, nil is returned when self registration is not configured
+func NewRegistrar(config *conf.ServerConfigurations) (*Registrar, error) {
+	if config.LcmcontrollerAddr == "" || config.AdvertiseAddr == "" {
+		return nil, nil
+	}
+	if config.LcmcontrollerTokenFile == "" {
+		return nil, errors.New("access token file of plugin registration is not configured")
+	}
+
+	scheme := "https://"
+	transport := &http.Transport{}
+	if config.LcmcontrollerSslNotEnabled {
+		scheme = "http://"
+	} else {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if config.LcmcontrollerCaCert != "" {
+			caCert, err := ioutil.ReadFile(config.LcmcontrollerCaCert)
+			if err != nil {
+				log.Error("failed to read lcmcontroller ca certificate")
+				return nil, err
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+				return nil, errors.New("failed to append lcmcontroller ca certificate")
+			}
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	vimTypes := config.VimTypes
+	if vimTypes == "" {
+		vimTypes = defaultVimTypes
+	}
+	info := PluginInfo{
+		PluginName:   config.PluginName,
+		Address:      config.AdvertiseAddr,
+		SslEnabled:   !config.Sslnotenabled,
+		VimTypes:     strings.Split(vimTypes, ","),
+		Capabilities: util.SupportedOperations,
+	}
+	if info.SslEnabled {
+		info.ServerName = config.Servername
+	}
+
+	return &Registrar{
+		url:        scheme + config.LcmcontrollerAddr + pluginsPath,
+		tokenFile:  config.LcmcontrollerTokenFile,
+		info:       info,
+		httpClient: &http.Client{Timeout: registrationTimeout, Transport: transport},
+	}, nil
+}
+
+// Register plugin in lcmcontroller, registration of same plugin name updates the existing registration.
+// Access token is read from token file on each registration so that it can be renewed
+func (r *Registrar) Register() error {
+	body, err := json.Marshal(r.info)
+	if err != nil {
+		return err
+	}
+
+	token, err := ioutil.ReadFile(r.tokenFile)
+	if err != nil || len(bytes.TrimSpace(token)) == 0 {
+		return errors.New("failed to read access token of plugin registration")
+	}
+	defer util.ClearByteArray(token)
+
+	req, err := http.NewRequest(http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(accessTokenHeader, string(bytes.TrimSpace(token)))
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("plugin registration failed, status is " + strconv.Itoa(resp.StatusCode))
+	}
+	return nil
+}
+
+// Start registration in background, registration is retried until lcmcontroller is reachable
+func (r *Registrar) Start() {
+	go func() {
+		for attempt := 1; attempt <= maxRegistrationAttempts; attempt++ {
+			err := r.Register()
+			if err == nil {
+				log.Info("Plugin " + r.info.PluginName + " is registered in lcmcontroller")
+				return
+			}
+			log.Warn("Plugin registration attempt " + strconv.Itoa(attempt) + " failed: " + err.Error())
+			time.Sleep(registrationRetryInterval)
+		}
+		log.Error("Plugin registration failed, plugin is resolved through environment variables of lcmcontroller")
+	}()
+}
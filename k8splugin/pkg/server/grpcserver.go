@@ -16,7 +16,6 @@
 package server
 
 import (
-	"archive/zip"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -25,27 +24,37 @@ import (
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/tap"
 	"io"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/api/core/v1"
 	"io/ioutil"
 	"k8splugin/conf"
 	"k8splugin/internal/lcmservice"
 	"k8splugin/models"
 	"k8splugin/pgdb"
 	"k8splugin/pkg/adapter"
+	"k8splugin/pkg/csar"
+	"k8splugin/pkg/imageloader"
+	"k8splugin/pkg/snapshot"
 	"k8splugin/util"
 	"net"
 	"os"
 	"path"
-	"path/filepath"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
 )
 
+const keepaliveMinTime = 10 * time.Second
+
 var (
 	KubeconfigPath = "/usr/app/config/"
 	appPackagesBasePath = "/usr/app/packages/"
@@ -60,6 +69,9 @@ type ServerGRPC struct {
 	key          string
 	db           pgdb.Database
 	serverConfig *conf.ServerConfigurations
+	imageLoader  imageloader.ImageLoader
+	validator    *csar.Validator
+	snapshotter  *snapshot.Snapshotter
 }
 
 // GRPC service configuration used to create GRPC server
@@ -92,6 +104,35 @@ func NewServerGRPC(cfg ServerGRPCConfig) (s ServerGRPC) {
 		os.Exit(1)
 	}
 	s.db = dbAdapter
+	imageLoader, err := imageloader.GetImageLoader(cfg.ServerConfig)
+	if err != nil {
+		log.Error("Failed to get image loader")
+		os.Exit(1)
+	}
+	s.imageLoader = imageLoader
+	validator, err := csar.NewValidator(cfg.ServerConfig.PackageTrustStore, csar.ValidatorOptions{
+		ManifestNotEnforced: cfg.ServerConfig.PackageManifestNotEnforced})
+	if err != nil {
+		log.Error("Failed to get package validator")
+		os.Exit(1)
+	}
+	s.validator = validator
+	dockerPort := cfg.ServerConfig.DockerPort
+	if dockerPort == "" {
+		dockerPort = util.DefaultDockerPort
+	}
+	snapshotCacheDir := cfg.ServerConfig.SnapshotCacheDir
+	if snapshotCacheDir == "" {
+		snapshotCacheDir = util.DefaultSnapshotCacheDir
+	}
+	dockerTlsConfig, err := util.GetClientTLSConfig(cfg.ServerConfig.DockerCaCert, cfg.ServerConfig.DockerClientCert,
+		cfg.ServerConfig.DockerClientKey)
+	if err != nil {
+		log.Warn("Docker engine tls configuration is not available, only csi volume snapshots are supported")
+		dockerTlsConfig = nil
+	}
+	s.snapshotter = snapshot.NewSnapshotter(dockerPort, dockerTlsConfig, cfg.ServerConfig.SnapshotClass,
+		snapshotCacheDir)
 	log.Infof("Binding is successful")
 	return
 }
@@ -112,6 +153,10 @@ func (s *ServerGRPC) Listen() (err error) {
 	//KANAG: MOve this log to line #136 to log once everything ready to start GRPC instead !
 	log.Info("Server started listening on configured port")
 
+	// Keepalive pings of lcmcontroller connection pool are permitted on idle connections
+	serverOpts := []grpc.ServerOption{grpc.InTapHandle(NewRateLimit().Handler),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{MinTime: keepaliveMinTime,
+			PermitWithoutStream: true})}
 	if !s.serverConfig.Sslnotenabled {
 		tlsConfig, err := util.GetTLSConfig(s.serverConfig, s.certificate, s.key)
 		if err != nil {
@@ -123,13 +168,13 @@ func (s *ServerGRPC) Listen() (err error) {
 		creds := credentials.NewTLS(tlsConfig)
 
 		// Create server with TLS credentials
-		s.server = grpc.NewServer(grpc.Creds(creds), grpc.InTapHandle(NewRateLimit().Handler))
-	} else {
-		// Create server without TLS credentials
-		s.server = grpc.NewServer(grpc.InTapHandle(NewRateLimit().Handler))
+		serverOpts = append(serverOpts, grpc.Creds(creds))
 	}
+	s.server = grpc.NewServer(serverOpts...)
 
 	lcmservice.RegisterAppLCMServer(s.server, s)
+	lcmservice.RegisterVmImageServer(s.server, s)
+	healthpb.RegisterHealthServer(s.server, health.NewServer())
 	log.Infof("Server registered with GRPC")
 
 	// Server start serving
@@ -163,7 +208,7 @@ func (s *ServerGRPC) WorkloadEvents(ctx context.Context, req *lcmservice.Workloa
 	}
 
 	// Input validation
-	hostIp, appInsId, err := s.validateInputParamsForPodDesc(req)
+	hostIp, appInsId, filter, err := s.validateInputParamsForPodDesc(req)
 	if err != nil {
 		s.displayResponseMsg(ctx, util.WorkloadEvents, util.FailedToValInputParams)
 		return resp, err
@@ -187,7 +232,8 @@ func (s *ServerGRPC) WorkloadEvents(ctx context.Context, req *lcmservice.Workloa
 	}
 
 	// Query Chart
-	r, err := client.WorkloadEvents(appInstanceRecord.WorkloadId)
+	r, err := client.WorkloadEvents(appInstanceRecord.WorkloadId, util.GetAppNamespace(appInstanceRecord.Namespace),
+		filter)
 	if err != nil {
 		s.displayResponseMsg(ctx, util.WorkloadEvents, "failed to get pod describe information")
 		return resp, err
@@ -199,6 +245,115 @@ func (s *ServerGRPC) WorkloadEvents(ctx context.Context, req *lcmservice.Workloa
 	return resp, nil
 }
 
+// Get endpoints of services and ingresses of application
+func (s *ServerGRPC) Endpoints(ctx context.Context,
+	req *lcmservice.EndpointsRequest) (resp *lcmservice.EndpointsResponse, err error) {
+
+	resp = &lcmservice.EndpointsResponse{
+		Response: util.Failure,
+	}
+
+	err = s.displayReceivedMsg(ctx, util.Endpoints)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Endpoints, util.FailedToDispRecvMsg)
+		return resp, err
+	}
+
+	hostIp, appInsId, err := s.validateInputParamsForEndpoints(req)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Endpoints, util.FailedToValInputParams)
+		return resp, err
+	}
+
+	appInstanceRecord := &models.AppInstanceInfo{
+		AppInsId: appInsId,
+	}
+	readErr := s.db.ReadData(appInstanceRecord, util.AppInsId)
+	if readErr != nil {
+		s.displayResponseMsg(ctx, util.Endpoints, util.AppRecordDoesNotExit)
+		return resp, s.logError(status.Error(codes.NotFound, util.AppRecordDoesNotExit))
+	}
+
+	// Get Client
+	client, err := adapter.GetClient(util.DeployType, hostIp)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Endpoints, util.FailedToGetClient)
+		return resp, err
+	}
+
+	r, err := client.Endpoints(appInstanceRecord.WorkloadId, util.GetAppNamespace(appInstanceRecord.Namespace))
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Endpoints, "failed to get endpoints of application")
+		return resp, err
+	}
+	resp.Response = r
+	s.handleLoggingForSuccess(ctx, util.Endpoints, "Endpoints query is successful")
+	return resp, nil
+}
+
+// Stream logs of application pod
+func (s *ServerGRPC) WorkloadLogs(req *lcmservice.WorkloadLogsRequest,
+	stream lcmservice.AppLCM_WorkloadLogsServer) error {
+	ctx := stream.Context()
+
+	err := s.displayReceivedMsg(ctx, util.WorkloadLogs)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.WorkloadLogs, util.FailedToDispRecvMsg)
+		return err
+	}
+
+	hostIp, appInsId, options, err := s.validateInputParamsForWorkloadLogs(req)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.WorkloadLogs, util.FailedToValInputParams)
+		return err
+	}
+
+	appInstanceRecord := &models.AppInstanceInfo{
+		AppInsId: appInsId,
+	}
+	readErr := s.db.ReadData(appInstanceRecord, util.AppInsId)
+	if readErr != nil {
+		s.displayResponseMsg(ctx, util.WorkloadLogs, util.AppRecordDoesNotExit)
+		return s.logError(status.Error(codes.NotFound, util.AppRecordDoesNotExit))
+	}
+
+	// Get Client
+	client, err := adapter.GetClient(util.DeployType, hostIp)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.WorkloadLogs, util.FailedToGetClient)
+		return err
+	}
+
+	err = client.WorkloadLogs(ctx, appInstanceRecord.WorkloadId, util.GetAppNamespace(appInstanceRecord.Namespace),
+		options, &logStreamWriter{stream: stream})
+	if err != nil {
+		s.displayResponseMsg(ctx, util.WorkloadLogs, "failed to get pod logs")
+		switch {
+		case err.Error() == util.PodDoesNotExist || apierrors.IsNotFound(err):
+			return s.logError(status.Error(codes.NotFound, err.Error()))
+		case apierrors.IsBadRequest(err):
+			return s.logError(status.Error(codes.InvalidArgument, err.Error()))
+		}
+		return err
+	}
+	s.handleLoggingForSuccess(ctx, util.WorkloadLogs, "Pod logs are streamed successfully")
+	return nil
+}
+
+// Writer which sends pod logs to the stream
+type logStreamWriter struct {
+	stream lcmservice.AppLCM_WorkloadLogsServer
+}
+
+// Send logs as one response message
+func (w *logStreamWriter) Write(data []byte) (int, error) {
+	err := w.stream.Send(&lcmservice.WorkloadLogsResponse{Content: data})
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
 // Query application
 func (s *ServerGRPC) Query(ctx context.Context, req *lcmservice.QueryRequest) (resp *lcmservice.QueryResponse, err error) {
 
@@ -236,8 +391,20 @@ func (s *ServerGRPC) Query(ctx context.Context, req *lcmservice.QueryRequest) (r
 		return resp, err
 	}
 
+	namespace := util.GetAppNamespace(appInstanceRecord.Namespace)
+	if req.GetHealthOnly() {
+		health, err := client.Health(appInstanceRecord.WorkloadId, namespace)
+		if err != nil {
+			s.displayResponseMsg(ctx, util.Query, "failed to get health of application")
+			return resp, err
+		}
+		resp = &lcmservice.QueryResponse{Health: getHealthSummary(health)}
+		s.handleLoggingForSuccess(ctx, util.Query, "Query health is successful")
+		return resp, nil
+	}
+
 	// Query Chart
-	r, err := client.Query(appInstanceRecord.WorkloadId)
+	r, err := client.Query(appInstanceRecord.WorkloadId, namespace)
 	if err != nil {
 		log.Errorf("Chart not found for workloadId: %s. Err: %s", appInstanceRecord.WorkloadId, err)
 		s.displayResponseMsg(ctx, util.Query, "chart not found for workloadId")
@@ -246,6 +413,14 @@ func (s *ServerGRPC) Query(ctx context.Context, req *lcmservice.QueryRequest) (r
 	resp = &lcmservice.QueryResponse{
 		Response: r,
 	}
+
+	// Pod statistics are responded even when health can't be computed
+	health, err := client.Health(appInstanceRecord.WorkloadId, namespace)
+	if err != nil {
+		log.Error("failed to get health of application")
+	} else {
+		resp.Health = getHealthSummary(health)
+	}
 	s.handleLoggingForSuccess(ctx, util.Query, "Query pod statistics is successful")
 	return resp, nil
 }
@@ -286,8 +461,17 @@ func (s *ServerGRPC) Terminate(ctx context.Context,
 		return resp, err
 	}
 
+	// Autoscalers are not part of the release and are removed before uninstall
+	if appInstanceRecord.AutoscalingPolicy != "" {
+		err = client.DeleteAutoscaling(util.GetAppNamespace(appInstanceRecord.Namespace), appInsId)
+		if err != nil {
+			s.displayResponseMsg(ctx, util.Terminate, "failed to delete autoscalers")
+			return resp, err
+		}
+	}
+
 	// Uninstall chart
-	err = client.UnDeploy(appInstanceRecord.WorkloadId)
+	err = client.UnDeploy(appInstanceRecord.WorkloadId, util.GetAppNamespace(appInstanceRecord.Namespace))
 	if err != nil {
 		log.Errorf("Chart not found for workloadId: %s. Err: %s", appInstanceRecord.WorkloadId, err)
 		s.displayResponseMsg(ctx, util.Terminate, "chart not found for workloadId")
@@ -326,6 +510,21 @@ func (s *ServerGRPC) Instantiate(ctx context.Context,
 		s.displayResponseMsg(ctx, util.Instantiate, util.FailedToValInputParams)
 		return resp, err
 	}
+	parameters, err := s.getDeployParameters(req.GetParameters())
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Instantiate, util.FailedToValInputParams)
+		return resp, err
+	}
+	namespace, err := s.getDeployNamespace(req.GetNamespace(), tenantId)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Instantiate, util.FailedToValInputParams)
+		return resp, err
+	}
+	options, err := s.getDeployOptions(req)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Instantiate, util.FailedToValInputParams)
+		return resp, err
+	}
 	appPkgRecord := &models.AppPackage{
 		AppPkgId: packageId + tenantId + hostIp,
 	}
@@ -343,13 +542,23 @@ func (s *ServerGRPC) Instantiate(ctx context.Context,
 		return resp, err
 	}
 
-	releaseName, err := client.Deploy(appPkgRecord, appInsId, ak, sk, s.db)
+	releaseName, err := client.Deploy(appPkgRecord, appInsId, ak, sk, namespace, parameters, options, s.db)
+	if err != nil && err.Error() == util.WorkloadsNotReady {
+		// Release is already uninstalled, failure is reported with its reason
+		resp.Reason = util.WorkloadsNotReady
+		s.displayResponseMsg(ctx, util.Instantiate, util.WorkloadsNotReady)
+		return resp, nil
+	}
+	if err != nil && err.Error() == util.ResourceNameConflict {
+		s.displayResponseMsg(ctx, util.Instantiate, util.ResourceNameConflict)
+		return resp, s.logError(status.Error(codes.AlreadyExists, util.ResourceNameConflict))
+	}
 	if err != nil {
 		log.Info("instantiation failed")
 		s.displayResponseMsg(ctx, util.Instantiate, "instantiation failed")
 		return resp, err
 	}
-	err = s.insertOrUpdateAppInsRecord(appInsId, hostIp, releaseName)
+	err = s.insertOrUpdateAppInsRecord(appInsId, hostIp, releaseName, namespace, req.GetParameters())
 	if err != nil {
 		s.displayResponseMsg(ctx, util.Instantiate, "failed to insert or update app record")
 		return resp, err
@@ -360,6 +569,342 @@ func (s *ServerGRPC) Instantiate(ctx context.Context,
 	return resp, nil
 }
 
+// Upgrade application
+func (s *ServerGRPC) Upgrade(ctx context.Context,
+	req *lcmservice.UpgradeRequest) (resp *lcmservice.UpgradeResponse, err error) {
+
+	resp = &lcmservice.UpgradeResponse{
+		Status: util.Failure,
+	}
+
+	err = s.displayReceivedMsg(ctx, util.Upgrade)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Upgrade, util.FailedToDispRecvMsg)
+		return resp, err
+	}
+
+	tenantId, packageId, hostIp, appInsId, ak, sk, err := s.validateInputParamsForUpgrade(req)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Upgrade, util.FailedToValInputParams)
+		return resp, err
+	}
+
+	appInstanceRecord := &models.AppInstanceInfo{
+		AppInsId: appInsId,
+	}
+	readErr := s.db.ReadData(appInstanceRecord, util.AppInsId)
+	if readErr != nil {
+		s.displayResponseMsg(ctx, util.Upgrade, util.AppRecordDoesNotExit)
+		return resp, s.logError(status.Error(codes.NotFound, util.AppRecordDoesNotExit))
+	}
+
+	appPkgRecord := &models.AppPackage{
+		AppPkgId: packageId + tenantId + hostIp,
+	}
+	readErr = s.db.ReadData(appPkgRecord, util.AppPkgId)
+	if readErr != nil {
+		s.displayResponseMsg(ctx, util.Upgrade, util.AppPkgRecordDoesNotExit)
+		return resp, s.logError(status.Error(codes.NotFound, util.AppPkgRecordDoesNotExit))
+	}
+
+	// Get Client
+	client, err := adapter.GetClient(util.DeployType, hostIp)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Upgrade, util.FailedToGetClient)
+		return resp, err
+	}
+
+	// Deployment parameters given at instantiation are kept across upgrades
+	parameters, err := s.getDeployParameters(appInstanceRecord.Parameters)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Upgrade, util.ParametersIsInvalid)
+		return resp, err
+	}
+
+	_, err = client.Upgrade(appPkgRecord, appInstanceRecord.WorkloadId,
+		util.GetAppNamespace(appInstanceRecord.Namespace), appInsId, ak, sk, parameters)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Upgrade, "upgrade failed")
+		return resp, err
+	}
+	s.syncAutoscaling(client, appInstanceRecord)
+	resp.Status = util.Success
+	s.handleLoggingForSuccess(ctx, util.Upgrade, "Application upgraded successfully")
+	return resp, nil
+}
+
+// Rollback application to a previous revision
+func (s *ServerGRPC) Rollback(ctx context.Context,
+	req *lcmservice.RollbackRequest) (resp *lcmservice.RollbackResponse, err error) {
+
+	resp = &lcmservice.RollbackResponse{
+		Status: util.Failure,
+	}
+
+	err = s.displayReceivedMsg(ctx, util.Rollback)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Rollback, util.FailedToDispRecvMsg)
+		return resp, err
+	}
+
+	hostIp, appInsId, revision, err := s.validateInputParamsForRollback(req)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Rollback, util.FailedToValInputParams)
+		return resp, err
+	}
+
+	appInstanceRecord := &models.AppInstanceInfo{
+		AppInsId: appInsId,
+	}
+	readErr := s.db.ReadData(appInstanceRecord, util.AppInsId)
+	if readErr != nil {
+		s.displayResponseMsg(ctx, util.Rollback, util.AppRecordDoesNotExit)
+		return resp, s.logError(status.Error(codes.NotFound, util.AppRecordDoesNotExit))
+	}
+
+	// Get Client
+	client, err := adapter.GetClient(util.DeployType, hostIp)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Rollback, util.FailedToGetClient)
+		return resp, err
+	}
+
+	restored, err := client.Rollback(appInstanceRecord.WorkloadId,
+		util.GetAppNamespace(appInstanceRecord.Namespace), revision)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Rollback, "rollback failed")
+		return resp, err
+	}
+	s.syncAutoscaling(client, appInstanceRecord)
+	resp.Status = util.Success
+	resp.PackageId = restored.PackageId
+	resp.Ak = restored.Ak
+	resp.Sk = restored.Sk
+	s.handleLoggingForSuccess(ctx, util.Rollback, "Application rolled back successfully")
+	return resp, nil
+}
+
+// Scale application to the given number of replicas, scaling is rejected while autoscaling policy is set as
+// the autoscaler would override the replicas
+func (s *ServerGRPC) Scale(ctx context.Context,
+	req *lcmservice.ScaleRequest) (resp *lcmservice.ScaleResponse, err error) {
+
+	resp = &lcmservice.ScaleResponse{
+		Status: util.Failure,
+	}
+
+	err = s.displayReceivedMsg(ctx, util.Scale)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Scale, util.FailedToDispRecvMsg)
+		return resp, err
+	}
+
+	hostIp, appInsId, replicas, err := s.validateInputParamsForScale(req)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Scale, util.FailedToValInputParams)
+		return resp, err
+	}
+
+	appInstanceRecord := &models.AppInstanceInfo{
+		AppInsId: appInsId,
+	}
+	readErr := s.db.ReadData(appInstanceRecord, util.AppInsId)
+	if readErr != nil {
+		s.displayResponseMsg(ctx, util.Scale, util.AppRecordDoesNotExit)
+		return resp, s.logError(status.Error(codes.NotFound, util.AppRecordDoesNotExit))
+	}
+	if appInstanceRecord.AutoscalingPolicy != "" {
+		s.displayResponseMsg(ctx, util.Scale, util.AutoscalingIsSet)
+		return resp, s.logError(status.Error(codes.FailedPrecondition, util.AutoscalingIsSet))
+	}
+
+	// Get Client
+	client, err := adapter.GetClient(util.DeployType, hostIp)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Scale, util.FailedToGetClient)
+		return resp, err
+	}
+
+	err = client.Scale(appInstanceRecord.WorkloadId, util.GetAppNamespace(appInstanceRecord.Namespace), replicas)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.Scale, "scale failed")
+		return resp, err
+	}
+	resp.Status = util.Success
+	s.handleLoggingForSuccess(ctx, util.Scale, "Application scaled successfully")
+	return resp, nil
+}
+
+// Set autoscaling policy of application
+func (s *ServerGRPC) SetAutoscaling(ctx context.Context,
+	req *lcmservice.SetAutoscalingRequest) (resp *lcmservice.SetAutoscalingResponse, err error) {
+
+	resp = &lcmservice.SetAutoscalingResponse{
+		Status: util.Failure,
+	}
+
+	err = s.displayReceivedMsg(ctx, util.SetAutoscaling)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.SetAutoscaling, util.FailedToDispRecvMsg)
+		return resp, err
+	}
+
+	hostIp, appInsId, policy, err := s.validateInputParamsForSetAutoscaling(req)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.SetAutoscaling, util.FailedToValInputParams)
+		return resp, err
+	}
+
+	appInstanceRecord := &models.AppInstanceInfo{
+		AppInsId: appInsId,
+	}
+	readErr := s.db.ReadData(appInstanceRecord, util.AppInsId)
+	if readErr != nil {
+		s.displayResponseMsg(ctx, util.SetAutoscaling, util.AppRecordDoesNotExit)
+		return resp, s.logError(status.Error(codes.NotFound, util.AppRecordDoesNotExit))
+	}
+
+	// Get Client
+	client, err := adapter.GetClient(util.DeployType, hostIp)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.SetAutoscaling, util.FailedToGetClient)
+		return resp, err
+	}
+
+	err = client.SetAutoscaling(appInstanceRecord.WorkloadId, util.GetAppNamespace(appInstanceRecord.Namespace),
+		appInsId, policy)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.SetAutoscaling, "set autoscaling failed")
+		if err.Error() == util.MetricsServerNotAvailable {
+			return resp, s.logError(status.Error(codes.FailedPrecondition, util.MetricsServerNotAvailable))
+		}
+		return resp, err
+	}
+
+	// Policy is kept to re-apply autoscalers when release workloads change
+	policyJson, err := json.Marshal(policy)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.SetAutoscaling, util.FailedToJsonMarshal)
+		return resp, err
+	}
+	appInstanceRecord.AutoscalingPolicy = string(policyJson)
+	err = s.db.InsertOrUpdateData(appInstanceRecord, util.AppInsId)
+	if err != nil && err.Error() != "LastInsertId is not supported by this driver" {
+		s.displayResponseMsg(ctx, util.SetAutoscaling, "failed to update app info record in database")
+		return resp, err
+	}
+	resp.Status = util.Success
+	s.handleLoggingForSuccess(ctx, util.SetAutoscaling, "Application autoscaling policy set successfully")
+	return resp, nil
+}
+
+// Delete autoscaling policy of application
+func (s *ServerGRPC) DeleteAutoscaling(ctx context.Context,
+	req *lcmservice.DeleteAutoscalingRequest) (resp *lcmservice.DeleteAutoscalingResponse, err error) {
+
+	resp = &lcmservice.DeleteAutoscalingResponse{
+		Status: util.Failure,
+	}
+
+	err = s.displayReceivedMsg(ctx, util.DeleteAutoscaling)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DeleteAutoscaling, util.FailedToDispRecvMsg)
+		return resp, err
+	}
+
+	hostIp, appInsId, err := s.validateInputParamsForTerm(&lcmservice.TerminateRequest{
+		AccessToken:   req.GetAccessToken(),
+		AppInstanceId: req.GetAppInstanceId(),
+		HostIp:        req.GetHostIp(),
+	})
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DeleteAutoscaling, util.FailedToValInputParams)
+		return resp, err
+	}
+
+	appInstanceRecord := &models.AppInstanceInfo{
+		AppInsId: appInsId,
+	}
+	readErr := s.db.ReadData(appInstanceRecord, util.AppInsId)
+	if readErr != nil {
+		s.displayResponseMsg(ctx, util.DeleteAutoscaling, util.AppRecordDoesNotExit)
+		return resp, s.logError(status.Error(codes.NotFound, util.AppRecordDoesNotExit))
+	}
+
+	// Get Client
+	client, err := adapter.GetClient(util.DeployType, hostIp)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DeleteAutoscaling, util.FailedToGetClient)
+		return resp, err
+	}
+
+	err = client.DeleteAutoscaling(util.GetAppNamespace(appInstanceRecord.Namespace), appInsId)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DeleteAutoscaling, "delete autoscaling failed")
+		return resp, err
+	}
+
+	appInstanceRecord.AutoscalingPolicy = ""
+	err = s.db.InsertOrUpdateData(appInstanceRecord, util.AppInsId)
+	if err != nil && err.Error() != "LastInsertId is not supported by this driver" {
+		s.displayResponseMsg(ctx, util.DeleteAutoscaling, "failed to update app info record in database")
+		return resp, err
+	}
+	resp.Status = util.Success
+	s.handleLoggingForSuccess(ctx, util.DeleteAutoscaling, "Application autoscaling policy deleted successfully")
+	return resp, nil
+}
+
+// Get revision history of application
+func (s *ServerGRPC) History(ctx context.Context,
+	req *lcmservice.HistoryRequest) (resp *lcmservice.HistoryResponse, err error) {
+
+	resp = &lcmservice.HistoryResponse{
+		Response: util.Failure,
+	}
+
+	err = s.displayReceivedMsg(ctx, util.History)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.History, util.FailedToDispRecvMsg)
+		return resp, err
+	}
+
+	hostIp, appInsId, err := s.validateInputParamsForQuery(&lcmservice.QueryRequest{
+		AccessToken:   req.GetAccessToken(),
+		AppInstanceId: req.GetAppInstanceId(),
+		HostIp:        req.GetHostIp(),
+	})
+	if err != nil {
+		s.displayResponseMsg(ctx, util.History, util.FailedToValInputParams)
+		return resp, err
+	}
+
+	appInstanceRecord := &models.AppInstanceInfo{
+		AppInsId: appInsId,
+	}
+	readErr := s.db.ReadData(appInstanceRecord, util.AppInsId)
+	if readErr != nil {
+		s.displayResponseMsg(ctx, util.History, util.AppRecordDoesNotExit)
+		return resp, s.logError(status.Error(codes.NotFound, util.AppRecordDoesNotExit))
+	}
+
+	// Get Client
+	client, err := adapter.GetClient(util.DeployType, hostIp)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.History, util.FailedToGetClient)
+		return resp, err
+	}
+
+	history, err := client.History(appInstanceRecord.WorkloadId, util.GetAppNamespace(appInstanceRecord.Namespace))
+	if err != nil {
+		s.displayResponseMsg(ctx, util.History, "failed to get release history")
+		return resp, err
+	}
+	resp.Response = history
+	s.handleLoggingForSuccess(ctx, util.History, "Query release history is successful")
+	return resp, nil
+}
+
 // Upload file configuration
 func (s *ServerGRPC) UploadConfig(stream lcmservice.AppLCM_UploadConfigServer) (err error) {
 	var res lcmservice.UploadCfgResponse
@@ -581,6 +1126,143 @@ func (s *ServerGRPC) validateInputParamsForInstantiate(
 	return tenantId, packageId, hostIp, appInsId, ak, sk, nil
 }
 
+// Validate input parameters for upgrade
+func (s *ServerGRPC) validateInputParamsForUpgrade(
+	req *lcmservice.UpgradeRequest) (tenantId string, packageId string, hostIp string, appInsId string, ak string, sk string, err error) {
+	return s.validateInputParamsForInstantiate(&lcmservice.InstantiateRequest{
+		AccessToken:   req.GetAccessToken(),
+		TenantId:      req.GetTenantId(),
+		AppInstanceId: req.GetAppInstanceId(),
+		AppPackageId:  req.GetAppPackageId(),
+		HostIp:        req.GetHostIp(),
+		Ak:            req.GetAk(),
+		Sk:            req.GetSk(),
+	})
+}
+
+// Validate input parameters for rollback
+func (s *ServerGRPC) validateInputParamsForRollback(
+	req *lcmservice.RollbackRequest) (hostIp string, appInsId string, revision int, err error) {
+	hostIp, appInsId, err = s.validateInputParamsForTerm(&lcmservice.TerminateRequest{
+		AccessToken:   req.GetAccessToken(),
+		AppInstanceId: req.GetAppInstanceId(),
+		HostIp:        req.GetHostIp(),
+	})
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	if req.GetRevision() < 0 {
+		return "", "", 0, s.logError(status.Error(codes.InvalidArgument, util.RevisionIsInvalid))
+	}
+	return hostIp, appInsId, int(req.GetRevision()), nil
+}
+
+// Validate input parameters for scale
+func (s *ServerGRPC) validateInputParamsForScale(
+	req *lcmservice.ScaleRequest) (hostIp string, appInsId string, replicas int32, err error) {
+	hostIp, appInsId, err = s.validateInputParamsForTerm(&lcmservice.TerminateRequest{
+		AccessToken:   req.GetAccessToken(),
+		AppInstanceId: req.GetAppInstanceId(),
+		HostIp:        req.GetHostIp(),
+	})
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	if req.GetReplicas() < 0 || req.GetReplicas() > util.MaxReplicas {
+		return "", "", 0, s.logError(status.Error(codes.InvalidArgument, util.ReplicasIsInvalid))
+	}
+	return hostIp, appInsId, req.GetReplicas(), nil
+}
+
+// Validate input parameters for set autoscaling
+func (s *ServerGRPC) validateInputParamsForSetAutoscaling(req *lcmservice.SetAutoscalingRequest) (hostIp string,
+	appInsId string, policy *models.AutoscalingPolicy, err error) {
+	hostIp, appInsId, err = s.validateInputParamsForTerm(&lcmservice.TerminateRequest{
+		AccessToken:   req.GetAccessToken(),
+		AppInstanceId: req.GetAppInstanceId(),
+		HostIp:        req.GetHostIp(),
+	})
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	policy = &models.AutoscalingPolicy{}
+	err = json.Unmarshal([]byte(req.GetPolicy()), policy)
+	if err != nil || !isAutoscalingPolicyValid(policy) {
+		return "", "", nil, s.logError(status.Error(codes.InvalidArgument, util.AutoscalingIsInvalid))
+	}
+	return hostIp, appInsId, policy, nil
+}
+
+// Check replica bounds and that at least one utilization target is given
+func isAutoscalingPolicyValid(policy *models.AutoscalingPolicy) bool {
+	if policy.MinReplicas < 1 || policy.MaxReplicas < policy.MinReplicas || policy.MaxReplicas > util.MaxReplicas {
+		return false
+	}
+	if policy.TargetCpuUtilization < 0 || policy.TargetMemoryUtilization < 0 {
+		return false
+	}
+	return policy.TargetCpuUtilization > 0 || policy.TargetMemoryUtilization > 0
+}
+
+// Re-apply stored autoscaling policy after release workloads are changed, failure leaves the
+// previous autoscalers in place and does not fail the release operation
+func (s *ServerGRPC) syncAutoscaling(client adapter.ClientIntf, appInstanceRecord *models.AppInstanceInfo) {
+	if appInstanceRecord.AutoscalingPolicy == "" {
+		return
+	}
+	policy := &models.AutoscalingPolicy{}
+	err := json.Unmarshal([]byte(appInstanceRecord.AutoscalingPolicy), policy)
+	if err != nil {
+		log.Error("stored autoscaling policy is invalid")
+		return
+	}
+	err = client.SetAutoscaling(appInstanceRecord.WorkloadId, util.GetAppNamespace(appInstanceRecord.Namespace),
+		appInstanceRecord.AppInsId, policy)
+	if err != nil {
+		log.Errorf("failed to sync autoscalers of app instance %s. Err: %s", appInstanceRecord.AppInsId, err)
+	}
+}
+
+// Validate input parameters for workload logs
+func (s *ServerGRPC) validateInputParamsForWorkloadLogs(req *lcmservice.WorkloadLogsRequest) (hostIp string,
+	appInsId string, options *models.LogOptions, err error) {
+	hostIp, appInsId, err = s.validateInputParamsForQuery(&lcmservice.QueryRequest{
+		AccessToken:   req.GetAccessToken(),
+		AppInstanceId: req.GetAppInstanceId(),
+		HostIp:        req.GetHostIp(),
+	})
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	options = &models.LogOptions{
+		PodName:       req.GetPodName(),
+		ContainerName: req.GetContainerName(),
+		TailLines:     req.GetTailLines(),
+		Follow:        req.GetFollow(),
+	}
+	if util.ValidatePodName(options.PodName) != nil {
+		return "", "", nil, s.logError(status.Error(codes.InvalidArgument, util.PodNameIsInvalid))
+	}
+	if options.ContainerName != "" && util.ValidateContainerName(options.ContainerName) != nil {
+		return "", "", nil, s.logError(status.Error(codes.InvalidArgument, util.ContainerNameIsInvalid))
+	}
+	if options.TailLines < 0 {
+		return "", "", nil, s.logError(status.Error(codes.InvalidArgument, util.TailLinesIsInvalid))
+	}
+	if req.GetSinceTime() != "" {
+		sinceTime, err := time.Parse(time.RFC3339, req.GetSinceTime())
+		if err != nil {
+			return "", "", nil, s.logError(status.Error(codes.InvalidArgument, util.SinceTimeIsInvalid))
+		}
+		options.SinceTime = &sinceTime
+	}
+	return hostIp, appInsId, options, nil
+}
+
 // Validate input parameters for upload configuration
 func (s *ServerGRPC) validateInputParamsForUploadCfg(
 	stream lcmservice.AppLCM_UploadConfigServer) (hostIp string, err error) {
@@ -617,28 +1299,39 @@ func (s *ServerGRPC) validateInputParamsForUploadCfg(
 
 // Validate input parameters for pod describe
 func (s *ServerGRPC) validateInputParamsForPodDesc(
-	req *lcmservice.WorkloadEventsRequest) (hostIp string, podName string, err error) {
+	req *lcmservice.WorkloadEventsRequest) (hostIp string, podName string, filter *models.EventFilter, err error) {
 
 	accessToken := req.GetAccessToken()
 	err = util.ValidateAccessToken(accessToken, []string{util.MecmTenantRole, util.MecmAdminRole, util.MecmGuestRole})
 	if err != nil {
-		return "", "", s.logError(status.Error(codes.InvalidArgument,
+		return "", "", nil, s.logError(status.Error(codes.InvalidArgument,
 			util.AccssTokenIsInvalid))
 	}
 
 	hostIp = req.GetHostIp()
 	err = util.ValidateIpv4Address(hostIp)
 	if err != nil {
-		return "", "", s.logError(status.Error(codes.InvalidArgument, util.HostIpIsInvalid))
+		return "", "", nil, s.logError(status.Error(codes.InvalidArgument, util.HostIpIsInvalid))
 	}
 
 	appInsId := req.GetAppInstanceId()
 	err = util.ValidateUUID(appInsId)
 	if err != nil {
-		return "", "", s.logError(status.Error(codes.InvalidArgument, util.AppInsIdValid))
+		return "", "", nil, s.logError(status.Error(codes.InvalidArgument, util.AppInsIdValid))
 	}
 
-	return hostIp, appInsId, nil
+	filter = &models.EventFilter{Type: req.GetType()}
+	if filter.Type != "" && filter.Type != v1.EventTypeNormal && filter.Type != v1.EventTypeWarning {
+		return "", "", nil, s.logError(status.Error(codes.InvalidArgument, util.EventTypeIsInvalid))
+	}
+	if req.GetSinceTime() != "" {
+		sinceTime, err := time.Parse(time.RFC3339, req.GetSinceTime())
+		if err != nil {
+			return "", "", nil, s.logError(status.Error(codes.InvalidArgument, util.SinceTimeIsInvalid))
+		}
+		filter.SinceTime = &sinceTime
+	}
+	return hostIp, appInsId, filter, nil
 }
 
 // Validate input parameters for Query
@@ -667,6 +1360,32 @@ func (s *ServerGRPC) validateInputParamsForQuery(
 	return hostIp, appInsId, nil
 }
 
+// Validate input parameters for endpoints
+func (s *ServerGRPC) validateInputParamsForEndpoints(
+	req *lcmservice.EndpointsRequest) (hostIp string, appInsId string, err error) {
+
+	accessToken := req.GetAccessToken()
+	err = util.ValidateAccessToken(accessToken, []string{util.MecmTenantRole, util.MecmGuestRole, util.MecmAdminRole})
+	if err != nil {
+		return "", "", s.logError(status.Error(codes.InvalidArgument,
+			util.AccssTokenIsInvalid))
+	}
+
+	hostIp = req.GetHostIp()
+	err = util.ValidateIpv4Address(hostIp)
+	if err != nil {
+		return "", "", s.logError(status.Error(codes.InvalidArgument, util.HostIpIsInvalid))
+	}
+
+	appInsId = req.GetAppInstanceId()
+	err = util.ValidateUUID(appInsId)
+	if err != nil {
+		return "", "", s.logError(status.Error(codes.InvalidArgument, util.AppInsIdValid))
+	}
+
+	return hostIp, appInsId, nil
+}
+
 // Get upload configuration file
 func (s *ServerGRPC) getUploadConfigFile(stream lcmservice.AppLCM_UploadConfigServer) (but bytes.Buffer, err error) {
 	// Receive upload config file
@@ -699,12 +1418,61 @@ func (s *ServerGRPC) getUploadConfigFile(stream lcmservice.AppLCM_UploadConfigSe
 	return file, nil
 }
 
+// Get deployment parameters from json encoded helm values overrides
+func (s *ServerGRPC) getDeployParameters(parameters string) (map[string]interface{}, error) {
+	if parameters == "" {
+		return nil, nil
+	}
+	var deployParameters map[string]interface{}
+	err := json.Unmarshal([]byte(parameters), &deployParameters)
+	if err != nil {
+		return nil, s.logError(status.Error(codes.InvalidArgument, util.ParametersIsInvalid))
+	}
+	return deployParameters, nil
+}
+
+// Get namespace to deploy app instance in, each tenant gets its own namespace unless one owned by the tenant
+// is given
+func (s *ServerGRPC) getDeployNamespace(namespace, tenantId string) (string, error) {
+	if namespace == "" {
+		return strings.ToLower(tenantId), nil
+	}
+	err := util.ValidateTenantNamespace(namespace, tenantId)
+	if err != nil {
+		return "", s.logError(status.Error(codes.InvalidArgument, util.NamespaceIsInvalid))
+	}
+	return namespace, nil
+}
+
+// Get health summary of query response
+func getHealthSummary(health models.HealthSummary) *lcmservice.HealthSummary {
+	return &lcmservice.HealthSummary{Status: health.Status, Reasons: health.Reasons}
+}
+
+// Get deploy options, default ready timeout is used when waiting without timeout
+func (s *ServerGRPC) getDeployOptions(req *lcmservice.InstantiateRequest) (*models.DeployOptions, error) {
+	readyTimeout := req.GetReadyTimeout()
+	if readyTimeout < 0 || readyTimeout > util.MaxReadyTimeout {
+		return nil, s.logError(status.Error(codes.InvalidArgument, util.ReadyTimeoutIsInvalid))
+	}
+	if readyTimeout == 0 {
+		readyTimeout = util.DefaultReadyTimeout
+	}
+	return &models.DeployOptions{
+		WaitForReady: req.GetWaitForReady(),
+		ReadyTimeout: time.Duration(readyTimeout) * time.Second,
+	}, nil
+}
+
 // Insert or update application instance record
-func (s *ServerGRPC) insertOrUpdateAppInsRecord(appInsId, hostIp, releaseName string) (err error) {
+func (s *ServerGRPC) insertOrUpdateAppInsRecord(appInsId, hostIp, releaseName, namespace,
+	parameters string) (err error) {
 	appInfoRecord := &models.AppInstanceInfo{
 		AppInsId:   appInsId,
 		HostIp:     hostIp,
 		WorkloadId: releaseName,
+		Namespace:  namespace,
+		Parameters: parameters,
 	}
 	err = s.db.InsertOrUpdateData(appInfoRecord, util.AppInsId)
 	if err != nil && err.Error() != "LastInsertId is not supported by this driver" {
@@ -886,7 +1654,14 @@ func (s *ServerGRPC) UploadPackage(stream lcmservice.AppLCM_UploadPackageServer)
 		return err
 	}
 
-	dockerImages, err := s.loadDockerImagesToHost(packagePath)
+	err = s.validator.Validate(packagePath)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.UploadPackage, "application package validation failed")
+		sendUploadPackageResponse(stream, &res)
+		return s.logError(status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	dockerImages, err := s.loadDockerImagesToHost(hostIp, packagePath)
 	if err != nil {
 		s.displayResponseMsg(ctx, util.UploadConfig, "failed to process SwImageDescr")
 		sendUploadPackageResponse(stream, &res)
@@ -1041,7 +1816,10 @@ func (s *ServerGRPC) DeletePackage(ctx context.Context,
 		return resp, err
 	}
 
-	_ = s.deleteDockerImagesFromHost(appPkgRecord.DockerImages)
+	err = s.deleteDockerImagesFromHost(appPkgRecord.HostIp, appPkgRecord.DockerImages)
+	if err != nil {
+		log.Error("failed to delete application package images")
+	}
 
 	packagePath := appPackagesBasePath + tenantId + "/" + packageId + appPkgRecord.HostIp
 	err = s.deletePackage(packagePath)
@@ -1058,6 +1836,35 @@ func (s *ServerGRPC) DeletePackage(ctx context.Context,
 	return resp, nil
 }
 
+// Get capabilities of plugin
+func (s *ServerGRPC) GetCapabilities(ctx context.Context,
+	request *lcmservice.GetCapabilitiesRequest) (*lcmservice.GetCapabilitiesResponse, error) {
+
+	err := s.displayReceivedMsg(ctx, util.GetCapabilities)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.GetCapabilities, util.FailedToDispRecvMsg)
+		return nil, err
+	}
+
+	err = util.ValidateAccessToken(request.GetAccessToken(), []string{util.MecmTenantRole, util.MecmAdminRole})
+	if err != nil {
+		s.displayResponseMsg(ctx, util.GetCapabilities, util.FailedToValInputParams)
+		if err.Error() == util.Forbidden {
+			return nil, s.logError(status.Error(codes.PermissionDenied, util.Forbidden))
+		}
+		return nil, s.logError(status.Error(codes.InvalidArgument, util.AccssTokenIsInvalid))
+	}
+
+	resp := &lcmservice.GetCapabilitiesResponse{
+		Operations:        util.SupportedOperations,
+		DeploymentTypes:   []string{util.ContainerDeployment},
+		MaxPackageSize:    util.MaxPackageFile,
+		MaxConfigFileSize: util.MaxConfigFile,
+	}
+	s.handleLoggingForSuccess(ctx, util.GetCapabilities, "Get capabilities is successful")
+	return resp, nil
+}
+
 func (s *ServerGRPC) deletePackage(appPkgPath string) error {
 
 	tenantPath := path.Dir(appPkgPath)
@@ -1119,93 +1926,59 @@ func (s *ServerGRPC) validateInputParamsForDeletePackage(request *lcmservice.Del
 
 // extract CSAR package
 func (c *ServerGRPC) extractCsarPackage(packagePath string) (string, error) {
-	zipReader, _ := zip.OpenReader(packagePath)
-	if len(zipReader.File) > util.TooManyFile {
-		return "", errors.New("Too many files contains in zip file")
-	}
-	var totalWrote int64
 	packageDir := path.Dir(packagePath)
-	err := os.MkdirAll(packageDir, 0750)
+	err := csar.Extract(packagePath, packageDir, csar.ExtractLimits{MaxFiles: util.TooManyFile,
+		MaxFileSize: util.SingleFileTooBig, MaxTotalSize: util.TooBig})
 	if err != nil {
-		log.Error("Failed to make directory")
-		return "" ,errors.New("Failed to make directory")
-	}
-	for _, file := range zipReader.Reader.File {
-
-		zippedFile, err := file.Open()
-		if err != nil || zippedFile == nil {
-			log.Error("Failed to open zip file")
-			continue
-		}
-		if file.UncompressedSize64 > util.SingleFileTooBig || totalWrote > util.TooBig {
-			log.Error("File size limit is exceeded")
-		}
-
-		defer zippedFile.Close()
-
-		isContinue, wrote := c.extractFiles(file, zippedFile, totalWrote, packageDir)
-		if isContinue {
-			continue
-		}
-		totalWrote = wrote
+		log.Error("failed to extract csar package: " + err.Error())
+		return "", err
 	}
 	return packageDir, nil
 }
 
-// Extract files
-func (c *ServerGRPC) extractFiles(file *zip.File, zippedFile io.ReadCloser, totalWrote int64, dirName string) (bool, int64) {
-	targetDir := dirName
-	extractedFilePath := filepath.Join(
-		targetDir,
-		file.Name,
-	)
-
-	if file.FileInfo().IsDir() {
-		err := os.MkdirAll(extractedFilePath, 0750)
-		if err != nil {
-			log.Error("Failed to create directory")
-		}
-	} else {
-		parent := filepath.Dir(extractedFilePath)
-		if _, err := os.Stat(parent); os.IsNotExist(err) {
-			_ = os.MkdirAll(parent, 0750)
-		}
-		outputFile, err := os.OpenFile(
-			extractedFilePath,
-			os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
-			0750,
-		)
-		if err != nil || outputFile == nil {
-			log.Error("The output file is nil")
-			return true, totalWrote
+// Delete docker images of application package using image loader, images still used by other packages
+// distributed to the host are kept
+func (s *ServerGRPC) deleteDockerImagesFromHost(hostIp string, dockerImages string) error {
+	log.Info("Delete docker images")
+	var appPkgRecords []*models.AppPackage
+	_, err := s.db.QueryTable(util.AppPackageTable, &appPkgRecords, util.HostIp, hostIp)
+	if err != nil {
+		return err
+	}
+	imagesInUse := make(map[string]bool)
+	for _, appPkgRecord := range appPkgRecords {
+		for _, image := range splitDockerImages(appPkgRecord.DockerImages) {
+			imagesInUse[image] = true
 		}
+	}
 
-		defer outputFile.Close()
-
-		wt, err := io.Copy(outputFile, zippedFile)
-		if err != nil {
-			log.Error("Failed to copy zipped file")
+	images := make([]string, 0)
+	for _, image := range splitDockerImages(dockerImages) {
+		if imagesInUse[image] {
+			log.WithFields(log.Fields{
+				"image": image,
+			}).Info("image is used by other application packages of host")
+			continue
 		}
-		totalWrote += wt
+		images = append(images, image)
 	}
-	return false, totalWrote
+	return s.imageLoader.DeleteImages(hostIp, images)
 }
 
-func (s *ServerGRPC) deleteDockerImagesFromHost(dockerImages string) error {
-    log.Info("Delete docker images")
-	dockers := strings.Split(dockerImages, ",")
-	for i := range dockers {
-		log.WithFields(log.Fields{
-			"delete docker image": dockers[i],
-		}).Info("delete docker images")
-
-		//delete docker images form host machine using docker client
+// Split comma separated docker images of application package record
+func splitDockerImages(dockerImages string) []string {
+	images := make([]string, 0)
+	for _, image := range strings.Split(dockerImages, ",") {
+		image = strings.TrimSpace(image)
+		if image != "" {
+			images = append(images, image)
+		}
 	}
-	return nil
+	return images
 }
 
-// get sw image descriptors
-func (c *ServerGRPC) loadDockerImagesToHost(packagePath string) (string, error) {
+// Load docker images described by sw image descriptors using image loader
+func (c *ServerGRPC) loadDockerImagesToHost(hostIp string, packagePath string) (string, error) {
 
 	var imageDescriptors []models.SwImageDescriptor
 
@@ -1216,20 +1989,19 @@ func (c *ServerGRPC) loadDockerImagesToHost(packagePath string) (string, error)
 	defer jsonFile.Close()
 
 	imageDescrBytes, _ := ioutil.ReadAll(jsonFile)
-	json.Unmarshal(imageDescrBytes, &imageDescriptors)
-
-	dockerImages := make([]string, 0)
-	for i := range imageDescriptors {
-		log.WithFields(log.Fields{
-			"loading docker image": imageDescriptors[i].SwImage,
-		}).Info("load docker images")
-
-		dockerImages = append(dockerImages, imageDescriptors[i].SwImage)
+	// Numeric descriptor fields are not used, so their type mismatches are tolerated
+	err = json.Unmarshal(imageDescrBytes, &imageDescriptors)
+	if _, ok := err.(*json.UnmarshalTypeError); err != nil && !ok {
+		return "", errors.New("failed to parse SwImageDesc.json")
+	}
 
-		//load docker image to docker host using docker client
+	dockerImages, err := c.imageLoader.LoadImages(hostIp, packagePath, imageDescriptors)
+	if err != nil {
+		log.Error("failed to load docker images: " + err.Error())
+		return "", err
 	}
 
-	return strings.Join(dockerImages,", "), nil
+	return strings.Join(dockerImages, ","), nil
 }
 
 // Get app package record
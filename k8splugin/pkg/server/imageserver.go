@@ -0,0 +1,386 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"k8splugin/internal/lcmservice"
+	"k8splugin/models"
+	"k8splugin/pkg/snapshot"
+	"k8splugin/util"
+)
+
+// Create container image snapshot of application pod. Containers of docker runtime are committed to images
+// in the background, containers of other runtimes are snapshotted through csi volume snapshots of the
+// persistent volume claims of the pod, which are kept in the cluster and can not be downloaded
+func (s *ServerGRPC) CreateVmImage(ctx context.Context,
+	request *lcmservice.CreateVmImageRequest) (*lcmservice.CreateVmImageResponse, error) {
+
+	err := s.displayReceivedMsg(ctx, util.CreateVmImage)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.CreateVmImage, util.FailedToDispRecvMsg)
+		return nil, err
+	}
+
+	err = s.validateInputParamsForImage(request.GetAccessToken(), request.GetHostIp(), request.GetAppInstanceId())
+	if err == nil && util.ValidateVmId(request.GetVmId()) != nil {
+		err = s.logError(status.Error(codes.InvalidArgument, util.VmIdIsInvalid))
+	}
+	if err != nil {
+		s.displayResponseMsg(ctx, util.CreateVmImage, util.FailedToValInputParams)
+		return nil, err
+	}
+
+	appInstanceRecord := &models.AppInstanceInfo{
+		AppInsId: request.GetAppInstanceId(),
+	}
+	readErr := s.db.ReadData(appInstanceRecord, util.AppInsId)
+	if readErr != nil || appInstanceRecord.HostIp != request.GetHostIp() {
+		s.displayResponseMsg(ctx, util.CreateVmImage, util.AppRecordDoesNotExit)
+		return nil, s.logError(status.Error(codes.NotFound, util.AppRecordDoesNotExit))
+	}
+
+	clientset, err := snapshot.GetClientset(KubeconfigPath + request.GetHostIp())
+	if err != nil {
+		s.displayResponseMsg(ctx, util.CreateVmImage, util.FailedToGetClient)
+		return nil, s.logError(status.Error(codes.Internal, util.FailedToGetClient))
+	}
+	container, err := s.snapshotter.FindContainer(clientset, util.GetAppNamespace(appInstanceRecord.Namespace),
+		request.GetVmId())
+	if err != nil {
+		s.displayResponseMsg(ctx, util.CreateVmImage, err.Error())
+		return nil, s.logError(status.Error(codes.FailedPrecondition, err.Error()))
+	}
+	snapshotType, err := s.snapshotter.GetSnapshotType(container)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.CreateVmImage, err.Error())
+		return nil, s.logError(status.Error(codes.FailedPrecondition, err.Error()))
+	}
+
+	imageId := util.GenerateImageId()
+	imageRecord := &models.ContainerImage{
+		ImageId:       imageId,
+		AppInsId:      request.GetAppInstanceId(),
+		HostIp:        request.GetHostIp(),
+		NodeIp:        container.NodeIp,
+		PodName:       container.PodName,
+		ContainerName: container.ContainerName,
+		ImageName:     snapshot.GetImageName(request.GetAppInstanceId(), imageId),
+		Status:        util.ImageQueued,
+		SnapshotType:  snapshotType,
+		Namespace:     container.Namespace,
+	}
+	if snapshotType == snapshot.CsiSnapshot {
+		dynamicClient, err := snapshot.GetDynamicClient(KubeconfigPath + request.GetHostIp())
+		if err != nil {
+			s.displayResponseMsg(ctx, util.CreateVmImage, util.FailedToGetClient)
+			return nil, s.logError(status.Error(codes.Internal, util.FailedToGetClient))
+		}
+		names, err := s.snapshotter.CreateVolumeSnapshots(dynamicClient, container, imageId)
+		if err != nil {
+			s.displayResponseMsg(ctx, util.CreateVmImage, "failed to create volume snapshots")
+			return nil, s.logError(status.Error(codes.Internal, "failed to create volume snapshots"))
+		}
+		imageRecord.VolumeSnapshots = snapshot.JoinVolumeSnapshots(names)
+	}
+	err = s.db.InsertOrUpdateData(imageRecord, util.ImageId)
+	if err != nil && err.Error() != "LastInsertId is not supported by this driver" {
+		s.displayResponseMsg(ctx, util.CreateVmImage, "failed to save image record")
+		return nil, s.logError(status.Error(codes.Internal, "failed to save image record"))
+	}
+
+	// Commit can take long for large containers, progress is reported by query vm image. Volume snapshots
+	// are taken by the csi driver and their progress is read on query
+	if snapshotType == snapshot.DockerSnapshot {
+		go s.commitContainer(imageRecord, container)
+	}
+
+	response, _ := json.Marshal(map[string]string{"imageId": imageId})
+	s.handleLoggingForSuccess(ctx, util.CreateVmImage, "Image creation is started")
+	return &lcmservice.CreateVmImageResponse{Response: string(response)}, nil
+}
+
+// Query container image snapshot
+func (s *ServerGRPC) QueryVmImage(ctx context.Context,
+	request *lcmservice.QueryVmImageRequest) (*lcmservice.QueryVmImageResponse, error) {
+
+	err := s.displayReceivedMsg(ctx, util.QueryVmImage)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.QueryVmImage, util.FailedToDispRecvMsg)
+		return nil, err
+	}
+
+	imageRecord, err := s.getImageRecord(request.GetAccessToken(), request.GetHostIp(), request.GetAppInstanceId(),
+		request.GetImageId())
+	if err != nil {
+		s.displayResponseMsg(ctx, util.QueryVmImage, util.FailedToValInputParams)
+		return nil, err
+	}
+	if imageRecord.SnapshotType == snapshot.CsiSnapshot && imageRecord.Status == util.ImageQueued {
+		s.refreshVolumeSnapshotStatus(imageRecord)
+	}
+
+	imageInfo := models.ImageInfo{
+		ImageId:       imageRecord.ImageId,
+		ImageName:     imageRecord.ImageName,
+		AppInstanceId: imageRecord.AppInsId,
+		Status:        imageRecord.Status,
+		ChunkSize:     util.ImageChunkSize,
+	}
+	if imageRecord.Status == util.ImageActive {
+		imageInfo.SumChunkNum = (imageRecord.Size + util.ImageChunkSize - 1) / util.ImageChunkSize
+		imageInfo.ImageSize = imageRecord.Size
+	}
+	response, err := json.Marshal(imageInfo)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.QueryVmImage, util.FailedToJsonMarshal)
+		return nil, s.logError(status.Error(codes.Internal, util.FailedToJsonMarshal))
+	}
+
+	s.handleLoggingForSuccess(ctx, util.QueryVmImage, "Image query is successful")
+	return &lcmservice.QueryVmImageResponse{Response: string(response)}, nil
+}
+
+// Delete container image snapshot
+func (s *ServerGRPC) DeleteVmImage(ctx context.Context,
+	request *lcmservice.DeleteVmImageRequest) (*lcmservice.DeleteVmImageResponse, error) {
+
+	err := s.displayReceivedMsg(ctx, util.DeleteVmImage)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DeleteVmImage, util.FailedToDispRecvMsg)
+		return nil, err
+	}
+
+	imageRecord, err := s.getImageRecord(request.GetAccessToken(), request.GetHostIp(), request.GetAppInstanceId(),
+		request.GetImageId())
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DeleteVmImage, util.FailedToValInputParams)
+		return nil, err
+	}
+	if imageRecord.Status == util.ImageQueued && imageRecord.SnapshotType != snapshot.CsiSnapshot {
+		s.displayResponseMsg(ctx, util.DeleteVmImage, "image is being created")
+		return nil, s.logError(status.Error(codes.FailedPrecondition, "image is being created"))
+	}
+
+	err = s.deleteSnapshot(imageRecord)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DeleteVmImage, err.Error())
+		return nil, s.logError(status.Error(codes.Internal, err.Error()))
+	}
+
+	err = s.db.DeleteData(imageRecord, util.ImageId)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DeleteVmImage, "failed to delete image record")
+		return nil, s.logError(status.Error(codes.Internal, "failed to delete image record"))
+	}
+
+	response, _ := json.Marshal(map[string]interface{}{"code": 200, "msg": "Ok"})
+	s.handleLoggingForSuccess(ctx, util.DeleteVmImage, "Image deletion is successful")
+	return &lcmservice.DeleteVmImageResponse{Response: string(response)}, nil
+}
+
+// Download container image snapshot as image archive, streamed in chunks starting from the requested chunk.
+// Image is exported once and the archive is cached, so that resumed downloads seek to the requested chunk.
+// Csi volume snapshots are kept in the cluster and can not be downloaded
+func (s *ServerGRPC) DownloadVmImage(request *lcmservice.DownloadVmImageRequest,
+	stream lcmservice.VmImage_DownloadVmImageServer) error {
+	ctx := stream.Context()
+
+	err := s.displayReceivedMsg(ctx, util.DownloadVmImage)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DownloadVmImage, util.FailedToDispRecvMsg)
+		return err
+	}
+
+	imageRecord, err := s.getImageRecord(request.GetAccessToken(), request.GetHostIp(), request.GetAppInstanceId(),
+		request.GetImageId())
+	if err == nil && request.GetChunkNum() < 0 {
+		err = s.logError(status.Error(codes.InvalidArgument, "chunkNum is invalid"))
+	}
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DownloadVmImage, util.FailedToValInputParams)
+		return err
+	}
+	if imageRecord.Status != util.ImageActive {
+		s.displayResponseMsg(ctx, util.DownloadVmImage, "image is not active")
+		return s.logError(status.Error(codes.FailedPrecondition, "image is not active"))
+	}
+	if imageRecord.SnapshotType == snapshot.CsiSnapshot {
+		s.displayResponseMsg(ctx, util.DownloadVmImage, "volume snapshot image can not be downloaded")
+		return s.logError(status.Error(codes.FailedPrecondition, "volume snapshot image can not be downloaded"))
+	}
+
+	archive, err := s.snapshotter.OpenExport(imageRecord.NodeIp, imageRecord.ImageName, imageRecord.ImageId)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DownloadVmImage, err.Error())
+		return s.logError(status.Error(codes.Internal, err.Error()))
+	}
+	defer archive.Close()
+
+	_, err = archive.Seek(int64(request.GetChunkNum())*util.ImageChunkSize, io.SeekStart)
+	if err != nil {
+		s.displayResponseMsg(ctx, util.DownloadVmImage, "failed to read image archive")
+		return s.logError(status.Error(codes.Internal, "failed to read image archive"))
+	}
+
+	chunk := make([]byte, util.ImageChunkSize)
+	for err != io.EOF {
+		if err = s.contextError(ctx); err != nil {
+			s.displayResponseMsg(ctx, util.DownloadVmImage, "context error")
+			return err
+		}
+
+		var n int
+		n, err = io.ReadFull(archive, chunk)
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		if err != nil && err != io.EOF {
+			s.displayResponseMsg(ctx, util.DownloadVmImage, "failed to read image archive")
+			return s.logError(status.Error(codes.Internal, "failed to read image archive"))
+		}
+		if n == 0 {
+			break
+		}
+		sendErr := stream.Send(&lcmservice.DownloadVmImageResponse{Content: chunk[:n]})
+		if sendErr != nil {
+			s.displayResponseMsg(ctx, util.DownloadVmImage, "failed to send image chunk")
+			return s.logError(status.Error(codes.Unknown, "failed to send image chunk"))
+		}
+	}
+
+	s.handleLoggingForSuccess(ctx, util.DownloadVmImage, "Image download is successful")
+	return nil
+}
+
+// Commit container and update image record with the result
+func (s *ServerGRPC) commitContainer(imageRecord *models.ContainerImage, container *snapshot.Container) {
+	imageRecord.Status = util.ImageKilled
+	err := s.snapshotter.Commit(container, imageRecord.ImageName)
+	if err != nil {
+		log.Error("failed to create image " + imageRecord.ImageId + ": " + err.Error())
+	} else {
+		size, err := s.snapshotter.GetImageSize(imageRecord.NodeIp, imageRecord.ImageName)
+		if err != nil {
+			log.Error("failed to get size of image " + imageRecord.ImageId + ": " + err.Error())
+		} else {
+			imageRecord.Status = util.ImageActive
+			imageRecord.Size = size
+		}
+	}
+
+	err = s.db.InsertOrUpdateData(imageRecord, util.ImageId)
+	if err != nil && err.Error() != "LastInsertId is not supported by this driver" {
+		log.Error("failed to update image record " + imageRecord.ImageId)
+	}
+}
+
+// Update status of csi volume snapshots of image record, status remains queued when it can not be read
+func (s *ServerGRPC) refreshVolumeSnapshotStatus(imageRecord *models.ContainerImage) {
+	dynamicClient, err := snapshot.GetDynamicClient(KubeconfigPath + imageRecord.HostIp)
+	if err != nil {
+		log.Error("failed to get client of host " + imageRecord.HostIp)
+		return
+	}
+	snapshotStatus, err := s.snapshotter.GetVolumeSnapshotStatus(dynamicClient, imageRecord.Namespace,
+		snapshot.SplitVolumeSnapshots(imageRecord.VolumeSnapshots))
+	if err != nil {
+		log.Error("failed to get status of image " + imageRecord.ImageId)
+		return
+	}
+	switch {
+	case snapshotStatus.Failed:
+		imageRecord.Status = util.ImageKilled
+	case snapshotStatus.Ready:
+		imageRecord.Status = util.ImageActive
+		imageRecord.Size = snapshotStatus.Size
+	default:
+		return
+	}
+
+	err = s.db.InsertOrUpdateData(imageRecord, util.ImageId)
+	if err != nil && err.Error() != "LastInsertId is not supported by this driver" {
+		log.Error("failed to update image record " + imageRecord.ImageId)
+	}
+}
+
+// Delete docker image or csi volume snapshots of image record and its cached image archive
+func (s *ServerGRPC) deleteSnapshot(imageRecord *models.ContainerImage) error {
+	if imageRecord.SnapshotType == snapshot.CsiSnapshot {
+		dynamicClient, err := snapshot.GetDynamicClient(KubeconfigPath + imageRecord.HostIp)
+		if err != nil {
+			return errors.New(util.FailedToGetClient)
+		}
+		return s.snapshotter.DeleteVolumeSnapshots(dynamicClient, imageRecord.Namespace,
+			snapshot.SplitVolumeSnapshots(imageRecord.VolumeSnapshots))
+	}
+
+	s.snapshotter.RemoveExport(imageRecord.ImageId)
+	if imageRecord.Status != util.ImageActive {
+		return nil
+	}
+	return s.snapshotter.DeleteImage(imageRecord.NodeIp, imageRecord.ImageName)
+}
+
+// Validate input parameters and get image record
+func (s *ServerGRPC) getImageRecord(accessToken, hostIp, appInsId, imageId string) (*models.ContainerImage, error) {
+	err := s.validateInputParamsForImage(accessToken, hostIp, appInsId)
+	if err != nil {
+		return nil, err
+	}
+	err = util.ValidateImageId(imageId)
+	if err != nil {
+		return nil, s.logError(status.Error(codes.InvalidArgument, util.ImageIdIsInvalid))
+	}
+
+	imageRecord := &models.ContainerImage{
+		ImageId: imageId,
+	}
+	readErr := s.db.ReadData(imageRecord, util.ImageId)
+	if readErr != nil || imageRecord.AppInsId != appInsId || imageRecord.HostIp != hostIp {
+		return nil, s.logError(status.Error(codes.NotFound, util.ImageRecordDoesNotExit))
+	}
+	return imageRecord, nil
+}
+
+// Validate input parameters common to image requests
+func (s *ServerGRPC) validateInputParamsForImage(accessToken, hostIp, appInsId string) error {
+	err := util.ValidateAccessToken(accessToken, []string{util.MecmTenantRole, util.MecmAdminRole})
+	if err != nil {
+		if err.Error() == util.Forbidden {
+			return s.logError(status.Error(codes.PermissionDenied, util.Forbidden))
+		}
+		return s.logError(status.Error(codes.InvalidArgument, util.AccssTokenIsInvalid))
+	}
+
+	err = util.ValidateIpv4Address(hostIp)
+	if err != nil {
+		return s.logError(status.Error(codes.InvalidArgument, util.HostIpIsInvalid))
+	}
+
+	err = util.ValidateUUID(appInsId)
+	if err != nil {
+		return s.logError(status.Error(codes.InvalidArgument, util.AppInsIdValid))
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	engineTimeout   = 10 * time.Minute
+	dockerIdPrefix  = "docker://"
+	snapshotRepoTag = "mecm-snapshot/"
+	exportFilePerm  = 0640
+
+	DockerSnapshot = "docker"
+	CsiSnapshot    = "csi"
+)
+
+// Container of application pod which is snapshotted
+type Container struct {
+	PodName       string
+	ContainerName string
+	ContainerId   string
+	NodeIp        string
+	Namespace     string
+	ClaimNames    []string
+}
+
+// Image inspect info of docker engine
+type imageInspect struct {
+	Size int64 `json:"Size"`
+}
+
+// Snapshotter of application pods. Containers of docker runtime are committed to images and exported from
+// docker engine of the node running the pod, accessed over tls. Docker runtime is removed from kubernetes
+// 1.24, containers of other runtimes are snapshotted through csi volume snapshots of the persistent volume
+// claims of the pod
+type Snapshotter struct {
+	dockerPort    string
+	dockerEnabled bool
+	snapshotClass string
+	cacheDir      string
+	httpClient    *http.Client
+	exportMutexes sync.Map
+}
+
+// Create snapshotter, docker snapshots are disabled when tls configuration of docker engine is not given.
+// Exported image archives are cached in cache directory until the image is deleted
+func NewSnapshotter(dockerPort string, tlsConfig *tls.Config, snapshotClass string, cacheDir string) *Snapshotter {
+	return &Snapshotter{
+		dockerPort:    dockerPort,
+		dockerEnabled: tlsConfig != nil,
+		snapshotClass: snapshotClass,
+		cacheDir:      cacheDir,
+		httpClient:    &http.Client{Timeout: engineTimeout, Transport: &http.Transport{TLSClientConfig: tlsConfig}},
+	}
+}
+
+// Get kubernetes clientset of the host
+func GetClientset(kubeconfig string) (kubernetes.Interface, error) {
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(kubeConfig)
+}
+
+// Get kubernetes dynamic client of the host
+func GetDynamicClient(kubeconfig string) (dynamic.Interface, error) {
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewForConfig(kubeConfig)
+}
+
+// Get snapshot image name of the application instance
+func GetImageName(appInsId, imageId string) string {
+	return snapshotRepoTag + appInsId + ":" + imageId
+}
+
+// Find container to snapshot, vm id is the pod name, optionally followed by "/" and the container name.
+// First container of the pod is used when container name is not given. Container id is set only for
+// containers of docker runtime
+func (s *Snapshotter) FindContainer(clientset kubernetes.Interface, namespace, vmId string) (*Container, error) {
+	podName := vmId
+	containerName := ""
+	if index := strings.Index(vmId, "/"); index >= 0 {
+		podName = vmId[:index]
+		containerName = vmId[index+1:]
+	}
+
+	pod, err := clientset.CoreV1().Pods(namespace).Get(context.Background(), podName, metav1.GetOptions{})
+	if err != nil {
+		log.Error("failed to get pod " + podName)
+		return nil, err
+	}
+	if pod.Status.HostIP == "" {
+		return nil, errors.New("pod " + podName + " is not scheduled on a node")
+	}
+
+	status, err := getContainerStatus(pod, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	container := &Container{
+		PodName:       podName,
+		ContainerName: status.Name,
+		NodeIp:        pod.Status.HostIP,
+		Namespace:     namespace,
+	}
+	if strings.HasPrefix(status.ContainerID, dockerIdPrefix) {
+		container.ContainerId = strings.TrimPrefix(status.ContainerID, dockerIdPrefix)
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			container.ClaimNames = append(container.ClaimNames, volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+	return container, nil
+}
+
+// Get snapshot type of container, docker snapshot is used for containers of docker runtime when docker
+// engine access is configured and csi volume snapshots otherwise
+func (s *Snapshotter) GetSnapshotType(container *Container) (string, error) {
+	if container.ContainerId != "" && s.dockerEnabled {
+		return DockerSnapshot, nil
+	}
+	if len(container.ClaimNames) != 0 {
+		return CsiSnapshot, nil
+	}
+	return "", errors.New("container " + container.ContainerName + " is not of docker runtime and pod " +
+		container.PodName + " has no persistent volume claim to snapshot")
+}
+
+// Commit container to image in docker engine of the node
+func (s *Snapshotter) Commit(container *Container, imageName string) error {
+	repo, tag := splitImageName(imageName)
+	query := url.Values{}
+	query.Set("container", container.ContainerId)
+	query.Set("repo", repo)
+	query.Set("tag", tag)
+
+	log.WithFields(log.Fields{
+		"pod":       container.PodName,
+		"container": container.ContainerName,
+		"image":     imageName,
+	}).Info("commit container to image")
+
+	resp, err := s.httpClient.Post(s.engineUrl(container.NodeIp)+"/commit?"+query.Encode(), "application/json", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode != http.StatusCreated {
+		return errors.New("failed to commit container, docker engine returned " + resp.Status)
+	}
+	return nil
+}
+
+// Get size of image in docker engine of the node
+func (s *Snapshotter) GetImageSize(nodeIp, imageName string) (int64, error) {
+	resp, err := s.httpClient.Get(s.engineUrl(nodeIp) + "/images/" + imageName + "/json")
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.New("failed to inspect image, docker engine returned " + resp.Status)
+	}
+
+	var inspect imageInspect
+	err = json.NewDecoder(resp.Body).Decode(&inspect)
+	if err != nil {
+		return 0, err
+	}
+	return inspect.Size, nil
+}
+
+// Open image archive of image, image is exported from docker engine of the node on first use and the archive
+// is cached so that downloads starting from any chunk do not export it again. Caller closes the archive
+func (s *Snapshotter) OpenExport(nodeIp, imageName, imageId string) (*os.File, error) {
+	value, _ := s.exportMutexes.LoadOrStore(imageId, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	exportPath := s.getExportPath(imageId)
+	if archive, err := os.Open(exportPath); err == nil {
+		return archive, nil
+	}
+
+	err := os.MkdirAll(s.cacheDir, 0750)
+	if err != nil {
+		return nil, errors.New("failed to create image archive cache directory")
+	}
+	archive, err := s.Export(nodeIp, imageName)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	// Archive is renamed in place once complete, so that partially exported archives are never used
+	tmpFile, err := ioutil.TempFile(s.cacheDir, imageId+".*.tmp")
+	if err != nil {
+		return nil, errors.New("failed to create image archive")
+	}
+	_, err = io.Copy(tmpFile, archive)
+	closeErr := tmpFile.Close()
+	if err == nil && closeErr == nil {
+		err = os.Chmod(tmpFile.Name(), exportFilePerm)
+	}
+	if err == nil && closeErr == nil {
+		err = os.Rename(tmpFile.Name(), exportPath)
+	}
+	if err != nil || closeErr != nil {
+		_ = os.Remove(tmpFile.Name())
+		return nil, errors.New("failed to export image archive")
+	}
+	return os.Open(exportPath)
+}
+
+// Remove cached image archive of image
+func (s *Snapshotter) RemoveExport(imageId string) {
+	value, _ := s.exportMutexes.LoadOrStore(imageId, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	err := os.Remove(s.getExportPath(imageId))
+	if err != nil && !os.IsNotExist(err) {
+		log.Error("failed to remove cached image archive of image " + imageId)
+	}
+	s.exportMutexes.Delete(imageId)
+}
+
+// Export image from docker engine of the node as image archive, caller closes the archive
+func (s *Snapshotter) Export(nodeIp, imageName string) (io.ReadCloser, error) {
+	resp, err := s.httpClient.Get(s.engineUrl(nodeIp) + "/images/" + imageName + "/get")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.New("failed to export image, docker engine returned " + resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// Delete image from docker engine of the node
+func (s *Snapshotter) DeleteImage(nodeIp, imageName string) error {
+	req, err := http.NewRequest(http.MethodDelete, s.engineUrl(nodeIp)+"/images/"+imageName, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return errors.New("failed to delete image " + imageName + ", docker engine returned " + resp.Status)
+	}
+	return nil
+}
+
+// Get docker engine url of the node
+func (s *Snapshotter) engineUrl(nodeIp string) string {
+	return "https://" + nodeIp + ":" + s.dockerPort
+}
+
+// Get path of cached image archive
+func (s *Snapshotter) getExportPath(imageId string) string {
+	return filepath.Join(s.cacheDir, imageId+".tar")
+}
+
+// Get status of the container in pod
+func getContainerStatus(pod *v1.Pod, containerName string) (*v1.ContainerStatus, error) {
+	for i := range pod.Status.ContainerStatuses {
+		status := &pod.Status.ContainerStatuses[i]
+		if containerName == "" || status.Name == containerName {
+			if status.ContainerID == "" {
+				return nil, errors.New("container " + status.Name + " is not started")
+			}
+			return status, nil
+		}
+	}
+	return nil, errors.New("container is not found in pod " + pod.Name)
+}
+
+// Split image name to repository and tag
+func splitImageName(imageName string) (string, string) {
+	index := strings.LastIndex(imageName, ":")
+	if index < 0 {
+		return imageName, "latest"
+	}
+	return imageName[:index], imageName[index+1:]
+}
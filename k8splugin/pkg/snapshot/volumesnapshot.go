@@ -0,0 +1,152 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"strconv"
+	"strings"
+)
+
+const volumeSnapshotPrefix = "mecm-snapshot-"
+
+// Volume snapshot resource of csi snapshot controller
+var VolumeSnapshotResource = schema.GroupVersionResource{
+	Group:    "snapshot.storage.k8s.io",
+	Version:  "v1",
+	Resource: "volumesnapshots",
+}
+
+// Status of csi volume snapshots of an image
+type VolumeSnapshotStatus struct {
+	Ready  bool
+	Failed bool
+	Size   int64
+}
+
+// Create csi volume snapshots of persistent volume claims of the pod, returns names of the snapshots.
+// Snapshots are kept in the cluster and are restored through the volume snapshot data source of new claims
+func (s *Snapshotter) CreateVolumeSnapshots(client dynamic.Interface, container *Container,
+	imageId string) ([]string, error) {
+	var names []string
+	for i, claimName := range container.ClaimNames {
+		name := volumeSnapshotPrefix + imageId + "-" + strconv.Itoa(i)
+		spec := map[string]interface{}{
+			"source": map[string]interface{}{
+				"persistentVolumeClaimName": claimName,
+			},
+		}
+		if s.snapshotClass != "" {
+			spec["volumeSnapshotClassName"] = s.snapshotClass
+		}
+		volumeSnapshot := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": VolumeSnapshotResource.GroupVersion().String(),
+			"kind":       "VolumeSnapshot",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": container.Namespace,
+			},
+			"spec": spec,
+		}}
+
+		log.WithFields(log.Fields{
+			"pod":      container.PodName,
+			"claim":    claimName,
+			"snapshot": name,
+		}).Info("create volume snapshot")
+
+		_, err := client.Resource(VolumeSnapshotResource).Namespace(container.Namespace).Create(context.Background(),
+			volumeSnapshot, metav1.CreateOptions{})
+		if err != nil {
+			log.Error("failed to create volume snapshot " + name)
+			_ = s.DeleteVolumeSnapshots(client, container.Namespace, names)
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// Get status of csi volume snapshots, snapshots are ready when all of them are ready to use and size is
+// the sum of their restore sizes. Snapshots failed when any of them has an error or was removed
+func (s *Snapshotter) GetVolumeSnapshotStatus(client dynamic.Interface, namespace string,
+	names []string) (*VolumeSnapshotStatus, error) {
+	snapshotStatus := &VolumeSnapshotStatus{Ready: true}
+	for _, name := range names {
+		volumeSnapshot, err := client.Resource(VolumeSnapshotResource).Namespace(namespace).Get(context.Background(),
+			name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			log.Error("volume snapshot " + name + " is not found")
+			return &VolumeSnapshotStatus{Failed: true}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		message, found, _ := unstructured.NestedString(volumeSnapshot.Object, "status", "error", "message")
+		if found {
+			log.Error("volume snapshot " + name + " failed: " + message)
+			return &VolumeSnapshotStatus{Failed: true}, nil
+		}
+		ready, _, _ := unstructured.NestedBool(volumeSnapshot.Object, "status", "readyToUse")
+		if !ready {
+			snapshotStatus.Ready = false
+			continue
+		}
+		restoreSize, found, _ := unstructured.NestedString(volumeSnapshot.Object, "status", "restoreSize")
+		if found {
+			quantity, err := resource.ParseQuantity(restoreSize)
+			if err == nil {
+				snapshotStatus.Size += quantity.Value()
+			}
+		}
+	}
+	return snapshotStatus, nil
+}
+
+// Delete csi volume snapshots, snapshots which do not exist are ignored
+func (s *Snapshotter) DeleteVolumeSnapshots(client dynamic.Interface, namespace string, names []string) error {
+	for _, name := range names {
+		err := client.Resource(VolumeSnapshotResource).Namespace(namespace).Delete(context.Background(), name,
+			metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return errors.New("failed to delete volume snapshot " + name + ": " + err.Error())
+		}
+	}
+	return nil
+}
+
+// Join names of volume snapshots as stored in image record
+func JoinVolumeSnapshots(names []string) string {
+	return strings.Join(names, ",")
+}
+
+// Split names of volume snapshots as stored in image record
+func SplitVolumeSnapshots(names string) []string {
+	if names == "" {
+		return nil
+	}
+	return strings.Split(names, ",")
+}
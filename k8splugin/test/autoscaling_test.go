@@ -0,0 +1,109 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+	"k8splugin/models"
+	"k8splugin/pkg/adapter"
+	"k8splugin/util"
+	"testing"
+)
+
+const autoscalingManifest = `
+---
+# Source: etherpad/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: etherpad
+---
+# Source: etherpad/templates/statefulset.yaml
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: etherpad-db
+`
+
+// List autoscalers of the namespace
+func listAutoscalers(t *testing.T, clientset *fake.Clientset) []autoscalingv2.HorizontalPodAutoscaler {
+	list, err := clientset.AutoscalingV2beta2().HorizontalPodAutoscalers("default").List(context.Background(),
+		metav1.ListOptions{})
+	assert.Nil(t, err, "List autoscalers failed")
+	return list.Items
+}
+
+func TestApplyAutoscalers(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	policy := &models.AutoscalingPolicy{MinReplicas: 1, MaxReplicas: 5, TargetCpuUtilization: 80}
+
+	err := adapter.ApplyAutoscalers(clientset, autoscalingManifest, "default", appInstanceIdentifier, policy)
+	assert.Nil(t, err, "TestApplyAutoscalers execution result")
+	autoscalers := listAutoscalers(t, clientset)
+	assert.Equal(t, 2, len(autoscalers), "TestApplyAutoscalers autoscalers are not created")
+
+	autoscaler := autoscalers[0]
+	assert.Equal(t, "deployment-etherpad", autoscaler.Name, "TestApplyAutoscalers autoscaler name is wrong")
+	assert.Equal(t, appInstanceIdentifier, autoscaler.Labels[util.AppInstanceLabel],
+		"TestApplyAutoscalers autoscaler label is wrong")
+	assert.Equal(t, "etherpad", autoscaler.Spec.ScaleTargetRef.Name, "TestApplyAutoscalers target is wrong")
+	assert.Equal(t, int32(5), autoscaler.Spec.MaxReplicas, "TestApplyAutoscalers max replicas is wrong")
+	assert.Equal(t, 1, len(autoscaler.Spec.Metrics), "TestApplyAutoscalers metrics are wrong")
+	assert.Equal(t, int32(80), *autoscaler.Spec.Metrics[0].Resource.Target.AverageUtilization,
+		"TestApplyAutoscalers cpu target is wrong")
+
+	// Policy update is applied to existing autoscalers, autoscalers of removed workloads are deleted
+	policy = &models.AutoscalingPolicy{MinReplicas: 2, MaxReplicas: 4, TargetCpuUtilization: 70,
+		TargetMemoryUtilization: 60}
+	err = adapter.ApplyAutoscalers(clientset, workloadManifest, "default", appInstanceIdentifier, policy)
+	assert.Nil(t, err, "TestApplyAutoscalers execution result")
+	autoscalers = listAutoscalers(t, clientset)
+	assert.Equal(t, 1, len(autoscalers), "TestApplyAutoscalers stale autoscaler is not deleted")
+	assert.Equal(t, int32(2), *autoscalers[0].Spec.MinReplicas, "TestApplyAutoscalers autoscaler is not updated")
+	assert.Equal(t, 2, len(autoscalers[0].Spec.Metrics), "TestApplyAutoscalers autoscaler is not updated")
+
+	err = adapter.DeleteAutoscalers(clientset, "default", appInstanceIdentifier)
+	assert.Nil(t, err, "TestApplyAutoscalers execution result")
+	assert.Empty(t, listAutoscalers(t, clientset), "TestApplyAutoscalers autoscalers are not deleted")
+}
+
+func TestApplyAutoscalersFailure(t *testing.T) {
+	policy := &models.AutoscalingPolicy{MinReplicas: 1, MaxReplicas: 5, TargetCpuUtilization: 80}
+	err := adapter.ApplyAutoscalers(fake.NewSimpleClientset(), "kind: Service\nmetadata:\n  name: svc\n",
+		"default", appInstanceIdentifier, policy)
+	assert.NotNil(t, err, "TestApplyAutoscalersFailure release without workload is autoscaled")
+}
+
+func TestCheckMetricsServer(t *testing.T) {
+	mc := metricsfake.NewSimpleClientset()
+	err := adapter.CheckMetricsServer(mc, "default")
+	assert.Nil(t, err, "TestCheckMetricsServer execution result")
+
+	mc.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("the server could not find the requested resource")
+	})
+	err = adapter.CheckMetricsServer(mc, "default")
+	assert.Equal(t, util.MetricsServerNotAvailable, err.Error(), "TestCheckMetricsServer unavailable is not detected")
+}
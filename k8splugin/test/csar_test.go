@@ -0,0 +1,338 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"archive/zip"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"github.com/stretchr/testify/assert"
+	"go.mozilla.org/pkcs7"
+	"io"
+	"io/ioutil"
+	"k8splugin/pkg/csar"
+	"math/big"
+	mathrand "math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	csarPackage  = "e17d23de-e562-4c81-b242-0d3926a2255f.csar"
+	csarManifest = "etherpad.mf"
+)
+
+var extractLimits = csar.ExtractLimits{MaxFiles: 10, MaxFileSize: 64, MaxTotalSize: 100}
+
+// Entry of crafted package archive
+type archiveEntry struct {
+	name    string
+	content string
+	mode    os.FileMode
+}
+
+// Create crafted package archive
+func createTestArchive(t *testing.T, dir string, entries []archiveEntry) string {
+	archivePath := filepath.Join(dir, "package.csar")
+	archiveFile, err := os.Create(archivePath)
+	assert.Nil(t, err, "create package archive")
+	defer archiveFile.Close()
+
+	writer := zip.NewWriter(archiveFile)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name, Method: zip.Deflate}
+		mode := entry.mode
+		if mode == 0 {
+			mode = 0640
+		}
+		header.SetMode(mode)
+		fileWriter, err := writer.CreateHeader(header)
+		assert.Nil(t, err, "add package archive entry")
+		_, _ = fileWriter.Write([]byte(entry.content))
+	}
+	assert.Nil(t, writer.Close(), "close package archive")
+	return archivePath
+}
+
+// Check that no file is extracted outside of target directory
+func assertExtractedWithin(t *testing.T, sandboxDir string, targetDir string) {
+	_ = filepath.Walk(sandboxDir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() && !strings.HasSuffix(path, "package.csar") {
+			assert.True(t, strings.HasPrefix(path, targetDir+string(os.PathSeparator)),
+				"file "+path+" is extracted outside of target directory")
+		}
+		return nil
+	})
+}
+
+// Extract test package to temporary directory
+func extractTestPackage(t *testing.T) string {
+	packageDir, err := ioutil.TempDir("", "csar")
+	assert.Nil(t, err, "create package directory")
+
+	zipReader, err := zip.OpenReader(csarPackage)
+	assert.Nil(t, err, "open test package")
+	defer zipReader.Close()
+
+	for _, file := range zipReader.File {
+		target := filepath.Join(packageDir, file.Name)
+		if file.FileInfo().IsDir() {
+			_ = os.MkdirAll(target, filePermission)
+			continue
+		}
+		_ = os.MkdirAll(filepath.Dir(target), filePermission)
+		reader, _ := file.Open()
+		writer, _ := os.Create(target)
+		_, _ = io.Copy(writer, reader)
+		writer.Close()
+		reader.Close()
+	}
+	return packageDir
+}
+
+// Extract test package and list all its files with their digests in manifest
+func extractListedTestPackage(t *testing.T) string {
+	packageDir := extractTestPackage(t)
+	manifestPath := filepath.Join(packageDir, csarManifest)
+	manifest, err := ioutil.ReadFile(manifestPath)
+	assert.Nil(t, err, "read manifest")
+	content := string(manifest)
+	if index := strings.Index(content, "Source:"); index >= 0 {
+		content = content[:index]
+	}
+
+	_ = filepath.Walk(packageDir, func(path string, info os.FileInfo, err error) error {
+		name, _ := filepath.Rel(packageDir, path)
+		name = filepath.ToSlash(name)
+		if err != nil || !info.Mode().IsRegular() || name == csarManifest || name == csar.ToscaMetaPath {
+			return nil
+		}
+		file, _ := ioutil.ReadFile(path)
+		digest := sha256.Sum256(file)
+		content += "Source: " + name + "\nAlgorithm: SHA-256\nHash: " + hex.EncodeToString(digest[:]) + "\n\n"
+		return nil
+	})
+	_ = ioutil.WriteFile(manifestPath, []byte(content), filePermission)
+	return packageDir
+}
+
+// Sign manifest of package with a new self signed certificate and return the certificate in PEM format
+func signTestPackage(t *testing.T, packageDir string) []byte {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "package signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certBytes, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	cert, _ := x509.ParseCertificate(certBytes)
+
+	manifest, _ := ioutil.ReadFile(filepath.Join(packageDir, csarManifest))
+	signedData, err := pkcs7.NewSignedData(manifest)
+	assert.Nil(t, err, "create signed data")
+	assert.Nil(t, signedData.AddSigner(cert, key, pkcs7.SignerInfoConfig{}), "add signer")
+	signedData.Detach()
+	signature, err := signedData.Finish()
+	assert.Nil(t, err, "sign manifest")
+	_ = ioutil.WriteFile(filepath.Join(packageDir, "etherpad.cms"), signature, filePermission)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+}
+
+func TestValidatePackageSuccess(t *testing.T) {
+	packageDir := extractListedTestPackage(t)
+	defer os.RemoveAll(packageDir)
+
+	validator, _ := csar.NewValidator("", csar.ValidatorOptions{})
+	err := validator.Validate(packageDir)
+	assert.Nil(t, err, "TestValidatePackageSuccess execution result")
+}
+
+func TestValidatePackageDigestMismatch(t *testing.T) {
+	packageDir := extractListedTestPackage(t)
+	defer os.RemoveAll(packageDir)
+	_ = ioutil.WriteFile(filepath.Join(packageDir, "APPD/Definition/MainServiceTemplate.yaml"),
+		[]byte("tampered"), filePermission)
+
+	validator, _ := csar.NewValidator("", csar.ValidatorOptions{})
+	err := validator.Validate(packageDir)
+	assert.Equal(t, csar.ValidationErrors{{File: "APPD/Definition/MainServiceTemplate.yaml",
+		Message: "digest does not match manifest"}}, err, "TestValidatePackageDigestMismatch execution result")
+}
+
+func TestValidatePackageUnlistedFile(t *testing.T) {
+	packageDir := extractListedTestPackage(t)
+	defer os.RemoveAll(packageDir)
+	_ = ioutil.WriteFile(filepath.Join(packageDir, "Artifacts/Other/unlisted.sh"), []byte("unlisted"),
+		filePermission)
+
+	validator, _ := csar.NewValidator("", csar.ValidatorOptions{})
+	err := validator.Validate(packageDir)
+	assert.Equal(t, csar.ValidationErrors{{File: "Artifacts/Other/unlisted.sh",
+		Message: "file is not listed in manifest"}}, err, "TestValidatePackageUnlistedFile execution result")
+}
+
+func TestValidatePackageManifestNotEnforced(t *testing.T) {
+	// Test package is created before manifest validation, digests are placeholders and files are not listed
+	packageDir := extractTestPackage(t)
+	defer os.RemoveAll(packageDir)
+
+	validator, _ := csar.NewValidator("", csar.ValidatorOptions{})
+	assert.Error(t, validator.Validate(packageDir), "TestValidatePackageManifestNotEnforced execution result")
+
+	validator, _ = csar.NewValidator("", csar.ValidatorOptions{ManifestNotEnforced: true})
+	assert.Nil(t, validator.Validate(packageDir), "TestValidatePackageManifestNotEnforced execution result")
+}
+
+func TestValidatePackageVmRejected(t *testing.T) {
+	packageDir := extractListedTestPackage(t)
+	defer os.RemoveAll(packageDir)
+	manifestPath := filepath.Join(packageDir, csarManifest)
+	manifest, _ := ioutil.ReadFile(manifestPath)
+	_ = ioutil.WriteFile(manifestPath, []byte(strings.Replace(string(manifest), "app_type: container",
+		"app_type: vm", 1)), filePermission)
+
+	validator, _ := csar.NewValidator("", csar.ValidatorOptions{})
+	err := validator.Validate(packageDir)
+	assert.Equal(t, csar.ValidationErrors{{File: csarManifest, Message: "vm application packages are not supported"}},
+		err, "TestValidatePackageVmRejected execution result")
+}
+
+func TestValidatePackageMissingMetadataAndCharts(t *testing.T) {
+	packageDir := extractListedTestPackage(t)
+	defer os.RemoveAll(packageDir)
+	_ = os.Remove(filepath.Join(packageDir, csar.ToscaMetaPath))
+	_ = os.RemoveAll(filepath.Join(packageDir, csar.ChartsPath))
+
+	validator, _ := csar.NewValidator("", csar.ValidatorOptions{})
+	err := validator.Validate(packageDir)
+	validationErrs, ok := err.(csar.ValidationErrors)
+	assert.True(t, ok, "TestValidatePackageMissingMetadataAndCharts execution result")
+	files := make([]string, 0)
+	for _, validationErr := range validationErrs {
+		files = append(files, validationErr.File)
+	}
+	assert.Contains(t, files, csar.ToscaMetaPath, "TestValidatePackageMissingMetadataAndCharts execution result")
+	assert.Contains(t, files, csar.ChartsPath, "TestValidatePackageMissingMetadataAndCharts execution result")
+}
+
+func TestValidatePackageSignature(t *testing.T) {
+	packageDir := extractListedTestPackage(t)
+	defer os.RemoveAll(packageDir)
+
+	trustStoreFile, _ := ioutil.TempFile("", "truststore")
+	trustStoreFile.Close()
+	defer os.Remove(trustStoreFile.Name())
+	_ = ioutil.WriteFile(trustStoreFile.Name(), signTestPackage(t, packageDir), filePermission)
+	validator, err := csar.NewValidator(trustStoreFile.Name(), csar.ValidatorOptions{})
+	assert.Nil(t, err, "TestValidatePackageSignature execution result")
+	assert.Nil(t, validator.Validate(packageDir), "TestValidatePackageSignature execution result")
+
+	// Manifest signed again by a certificate which is not trusted
+	_ = signTestPackage(t, packageDir)
+	assert.Error(t, validator.Validate(packageDir), "TestValidatePackageSignature execution result")
+}
+
+func TestExtractPackageSuccess(t *testing.T) {
+	targetDir, _ := ioutil.TempDir("", "csar")
+	defer os.RemoveAll(targetDir)
+
+	err := csar.Extract(csarPackage, targetDir, csar.ExtractLimits{MaxFiles: 1024, MaxFileSize: 0x6400000,
+		MaxTotalSize: 0x6400000})
+	assert.Nil(t, err, "TestExtractPackageSuccess execution result")
+	_, err = os.Stat(filepath.Join(targetDir, csar.ToscaMetaPath))
+	assert.Nil(t, err, "TestExtractPackageSuccess execution result")
+}
+
+func TestExtractPackageRejected(t *testing.T) {
+	cases := map[string][]archiveEntry{
+		"path traversal":   {{name: "../evil.txt", content: "evil"}},
+		"nested traversal": {{name: "Artifacts/../../evil.txt", content: "evil"}},
+		"absolute path":    {{name: "/tmp/evil.txt", content: "evil"}},
+		"backslash path":   {{name: "..\\evil.txt", content: "evil"}},
+		"symlink":          {{name: "link", content: "/etc/passwd", mode: os.ModeSymlink | 0777}},
+		"file too big":     {{name: "big.txt", content: strings.Repeat("a", 65)}},
+		"total too big":    {{name: "a.txt", content: strings.Repeat("a", 60)}, {name: "b.txt", content: strings.Repeat("b", 60)}},
+		"too many files": {{name: "1"}, {name: "2"}, {name: "3"}, {name: "4"}, {name: "5"}, {name: "6"},
+			{name: "7"}, {name: "8"}, {name: "9"}, {name: "10"}, {name: "11"}},
+	}
+	for name, entries := range cases {
+		sandboxDir, _ := ioutil.TempDir("", "csar")
+		targetDir := filepath.Join(sandboxDir, "package")
+		archivePath := createTestArchive(t, sandboxDir, entries)
+
+		err := csar.Extract(archivePath, targetDir, extractLimits)
+		assert.Error(t, err, "TestExtractPackageRejected "+name)
+		assertExtractedWithin(t, sandboxDir, targetDir)
+		_, err = os.Stat(targetDir)
+		assert.True(t, os.IsNotExist(err), "TestExtractPackageRejected "+name+" package directory is removed")
+		os.RemoveAll(sandboxDir)
+	}
+}
+
+func TestExtractPackageRejectedNextToArchive(t *testing.T) {
+	sandboxDir, _ := ioutil.TempDir("", "csar")
+	defer os.RemoveAll(sandboxDir)
+	archivePath := createTestArchive(t, sandboxDir, []archiveEntry{
+		{name: "Artifacts/a.txt", content: strings.Repeat("a", 60)},
+		{name: "b.txt", content: strings.Repeat("b", 60)}})
+
+	err := csar.Extract(archivePath, sandboxDir, extractLimits)
+	assert.Error(t, err, "TestExtractPackageRejectedNextToArchive execution result")
+	files, _ := ioutil.ReadDir(sandboxDir)
+	assert.Equal(t, 1, len(files), "TestExtractPackageRejectedNextToArchive extracted files are removed")
+	assert.Equal(t, "package.csar", files[0].Name(), "TestExtractPackageRejectedNextToArchive archive is kept")
+}
+
+func TestExtractPackageNotArchive(t *testing.T) {
+	err := csar.Extract("config.yaml", os.TempDir(), extractLimits)
+	assert.Error(t, err, "TestExtractPackageNotArchive execution result")
+}
+
+func TestExtractPackageRandomNames(t *testing.T) {
+	fragments := []string{"..", ".", "a", "b", "/", "\\", "", "Artifacts"}
+	random := mathrand.New(mathrand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		entries := make([]archiveEntry, 0)
+		for j := 0; j < 1+random.Intn(3); j++ {
+			name := ""
+			for k := 0; k < 1+random.Intn(6); k++ {
+				name += fragments[random.Intn(len(fragments))]
+			}
+			entries = append(entries, archiveEntry{name: name, content: "x"})
+		}
+
+		sandboxDir, _ := ioutil.TempDir("", "csar")
+		targetDir := filepath.Join(sandboxDir, "package")
+		archivePath := createTestArchive(t, sandboxDir, entries)
+		_ = csar.Extract(archivePath, targetDir, extractLimits)
+		assertExtractedWithin(t, sandboxDir, targetDir)
+		os.RemoveAll(sandboxDir)
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8splugin/models"
+	"k8splugin/pkg/adapter"
+	"strings"
+	"testing"
+)
+
+const endpointsManifest = `
+---
+# Source: etherpad/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: etherpad-svc
+---
+# Source: etherpad/templates/service-lb.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: etherpad-lb
+---
+# Source: etherpad/templates/ingress.yaml
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: etherpad-ingress
+---
+# Source: etherpad/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: etherpad
+`
+
+// Get clientset of cluster which serves ingresses of the api versions
+func getEndpointsClientset(apiVersions []string, objects ...runtime.Object) *fake.Clientset {
+	clientset := fake.NewSimpleClientset(objects...)
+	for _, apiVersion := range apiVersions {
+		clientset.Resources = append(clientset.Resources, &metav1.APIResourceList{GroupVersion: apiVersion,
+			APIResources: []metav1.APIResource{{Name: "ingresses", Namespaced: true, Kind: "Ingress"}}})
+	}
+	return clientset
+}
+
+// Get ingress object of the api version with the spec
+func getIngressObject(apiVersion string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       "Ingress",
+		"metadata":   map[string]interface{}{"name": "etherpad-ingress", "namespace": "default"},
+		"spec":       spec,
+		"status": map[string]interface{}{"loadBalancer": map[string]interface{}{
+			"ingress": []interface{}{map[string]interface{}{"hostname": "lb.example.com"}}}},
+	}}
+}
+
+func TestGetEndpoints(t *testing.T) {
+	nodePortService := &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "etherpad-svc", Namespace: "default"},
+		Spec: v1.ServiceSpec{Type: v1.ServiceTypeNodePort, ClusterIP: "10.96.0.10",
+			Ports: []v1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 9001,
+				TargetPort: intstr.FromString("http"), NodePort: 30901}}}}
+	otherService := &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "other-svc", Namespace: "default"}}
+	clientset := getEndpointsClientset([]string{"networking.k8s.io/v1"}, nodePortService, otherService)
+	ingress := getIngressObject("networking.k8s.io/v1", map[string]interface{}{
+		"tls": []interface{}{map[string]interface{}{"hosts": []interface{}{"pad.example.com"}}},
+		"rules": []interface{}{map[string]interface{}{"host": "pad.example.com", "http": map[string]interface{}{
+			"paths": []interface{}{map[string]interface{}{"pathType": "Prefix", "backend": map[string]interface{}{
+				"service": map[string]interface{}{"name": "etherpad-svc",
+					"port": map[string]interface{}{"number": int64(9001)}}}}}}}},
+	})
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), ingress)
+
+	endpointsInfo, err := adapter.GetEndpoints(clientset, dynamicClient, endpointsManifest, "default")
+	assert.Nil(t, err, "TestGetEndpoints execution result")
+	assert.Equal(t, []models.ServiceEndpoint{{Name: "etherpad-svc", Namespace: "default", Type: "NodePort",
+		ClusterIP: "10.96.0.10", Ports: []models.ServicePort{{Name: "http", Protocol: "TCP", Port: 9001,
+			TargetPort: "http", NodePort: 30901}}}}, endpointsInfo.Services,
+		"TestGetEndpoints services are wrong")
+	assert.Equal(t, []models.IngressEndpoint{{Name: "etherpad-ingress", Namespace: "default",
+		Addresses: []string{"lb.example.com"}, Paths: []models.IngressPath{{Host: "pad.example.com", Path: "/",
+			Tls: true, ServiceName: "etherpad-svc", ServicePort: "9001"}}}}, endpointsInfo.Ingresses,
+		"TestGetEndpoints ingresses are wrong")
+}
+
+func TestGetEndpointsBetaIngress(t *testing.T) {
+	manifest := strings.Replace(endpointsManifest, "networking.k8s.io/v1", "networking.k8s.io/v1beta1", 1)
+	clientset := getEndpointsClientset([]string{"networking.k8s.io/v1beta1"})
+	ingress := getIngressObject("networking.k8s.io/v1beta1", map[string]interface{}{
+		"backend": map[string]interface{}{"serviceName": "etherpad-svc", "servicePort": "http"},
+	})
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), ingress)
+
+	endpointsInfo, err := adapter.GetEndpoints(clientset, dynamicClient, manifest, "default")
+	assert.Nil(t, err, "TestGetEndpointsBetaIngress execution result")
+	assert.Equal(t, []models.IngressPath{{Path: "/", ServiceName: "etherpad-svc", ServicePort: "http"}},
+		endpointsInfo.Ingresses[0].Paths, "TestGetEndpointsBetaIngress default backend is wrong")
+
+	// Ingress api which is not served by the cluster is reported instead of skipping the ingress
+	clientset = getEndpointsClientset([]string{"networking.k8s.io/v1"})
+	_, err = adapter.GetEndpoints(clientset, dynamicClient, manifest, "default")
+	assert.NotNil(t, err, "TestGetEndpointsBetaIngress unavailable ingress api is not reported")
+}
+
+func TestGetEndpointsLoadBalancer(t *testing.T) {
+	loadBalancer := &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "etherpad-lb", Namespace: "default"},
+		Spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, ExternalIPs: []string{"192.168.1.10"},
+			Ports: []v1.ServicePort{{Protocol: v1.ProtocolUDP, Port: 53, TargetPort: intstr.FromInt(5353)}}},
+		Status: v1.ServiceStatus{LoadBalancer: v1.LoadBalancerStatus{
+			Ingress: []v1.LoadBalancerIngress{{IP: "172.16.0.5"}}}}}
+	clientset := getEndpointsClientset([]string{"networking.k8s.io/v1"}, loadBalancer)
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	endpointsInfo, err := adapter.GetEndpoints(clientset, dynamicClient, endpointsManifest, "default")
+	assert.Nil(t, err, "TestGetEndpointsLoadBalancer execution result")
+	assert.Equal(t, 1, len(endpointsInfo.Services), "TestGetEndpointsLoadBalancer removed services are returned")
+	assert.Equal(t, []string{"172.16.0.5"}, endpointsInfo.Services[0].LoadBalancerAddresses,
+		"TestGetEndpointsLoadBalancer load balancer addresses are wrong")
+	assert.Equal(t, []string{"192.168.1.10"}, endpointsInfo.Services[0].ExternalIPs,
+		"TestGetEndpointsLoadBalancer external IPs are wrong")
+	assert.Equal(t, "5353", endpointsInfo.Services[0].Ports[0].TargetPort,
+		"TestGetEndpointsLoadBalancer target port is wrong")
+	assert.Equal(t, 0, len(endpointsInfo.Ingresses), "TestGetEndpointsLoadBalancer removed ingress is returned")
+}
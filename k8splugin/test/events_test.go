@@ -0,0 +1,110 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8splugin/models"
+	"k8splugin/pkg/adapter"
+	"k8splugin/util"
+	"testing"
+	"time"
+)
+
+const eventsManifest = `
+---
+# Source: etherpad/templates/statefulset.yaml
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: etherpad
+  labels:
+    app: etherpad
+spec:
+  template:
+    metadata:
+      labels:
+        app: etherpad
+---
+# Source: etherpad/templates/daemonset.yaml
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: etherpad-agent
+spec:
+  template:
+    metadata:
+      labels:
+        component: etherpad-agent
+---
+# Source: etherpad/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: etherpad-svc
+`
+
+// Create event of the involved object
+func getEvent(name string, kind string, objectName string, eventType string, lastTimestamp time.Time) *v1.Event {
+	return &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		InvolvedObject: v1.ObjectReference{Kind: kind, Name: objectName, Namespace: "default"},
+		Type:           eventType, Reason: "Reason", Message: "message of " + name + "\n", Count: 2,
+		FirstTimestamp: metav1.NewTime(lastTimestamp.Add(-time.Minute)), LastTimestamp: metav1.NewTime(lastTimestamp)}
+}
+
+func TestGetWorkloadEvents(t *testing.T) {
+	start := time.Date(2020, 10, 1, 8, 0, 0, 0, time.UTC)
+	pod := getLabeledPod("etherpad-0", "etherpad")
+	pod.Spec.Volumes = []v1.Volume{{Name: "data", VolumeSource: v1.VolumeSource{
+		PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "data-etherpad-0"}}}}
+	agentPod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "etherpad-agent-x7k2p", Namespace: "default",
+		Labels: map[string]string{"component": "etherpad-agent"}}}
+	clientset := fake.NewSimpleClientset(pod, agentPod, getLabeledPod("other-0", "other"),
+		getEvent("pod-pulled", util.Pod, "etherpad-0", v1.EventTypeNormal, start.Add(2*time.Minute)),
+		getEvent("sts-created", util.StatefulSet, "etherpad", v1.EventTypeNormal, start),
+		getEvent("pvc-pending", util.PersistentVolumeClaim, "data-etherpad-0", v1.EventTypeWarning,
+			start.Add(time.Minute)),
+		getEvent("svc-sync", util.Service, "etherpad-svc", v1.EventTypeWarning, start.Add(3*time.Minute)),
+		getEvent("ds-created", util.DaemonSet, "etherpad-agent", v1.EventTypeNormal, start.Add(4*time.Minute)),
+		getEvent("other-pulled", util.Pod, "other-0", v1.EventTypeNormal, start))
+
+	eventsInfo, err := adapter.GetWorkloadEvents(clientset, eventsManifest, "default", nil)
+	assert.Nil(t, err, "TestGetWorkloadEvents execution result")
+	assert.Equal(t, 5, len(eventsInfo.Events), "TestGetWorkloadEvents events of other application are returned")
+	assert.Equal(t, models.WorkloadEvent{Kind: util.StatefulSet, Name: "etherpad", Type: v1.EventTypeNormal,
+		Reason: "Reason", Message: "message of sts-created", Count: 2, FirstTimestamp: "2020-10-01T07:59:00Z",
+		LastTimestamp: "2020-10-01T08:00:00Z"}, eventsInfo.Events[0], "TestGetWorkloadEvents event is wrong")
+	assert.Equal(t, util.PersistentVolumeClaim, eventsInfo.Events[1].Kind,
+		"TestGetWorkloadEvents events are not sorted")
+	assert.Equal(t, util.DaemonSet, eventsInfo.Events[4].Kind, "TestGetWorkloadEvents inventory events are missing")
+	assert.Equal(t, []models.PodDescInfo{{PodName: "etherpad-0", PodEventsInfo: []string{"message of pod-pulled"}},
+		{PodName: "etherpad-agent-x7k2p", PodEventsInfo: []string{"Pod is running successfully"}}},
+		eventsInfo.PodDescInfo, "TestGetWorkloadEvents pod events are wrong")
+
+	sinceTime := start.Add(time.Minute)
+	eventsInfo, err = adapter.GetWorkloadEvents(clientset, eventsManifest, "default",
+		&models.EventFilter{SinceTime: &sinceTime, Type: v1.EventTypeWarning})
+	assert.Nil(t, err, "TestGetWorkloadEvents execution result")
+	assert.Equal(t, 2, len(eventsInfo.Events), "TestGetWorkloadEvents events are not filtered")
+	assert.Equal(t, "data-etherpad-0", eventsInfo.Events[0].Name, "TestGetWorkloadEvents events are not filtered")
+	assert.Equal(t, "etherpad-svc", eventsInfo.Events[1].Name, "TestGetWorkloadEvents events are not filtered")
+	assert.Equal(t, []string{"Pod is running successfully"}, eventsInfo.PodDescInfo[0].PodEventsInfo,
+		"TestGetWorkloadEvents pod events are wrong")
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8splugin/pkg/adapter"
+	"k8splugin/util"
+	"testing"
+	"time"
+)
+
+const healthManifest = `
+---
+# Source: etherpad/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: etherpad
+  labels:
+    app: etherpad
+spec:
+  template:
+    metadata:
+      labels:
+        app: etherpad
+`
+
+// Create deployment with the ready replicas
+func getDeployment(replicas int32, readyReplicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "etherpad", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{Replicas: replicas, UpdatedReplicas: replicas,
+			ReadyReplicas: readyReplicas}}
+}
+
+// Create running pod of deployment with the container status
+func getHealthPod(name string, ready bool, containerStatus v1.ContainerStatus) *v1.Pod {
+	pod := getLabeledPod(name, "etherpad")
+	readyStatus := v1.ConditionFalse
+	if ready {
+		readyStatus = v1.ConditionTrue
+	}
+	pod.Status = v1.PodStatus{Phase: v1.PodRunning,
+		Conditions:        []v1.PodCondition{{Type: v1.PodReady, Status: readyStatus}},
+		ContainerStatuses: []v1.ContainerStatus{containerStatus}}
+	return pod
+}
+
+func TestGetHealthHealthy(t *testing.T) {
+	clientset := fake.NewSimpleClientset(getDeployment(1, 1),
+		getHealthPod("etherpad-0", true, v1.ContainerStatus{Name: "etherpad", RestartCount: 1}))
+
+	health, err := adapter.GetHealth(clientset, healthManifest, "default")
+	assert.Nil(t, err, "TestGetHealthHealthy execution result")
+	assert.Equal(t, util.Healthy, health.Status, "TestGetHealthHealthy status is wrong")
+	assert.Equal(t, 0, len(health.Reasons), "TestGetHealthHealthy reasons are wrong")
+}
+
+func TestGetHealthProgressing(t *testing.T) {
+	clientset := fake.NewSimpleClientset(getDeployment(2, 1),
+		getHealthPod("etherpad-0", true, v1.ContainerStatus{Name: "etherpad"}))
+
+	health, err := adapter.GetHealth(clientset, healthManifest, "default")
+	assert.Nil(t, err, "TestGetHealthProgressing execution result")
+	assert.Equal(t, util.Progressing, health.Status, "TestGetHealthProgressing status is wrong")
+	assert.Equal(t, []string{"deployment etherpad has 1 of 2 replicas ready"}, health.Reasons,
+		"TestGetHealthProgressing reasons are wrong")
+}
+
+func TestGetHealthDegraded(t *testing.T) {
+	event := getEvent("pod-unhealthy", util.Pod, "etherpad-0", v1.EventTypeWarning, time.Now())
+	oldEvent := getEvent("pod-old", util.Pod, "etherpad-0", v1.EventTypeWarning, time.Now().Add(-time.Hour))
+	clientset := fake.NewSimpleClientset(getDeployment(1, 1),
+		getHealthPod("etherpad-0", true, v1.ContainerStatus{Name: "etherpad", RestartCount: 6}), event, oldEvent)
+
+	health, err := adapter.GetHealth(clientset, healthManifest, "default")
+	assert.Nil(t, err, "TestGetHealthDegraded execution result")
+	assert.Equal(t, util.Degraded, health.Status, "TestGetHealthDegraded status is wrong")
+	assert.Equal(t, []string{"container etherpad of pod etherpad-0 restarted 6 times",
+		"warning event Reason of pod etherpad-0"}, health.Reasons, "TestGetHealthDegraded reasons are wrong")
+}
+
+func TestGetHealthFailed(t *testing.T) {
+	crashing := v1.ContainerStatus{Name: "etherpad", State: v1.ContainerState{
+		Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}}
+	clientset := fake.NewSimpleClientset(getDeployment(1, 0), getHealthPod("etherpad-0", false, crashing))
+
+	health, err := adapter.GetHealth(clientset, healthManifest, "default")
+	assert.Nil(t, err, "TestGetHealthFailed execution result")
+	assert.Equal(t, util.HealthFailed, health.Status, "TestGetHealthFailed status is wrong")
+	assert.Equal(t, "no pod of application is ready", health.Reasons[len(health.Reasons)-1],
+		"TestGetHealthFailed reasons are wrong")
+
+	deployment := getDeployment(1, 0)
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing,
+		Status: v1.ConditionFalse, Reason: "ProgressDeadlineExceeded"}}
+	health, err = adapter.GetHealth(fake.NewSimpleClientset(deployment), healthManifest, "default")
+	assert.Nil(t, err, "TestGetHealthFailed execution result")
+	assert.Equal(t, util.HealthFailed, health.Status, "TestGetHealthFailed progress deadline is not failed")
+
+	health, err = adapter.GetHealth(fake.NewSimpleClientset(), healthManifest, "default")
+	assert.Nil(t, err, "TestGetHealthFailed execution result")
+	assert.Equal(t, []string{"deployment etherpad does not exist"}, health.Reasons,
+		"TestGetHealthFailed missing deployment is not failed")
+}
@@ -25,6 +25,7 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8splugin/config"
@@ -35,6 +36,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 	restclient "k8s.io/client-go/rest"
 )
 var (
@@ -44,6 +46,7 @@ var (
 
 	tenantIdentifier      = "e921ce54-82c8-4532-b5c6-8516cf75f7a6"
 	packageId             = "e261211d80d04cb6aed00e5cd1f2cd11b5a6ca9b8f85477bba2cd66fd79d5f98"
+	sharedPackageId       = "f7a3c9d2b1e84f6a9c0d5e2b7a4f1c8d3e6b9a2c5f8d1e4b7a0c3f6d9e2b5a8c"
 	relName               = "example"
 	addValues             = "AddValues"
 	configFile                = "/usr/app/config/"
@@ -90,7 +93,7 @@ func testDeploySuccess(t *testing.T) {
 		HostIp: hostIpAddress,
 		PackageId: packageId,
 	}
-	result, _ := client.Deploy(appPkgRecord,  appInstanceIdentifier,  ak,  sk,
+	result, _ := client.Deploy(appPkgRecord,  appInstanceIdentifier,  ak,  sk, "default", nil, nil,
 		&mockK8sPluginDb{appInstanceRecords: make(map[string]models.AppInstanceInfo)})
 	assert.Equal(t, "", result, "TestGetReleaseNamespaceSuccess execution result")
 }
@@ -137,11 +140,61 @@ func testDeployFailure(t *testing.T) {
 		HostIp: hostIpAddress,
 		PackageId: packageId,
 	}
-	result, _ := client.Deploy(appPkgRec,  appInstanceIdentifier,  ak,  sk,
+	result, _ := client.Deploy(appPkgRec,  appInstanceIdentifier,  ak,  sk, "default", nil, nil,
 		&mockK8sPluginDb{appInstanceRecords: make(map[string]models.AppInstanceInfo)})
 	assert.Equal(t, "", result, "TestGetReleaseNamespaceSuccess execution result")
 }
 
+// Recorder of install options, options are copied as install action does not outlive the deploy call
+type installRecorder struct {
+	wait    bool
+	atomic  bool
+	timeout time.Duration
+}
+
+func (r *installRecorder) record(install *action.Install) {
+	r.wait = install.Wait
+	r.atomic = install.Atomic
+	r.timeout = install.Timeout
+}
+
+// Install options of the running test, patched install action records onto it so that recorders are not
+// captured from the test
+var deployInstalls = &installRecorder{}
+
+func testDeployNotReady(t *testing.T) {
+	var c *config.AppAuthConfigBuilder
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), addValues,
+		func(*config.AppAuthConfigBuilder, *os.File) (string, error) {
+			return "test", nil
+		})
+	defer patch1.Reset()
+
+	patch2 := gomonkey.ApplyFunc(loader.Load, func(_ string) (*chart.Chart, error) {
+		return &chart.Chart{Metadata: &chart.Metadata{Name: "etherpad"}}, nil
+	})
+	defer patch2.Reset()
+
+	deployInstalls = &installRecorder{}
+	var i *action.Install
+	patch3 := gomonkey.ApplyMethod(reflect.TypeOf(i), "Run",
+		func(install *action.Install, _ *chart.Chart, _ map[string]interface{}) (*release.Release, error) {
+			deployInstalls.record(install)
+			return &release.Release{}, fmt.Errorf("release failed, and has been uninstalled due to atomic "+
+				"being set: %w", wait.ErrWaitTimeout)
+		})
+	defer patch3.Reset()
+
+	client := &adapter.HelmClient{HostIP: ipAddress, Kubeconfig: configFile + ipAddress}
+	appPkgRec := &models.AppPackage{TenantId: tenantIdentifier, HostIp: hostIpAddress, PackageId: packageId}
+	_, err := client.Deploy(appPkgRec, appInstanceIdentifier, ak, sk, "default", nil,
+		&models.DeployOptions{WaitForReady: true, ReadyTimeout: time.Minute},
+		&mockK8sPluginDb{appInstanceRecords: make(map[string]models.AppInstanceInfo)})
+	assert.Equal(t, util.WorkloadsNotReady, err.Error(), "TestDeployNotReady reason is wrong")
+	assert.True(t, deployInstalls.wait && deployInstalls.atomic, "TestDeployNotReady install is not atomic")
+	assert.Equal(t, time.Minute, deployInstalls.timeout, "TestDeployNotReady timeout is wrong")
+}
+
 func testUnDeploySuccess(t *testing.T) {
 
 	patch1 := gomonkey.ApplyFunc(adapter.NewHelmClient, func(_ string) (*adapter.HelmClient, error) {
@@ -181,10 +234,35 @@ func testUnDeploySuccess(t *testing.T) {
 
 	client, _ := adapter.NewHelmClient(hostIpAddress)
 
-	result := client.UnDeploy(relName)
+	result := client.UnDeploy(relName, "default")
 	assert.Nil(t, result, "TestUnDeploySuccess execution result")
 }
 
+func testRollbackRestoredRevision(t *testing.T) {
+	var rb *action.Rollback
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(rb), "Run", func(*action.Rollback, string) error {
+		return nil
+	})
+	defer patch1.Reset()
+
+	var g *action.Get
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(g), "Run", func(*action.Get, string) (*release.Release, error) {
+		return &release.Release{Chart: &chart.Chart{Values: map[string]interface{}{
+			"appconfig": map[string]interface{}{
+				"apppackageid": packageId,
+				"aksk":         map[string]interface{}{"accesskey": ak, "secretkey": sk},
+			},
+		}}}, nil
+	})
+	defer patch2.Reset()
+
+	client := &adapter.HelmClient{HostIP: ipAddress, Kubeconfig: configFile + ipAddress}
+	restored, err := client.Rollback(relName, "default", 1)
+	assert.Nil(t, err, "TestRollbackRestoredRevision execution result")
+	assert.Equal(t, &models.RestoredRevision{PackageId: packageId, Ak: ak, Sk: sk}, restored,
+		"TestRollbackRestoredRevision restored revision is wrong")
+}
+
 func testWorkloadEvents(t *testing.T) {
 	patch1 := gomonkey.ApplyFunc(adapter.NewHelmClient, func(_ string) (*adapter.HelmClient, error) {
 		// do nothing
@@ -221,8 +299,8 @@ func testWorkloadEvents(t *testing.T) {
 	client, _ := adapter.NewHelmClient(hostIpAddress)
 	baseDir, _ := os.Getwd()
 	client.Kubeconfig = baseDir + directory + "/" + hostIpAddress
-	result, _ := client.WorkloadEvents(relName)
-	assert.Equal(t, "{\"pods\":null}", result, "Test workload events execution result")
+	result, _ := client.WorkloadEvents(relName, "default", nil)
+	assert.Equal(t, "{\"events\":[],\"pods\":null}", result, "Test workload events execution result")
 }
 
 func testQueryInfo(t *testing.T) {
@@ -261,6 +339,6 @@ func testQueryInfo(t *testing.T) {
 	client, _ := adapter.NewHelmClient(hostIpAddress)
 	baseDir, _ := os.Getwd()
 	client.Kubeconfig = baseDir + directory + "/" + hostIpAddress
-	result, _ := client.Query(relName)
+	result, _ := client.Query(relName, "default")
 	assert.Equal(t, "{\"pods\":null}", result, "Test query info execution result")
 }
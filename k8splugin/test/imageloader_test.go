@@ -0,0 +1,157 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"archive/tar"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"k8splugin/conf"
+	"k8splugin/models"
+	"k8splugin/pkg/imageloader"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var imageArchiveDescriptors = []models.SwImageDescriptor{{Name: "etherpad", SwImage: "Image/etherpad.tar"}}
+
+// Create package directory with an image archive in docker save format
+func createImagePackage(t *testing.T) string {
+	packagePath, err := ioutil.TempDir("", "image-package")
+	assert.Nil(t, err, "create package directory")
+	_ = os.Mkdir(filepath.Join(packagePath, "Image"), filePermission)
+
+	archiveFile, err := os.Create(filepath.Join(packagePath, "Image", "etherpad.tar"))
+	assert.Nil(t, err, "create image archive")
+	defer archiveFile.Close()
+
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"manifest.json", `[{"Config":"config.json","RepoTags":["119.8.36.45/developer/etherpad:latest"],` +
+			`"Layers":["layer1/layer.tar"]}]`},
+		{"config.json", `{"architecture":"amd64","os":"linux"}`},
+		{"layer1/layer.tar", "layer content"},
+	}
+	writer := tar.NewWriter(archiveFile)
+	for _, file := range files {
+		_ = writer.WriteHeader(&tar.Header{Name: file.name, Mode: 0600, Size: int64(len(file.content))})
+		_, _ = writer.Write([]byte(file.content))
+	}
+	_ = writer.Close()
+	return packagePath
+}
+
+func TestRegistryLoaderLoadAndDeleteImages(t *testing.T) {
+	packagePath := createImagePackage(t)
+	defer os.RemoveAll(packagePath)
+
+	registry := newMockRegistry()
+	registryServer := httptest.NewServer(registry)
+	defer registryServer.Close()
+	registryUrl, _ := url.Parse(registryServer.URL)
+
+	loader := imageloader.NewRegistryLoader(registryUrl.Host, true)
+	images, err := loader.LoadImages(hostIpAddress, packagePath, imageArchiveDescriptors)
+	assert.Nil(t, err, "TestRegistryLoaderLoadAndDeleteImages execution result")
+	assert.Equal(t, []string{registryUrl.Host + "/developer/etherpad:latest"}, images,
+		"TestRegistryLoaderLoadAndDeleteImages execution result")
+	assert.Equal(t, 2, len(registry.blobs), "TestRegistryLoaderLoadAndDeleteImages execution result")
+	assert.Contains(t, registry.manifests, "developer/etherpad/manifests/latest",
+		"TestRegistryLoaderLoadAndDeleteImages execution result")
+
+	err = loader.DeleteImages(hostIpAddress, images)
+	assert.Nil(t, err, "TestRegistryLoaderLoadAndDeleteImages execution result")
+	assert.Empty(t, registry.manifests, "TestRegistryLoaderLoadAndDeleteImages execution result")
+}
+
+func TestRuntimeLoaderLoadAndDeleteImages(t *testing.T) {
+	packagePath := createImagePackage(t)
+	defer os.RemoveAll(packagePath)
+
+	// Image only referenced by the package is used by other applications on the host
+	engine := newMockDockerEngine()
+	engine.images["nginx:1.19"] = true
+	engineServer, tlsConfig := startTLSDockerEngine(t, engine)
+	defer engineServer.Close()
+	engineUrl, _ := url.Parse(engineServer.URL)
+
+	loader := imageloader.NewRuntimeLoader(engineUrl.Port(), tlsConfig)
+	images, err := loader.LoadImages(engineUrl.Hostname(), packagePath,
+		append(imageArchiveDescriptors, models.SwImageDescriptor{Name: "nginx", SwImage: "nginx:1.19"}))
+	assert.Nil(t, err, "TestRuntimeLoaderLoadAndDeleteImages execution result")
+	assert.Equal(t, []string{"docker-engine://119.8.36.45/developer/etherpad:latest"}, images,
+		"TestRuntimeLoaderLoadAndDeleteImages execution result")
+	assert.Equal(t, 1, engine.loaded, "TestRuntimeLoaderLoadAndDeleteImages execution result")
+
+	err = loader.DeleteImages(engineUrl.Hostname(), append(images, "nginx:1.19"))
+	assert.Nil(t, err, "TestRuntimeLoaderLoadAndDeleteImages execution result")
+	assert.Equal(t, map[string]bool{"nginx:1.19": true}, engine.images,
+		"TestRuntimeLoaderLoadAndDeleteImages execution result")
+}
+
+func TestRuntimeLoaderRequiresClientCertificate(t *testing.T) {
+	packagePath := createImagePackage(t)
+	defer os.RemoveAll(packagePath)
+
+	engine := newMockDockerEngine()
+	engineServer, tlsConfig := startTLSDockerEngine(t, engine)
+	defer engineServer.Close()
+	engineUrl, _ := url.Parse(engineServer.URL)
+
+	tlsConfig.Certificates = nil
+	loader := imageloader.NewRuntimeLoader(engineUrl.Port(), tlsConfig)
+	_, err := loader.LoadImages(engineUrl.Hostname(), packagePath, imageArchiveDescriptors)
+	assert.Error(t, err, "TestRuntimeLoaderRequiresClientCertificate execution result")
+	assert.Equal(t, 0, engine.loaded, "TestRuntimeLoaderRequiresClientCertificate execution result")
+
+	_, err = imageloader.GetImageLoader(&conf.ServerConfigurations{ImageLoader: "runtime"})
+	assert.Error(t, err, "TestRuntimeLoaderRequiresClientCertificate execution result")
+}
+
+func TestReferenceLoaderRecordsImages(t *testing.T) {
+	loader, err := imageloader.GetImageLoader(&conf.ServerConfigurations{})
+	assert.Nil(t, err, "TestReferenceLoaderRecordsImages execution result")
+
+	images, err := loader.LoadImages(hostIpAddress, "",
+		[]models.SwImageDescriptor{{SwImage: "119.8.36.45/developer/etherpad:latest"}})
+	assert.Nil(t, err, "TestReferenceLoaderRecordsImages execution result")
+	assert.Equal(t, []string{"119.8.36.45/developer/etherpad:latest"}, images,
+		"TestReferenceLoaderRecordsImages execution result")
+}
+
+func TestGetImageLoaderInvalid(t *testing.T) {
+	_, err := imageloader.GetImageLoader(&conf.ServerConfigurations{ImageLoader: "registry"})
+	assert.Error(t, err, "TestGetImageLoaderInvalid execution result")
+
+	_, err = imageloader.GetImageLoader(&conf.ServerConfigurations{ImageLoader: "unknown"})
+	assert.Error(t, err, "TestGetImageLoaderInvalid execution result")
+}
+
+func TestImageArchiveOutsidePackage(t *testing.T) {
+	packagePath := createImagePackage(t)
+	defer os.RemoveAll(packagePath)
+
+	loader := imageloader.NewRuntimeLoader("2376", nil)
+	_, err := loader.LoadImages(hostIpAddress, filepath.Join(packagePath, "Image"),
+		[]models.SwImageDescriptor{{SwImage: "../Image/etherpad.tar"}})
+	assert.Error(t, err, "TestImageArchiveOutsidePackage execution result")
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8splugin/models"
+	"k8splugin/pkg/adapter"
+	"k8splugin/util"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const logsManifest = `
+---
+# Source: etherpad/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: etherpad
+  labels:
+    app: etherpad
+spec:
+  template:
+    metadata:
+      labels:
+        app: etherpad
+---
+# Source: etherpad/templates/pod.yaml
+apiVersion: v1
+kind: Pod
+metadata:
+  name: etherpad-job
+`
+
+// Create pod with app label
+func getLabeledPod(name string, app string) *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default",
+		Labels: map[string]string{"app": app}}}
+}
+
+func TestValidateReleasePod(t *testing.T) {
+	clientset := fake.NewSimpleClientset(getLabeledPod("etherpad-5d8f7", "etherpad"),
+		getLabeledPod("other-7c9d4", "other"))
+
+	err := adapter.ValidateReleasePod(clientset, logsManifest, "default", "etherpad-5d8f7")
+	assert.Nil(t, err, "TestValidateReleasePod pod of deployment is not found")
+
+	err = adapter.ValidateReleasePod(clientset, logsManifest, "default", "etherpad-job")
+	assert.Nil(t, err, "TestValidateReleasePod pod of release is not found")
+
+	err = adapter.ValidateReleasePod(clientset, logsManifest, "default", "other-7c9d4")
+	assert.Equal(t, util.PodDoesNotExist, err.Error(), "TestValidateReleasePod pod of other application is found")
+}
+
+func TestStreamPodLogs(t *testing.T) {
+	// Pod log subresource of kubernetes api server
+	var query url.Values
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/default/pods/etherpad-5d8f7/log" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query()
+		_, _ = w.Write([]byte("line 1\nline 2\n"))
+	}))
+	defer apiServer.Close()
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	assert.Nil(t, err, "TestStreamPodLogs execution result")
+
+	sinceTime := time.Date(2020, 10, 1, 8, 0, 0, 0, time.UTC)
+	var logs bytes.Buffer
+	err = adapter.StreamPodLogs(context.Background(), clientset, "default", &models.LogOptions{
+		PodName: "etherpad-5d8f7", ContainerName: "etherpad", TailLines: 10, SinceTime: &sinceTime}, &logs)
+	assert.Nil(t, err, "TestStreamPodLogs execution result")
+	assert.Equal(t, "line 1\nline 2\n", logs.String(), "TestStreamPodLogs logs are not streamed")
+	assert.Equal(t, "etherpad", query.Get("container"), "TestStreamPodLogs container is wrong")
+	assert.Equal(t, "10", query.Get("tailLines"), "TestStreamPodLogs tail lines is wrong")
+	assert.Equal(t, "2020-10-01T08:00:00Z", query.Get("sinceTime"), "TestStreamPodLogs since time is wrong")
+	assert.Equal(t, "", query.Get("follow"), "TestStreamPodLogs logs are followed")
+
+	err = adapter.StreamPodLogs(context.Background(), clientset, "default", &models.LogOptions{
+		PodName: "unknown"}, &logs)
+	assert.NotNil(t, err, "TestStreamPodLogs logs of unknown pod are streamed")
+}
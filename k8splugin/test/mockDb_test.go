@@ -24,6 +24,7 @@ import (
 
 type mockK8sPluginDb struct {
 	appInstanceRecords map[string]models.AppInstanceInfo
+	appPackageRecords  map[string]models.AppPackage
 }
 
 func (db *mockK8sPluginDb) InitDatabase(_ string) error {
@@ -37,6 +38,15 @@ func (db *mockK8sPluginDb) InsertOrUpdateData(data interface{}, cols ...string)
 			db.appInstanceRecords[appInstance.AppInsId] = *appInstance
 		}
 	}
+	if cols[0] == util.AppPkgId {
+		appPackage, ok := data.(*models.AppPackage)
+		if ok {
+			if db.appPackageRecords == nil {
+				db.appPackageRecords = make(map[string]models.AppPackage)
+			}
+			db.appPackageRecords[appPackage.AppPkgId] = *appPackage
+		}
+	}
 	return nil
 }
 
@@ -50,6 +60,18 @@ func (db *mockK8sPluginDb) ReadData(data interface{}, cols ...string) (err error
 			}
 			appInstance.WorkloadId = readAppInstance.WorkloadId
 			appInstance.HostIp = readAppInstance.HostIp
+			appInstance.Parameters = readAppInstance.Parameters
+			appInstance.Namespace = readAppInstance.Namespace
+			appInstance.AutoscalingPolicy = readAppInstance.AutoscalingPolicy
+		}
+	}
+	if cols[0] == util.AppPkgId {
+		appPackage, ok := data.(*models.AppPackage)
+		if ok {
+			readAppPackage, found := db.appPackageRecords[appPackage.AppPkgId]
+			if found {
+				*appPackage = readAppPackage
+			}
 		}
 	}
 	if cols[0] == "workload_id" {
@@ -59,6 +81,28 @@ func (db *mockK8sPluginDb) ReadData(data interface{}, cols ...string) (err error
 }
 
 func (db *mockK8sPluginDb) DeleteData(data interface{}, cols ...string) (err error) {
+	if cols[0] == util.AppPkgId {
+		appPackage, ok := data.(*models.AppPackage)
+		if ok {
+			delete(db.appPackageRecords, appPackage.AppPkgId)
+		}
+	}
 	return nil
 }
 
+func (db *mockK8sPluginDb) QueryTable(tableName string, container interface{}, field string,
+	container1 ...interface{}) (num int64, err error) {
+	appPackages, ok := container.(*[]*models.AppPackage)
+	if tableName != util.AppPackageTable || !ok {
+		return 0, nil
+	}
+	for _, appPackage := range db.appPackageRecords {
+		if field == util.HostIp && appPackage.HostIp != container1[0] {
+			continue
+		}
+		record := appPackage
+		*appPackages = append(*appPackages, &record)
+	}
+	return int64(len(*appPackages)), nil
+}
+
@@ -57,7 +57,7 @@ func (c *mockGrpcClient) dialToServer(address string) {
 }
 // Upload Package
 func (c *mockGrpcClient) UploadPkg(deployArtifact string, hostIP string,
-	accessToken string) (status string, error error) {
+	accessToken string, pkgId string) (status string, error error) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
 	defer cancel()
@@ -79,7 +79,7 @@ func (c *mockGrpcClient) UploadPkg(deployArtifact string, hostIP string,
 	_ = stream.Send(req)
 	req = &lcmservice.UploadPackageRequest{
 		Data: &lcmservice.UploadPackageRequest_AppPackageId{
-			AppPackageId: packageId,
+			AppPackageId: pkgId,
 		},
 	}
 	_ = stream.Send(req)
@@ -135,7 +135,7 @@ func (c *mockGrpcClient) UploadPkg(deployArtifact string, hostIP string,
 
 
 // Delete Package
-func (c *mockGrpcClient) DeletePkg() (status string, error error) {
+func (c *mockGrpcClient) DeletePkg(pkgId string) (status string, error error) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
 	defer cancel()
@@ -143,7 +143,7 @@ func (c *mockGrpcClient) DeletePkg() (status string, error error) {
 	req := &lcmservice.DeletePackageRequest{
 		HostIp:        hostIpAddress,
 		AccessToken:   token,
-		AppPackageId:  packageId,
+		AppPackageId:  pkgId,
 		TenantId:      tenantIdentifier,
 
 	}
@@ -166,11 +166,195 @@ func (c *mockGrpcClient) Instantiate(deployArtifact string, hostIP string, acces
 		TenantId:      tenantIdentifier,
 		Ak: ak,
 		Sk: sk,
+		Parameters: "{\"replicaCount\": 2}",
+		Namespace: tenantIdentifier + "-apps",
 	}
 	resp, err := c.client.Instantiate(ctx, req)
 	return resp.Status, err
 }
 
+// Instantiate application and wait for its workloads to be ready
+func (c *mockGrpcClient) InstantiateWithWait(hostIP string, accessToken string, appInsId string,
+	readyTimeout int32) (*lcmservice.InstantiateResponse, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.InstantiateRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		AppPackageId:  packageId,
+		TenantId:      tenantIdentifier,
+		Ak:            ak,
+		Sk:            sk,
+		Namespace:     tenantIdentifier + "-apps",
+		WaitForReady:  true,
+		ReadyTimeout:  readyTimeout,
+	}
+	return c.client.Instantiate(ctx, req)
+}
+
+// Instantiate application in the given namespace
+func (c *mockGrpcClient) InstantiateInNamespace(hostIP string, accessToken string, appInsId string,
+	namespace string) (*lcmservice.InstantiateResponse, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.InstantiateRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		AppPackageId:  packageId,
+		TenantId:      tenantIdentifier,
+		Ak:            ak,
+		Sk:            sk,
+		Namespace:     namespace,
+	}
+	return c.client.Instantiate(ctx, req)
+}
+
+// Upgrade application
+func (c *mockGrpcClient) Upgrade(hostIP string, accessToken string, appInsId string, ak string,
+	sk string) (status string, error error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.UpgradeRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		AppPackageId:  packageId,
+		TenantId:      tenantIdentifier,
+		Ak:            ak,
+		Sk:            sk,
+	}
+	resp, err := c.client.Upgrade(ctx, req)
+	return resp.Status, err
+}
+
+// Rollback application
+func (c *mockGrpcClient) Rollback(hostIP string, accessToken string, appInsId string,
+	revision int32) (*lcmservice.RollbackResponse, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.RollbackRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		Revision:      revision,
+	}
+	return c.client.Rollback(ctx, req)
+}
+
+// Scale application
+func (c *mockGrpcClient) Scale(hostIP string, accessToken string, appInsId string,
+	replicas int32) (status string, error error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.ScaleRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		Replicas:      replicas,
+	}
+	resp, err := c.client.Scale(ctx, req)
+	if err != nil {
+		return util.Failure, err
+	}
+	return resp.Status, nil
+}
+
+// Set application autoscaling policy
+func (c *mockGrpcClient) SetAutoscaling(hostIP string, accessToken string, appInsId string,
+	policy string) (status string, error error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.SetAutoscalingRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		Policy:        policy,
+	}
+	resp, err := c.client.SetAutoscaling(ctx, req)
+	if err != nil {
+		return util.Failure, err
+	}
+	return resp.Status, nil
+}
+
+// Delete application autoscaling policy
+func (c *mockGrpcClient) DeleteAutoscaling(hostIP string, accessToken string,
+	appInsId string) (status string, error error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.DeleteAutoscalingRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+	}
+	resp, err := c.client.DeleteAutoscaling(ctx, req)
+	if err != nil {
+		return util.Failure, err
+	}
+	return resp.Status, nil
+}
+
+// Get application pod logs
+func (c *mockGrpcClient) WorkloadLogs(accessToken string, appInsId string, hostIP string, podName string,
+	sinceTime string) (response string, error error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.WorkloadLogsRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		PodName:       podName,
+		SinceTime:     sinceTime,
+	}
+	stream, err := c.client.WorkloadLogs(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return response, nil
+		}
+		if err != nil {
+			return response, err
+		}
+		response += string(res.GetContent())
+	}
+}
+
+// Get application revision history
+func (c *mockGrpcClient) History(accessToken string, appInsId string, hostIP string) (response string, error error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.HistoryRequest{
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		HostIp:        hostIP,
+	}
+	resp, err := c.client.History(ctx, req)
+	return resp.Response, err
+}
+
 // Query application
 func (c *mockGrpcClient) Query(accessToken string, appInsId string, hostIP string) (response string, error error) {
 
@@ -186,8 +370,25 @@ func (c *mockGrpcClient) Query(accessToken string, appInsId string, hostIP strin
 	return resp.Response, err
 }
 
+// Query health of application
+func (c *mockGrpcClient) QueryHealth(accessToken string, appInsId string, hostIP string,
+	healthOnly bool) (*lcmservice.QueryResponse, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.QueryRequest{
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		HostIp:        hostIP,
+		HealthOnly:    healthOnly,
+	}
+	return c.client.Query(ctx, req)
+}
+
 // Get workload description
-func (c *mockGrpcClient) WorkloadEvents(accessToken string, appInsId string, hostIP string) (response string, error error) {
+func (c *mockGrpcClient) WorkloadEvents(accessToken string, appInsId string, hostIP string, sinceTime string,
+	eventType string) (response string, error error) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
 	defer cancel()
@@ -196,8 +397,32 @@ func (c *mockGrpcClient) WorkloadEvents(accessToken string, appInsId string, hos
 		AccessToken:   accessToken,
 		AppInstanceId: appInsId,
 		HostIp:        hostIP,
+		SinceTime:     sinceTime,
+		Type:          eventType,
 	}
 	resp, err := c.client.WorkloadEvents(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Response, err
+}
+
+// Get endpoints of application
+func (c *mockGrpcClient) Endpoints(accessToken string, appInsId string, hostIP string) (response string,
+	error error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	req := &lcmservice.EndpointsRequest{
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		HostIp:        hostIP,
+	}
+	resp, err := c.client.Endpoints(ctx, req)
+	if err != nil {
+		return "", err
+	}
 	return resp.Response, err
 }
 
@@ -228,6 +453,30 @@ func (c *mockGrpcClient) RemoveConfig(hostIP string, accessToken string) (status
 	return resp.Status, err
 }
 
+// Get capabilities
+func (c *mockGrpcClient) GetCapabilities(accessToken string) (*lcmservice.GetCapabilitiesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+	req := &lcmservice.GetCapabilitiesRequest{
+		AccessToken: accessToken,
+	}
+	return c.client.GetCapabilities(ctx, req)
+}
+
+// Query vm image
+func (c *mockGrpcClient) QueryVmImage(accessToken string, appInsId string, hostIP string,
+	imageId string) (*lcmservice.QueryVmImageResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+	req := &lcmservice.QueryVmImageRequest{
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		HostIp:        hostIP,
+		ImageId:       imageId,
+	}
+	return lcmservice.NewVmImageClient(c.conn).QueryVmImage(ctx, req)
+}
+
 // Upload Configuration
 func (c *mockGrpcClient) UploadConfig(deployArtifact string, hostIP string,
 	accessToken string) (status string, error error) {
@@ -17,28 +17,81 @@
 package test
 
 import (
+	"context"
+	"errors"
+	"io"
 	"k8splugin/models"
 	"k8splugin/pgdb"
+	"k8splugin/util"
+	"time"
 )
 
 // Helm client
 type mockedHelmClient struct {
 }
 
-func (hc *mockedHelmClient) Deploy(appPkgRec *models.AppPackage, appInsId string, ak string, sk string, db pgdb.Database) (string, error) {
+func (hc *mockedHelmClient) Deploy(appPkgRec *models.AppPackage, appInsId string, ak string, sk string, namespace string,
+	parameters map[string]interface{}, options *models.DeployOptions, db pgdb.Database) (string, error) {
+	if options.WaitForReady && options.ReadyTimeout == time.Second {
+		return "", errors.New(util.WorkloadsNotReady)
+	}
 	return "testRelease", nil
 }
 
-func (hc *mockedHelmClient) UnDeploy(relName string) error {
+func (hc *mockedHelmClient) Upgrade(appPkgRec *models.AppPackage, relName string, namespace string, appInsId string, ak string, sk string,
+	parameters map[string]interface{}) (string, error) {
+	return relName, nil
+}
+
+func (hc *mockedHelmClient) Rollback(relName string, namespace string,
+	revision int) (*models.RestoredRevision, error) {
+	return &models.RestoredRevision{PackageId: packageId, Ak: ak, Sk: sk}, nil
+}
+
+func (hc *mockedHelmClient) History(relName string, namespace string) (string, error) {
+	// Output to be checked
+	return "[{\"revision\":1}]", nil
+}
+
+func (hc *mockedHelmClient) UnDeploy(relName string, namespace string) error {
 	return nil
 }
 
-func (hc *mockedHelmClient) Query(relName string) (string, error) {
+func (hc *mockedHelmClient) Query(relName string, namespace string) (string, error) {
 	// Output to be checked
 	return "{\"Output\":\"Success\"}", nil
 }
 
-func (hc *mockedHelmClient) WorkloadEvents(relName string) (string, error) {
+func (hc *mockedHelmClient) Health(relName string, namespace string) (models.HealthSummary, error) {
+	return models.HealthSummary{Status: util.Degraded,
+		Reasons: []string{"container etherpad of pod etherpad-0 restarted 6 times"}}, nil
+}
+
+func (hc *mockedHelmClient) Scale(relName string, namespace string, replicas int32) error {
+	return nil
+}
+
+func (hc *mockedHelmClient) SetAutoscaling(relName string, namespace string, appInsId string,
+	policy *models.AutoscalingPolicy) error {
+	return nil
+}
+
+func (hc *mockedHelmClient) DeleteAutoscaling(namespace string, appInsId string) error {
+	return nil
+}
+
+func (hc *mockedHelmClient) WorkloadLogs(ctx context.Context, relName string, namespace string,
+	options *models.LogOptions, writer io.Writer) error {
+	_, err := writer.Write([]byte("log line of " + options.PodName + "\n"))
+	return err
+}
+
+func (hc *mockedHelmClient) Endpoints(relName string, namespace string) (string, error) {
+	return "{\"services\":[],\"ingresses\":[]}", nil
+}
+
+func (hc *mockedHelmClient) WorkloadEvents(relName string, namespace string,
+	filter *models.EventFilter) (string, error) {
 	// Output to be checked
 	return "{\"Output\":\"Success\"}", nil
 }
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"k8splugin/pkg/imageloader"
+)
+
+// Recorder of images deleted by image loader of the test server
+type imageDeletionRecorder struct {
+	images [][]string
+}
+
+// Images deleted during the running test, server image loader records onto it so that recorders are not
+// captured from the test
+var imageDeletions = &imageDeletionRecorder{}
+
+// Image loader which records image names of the package and deleted images
+type mockImageLoader struct {
+	imageloader.ReferenceLoader
+}
+
+func (l *mockImageLoader) DeleteImages(_ string, images []string) error {
+	imageDeletions.images = append(imageDeletions.images, images)
+	return nil
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"k8splugin/util"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Docker registry stand-in supporting the blob upload and manifest APIs
+type mockRegistry struct {
+	mutex     sync.Mutex
+	blobs     map[string][]byte
+	manifests map[string][]byte
+}
+
+func newMockRegistry() *mockRegistry {
+	return &mockRegistry{blobs: make(map[string][]byte), manifests: make(map[string][]byte)}
+}
+
+func (r *mockRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	switch {
+	case strings.HasSuffix(path, "/blobs/uploads/") && req.Method == http.MethodPost:
+		w.Header().Set("Location", "/v2/"+path+"upload-1")
+		w.WriteHeader(http.StatusAccepted)
+	case strings.Contains(path, "/blobs/uploads/") && req.Method == http.MethodPut:
+		body, _ := ioutil.ReadAll(req.Body)
+		digest := req.URL.Query().Get("digest")
+		sum := sha256.Sum256(body)
+		if digest != "sha256:"+hex.EncodeToString(sum[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.blobs[digest] = body
+		w.WriteHeader(http.StatusCreated)
+	case strings.Contains(path, "/blobs/") && req.Method == http.MethodHead:
+		digest := path[strings.LastIndex(path, "/")+1:]
+		if _, ok := r.blobs[digest]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case strings.Contains(path, "/manifests/") && req.Method == http.MethodPut:
+		body, _ := ioutil.ReadAll(req.Body)
+		r.manifests[path] = body
+		w.WriteHeader(http.StatusCreated)
+	case strings.Contains(path, "/manifests/") && req.Method == http.MethodHead:
+		manifest, ok := r.manifests[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		sum := sha256.Sum256(manifest)
+		w.Header().Set("Docker-Content-Digest", "sha256:"+hex.EncodeToString(sum[:]))
+		w.WriteHeader(http.StatusOK)
+	case strings.Contains(path, "/manifests/sha256:") && req.Method == http.MethodDelete:
+		digest := path[strings.LastIndex(path, "/")+1:]
+		for key, manifest := range r.manifests {
+			sum := sha256.Sum256(manifest)
+			if "sha256:"+hex.EncodeToString(sum[:]) == digest {
+				delete(r.manifests, key)
+			}
+		}
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// Docker engine stand-in supporting the image load and remove APIs
+type mockDockerEngine struct {
+	mutex  sync.Mutex
+	images map[string]bool
+	loaded int
+}
+
+func newMockDockerEngine() *mockDockerEngine {
+	return &mockDockerEngine{images: make(map[string]bool)}
+}
+
+func (e *mockDockerEngine) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	switch {
+	case req.URL.Path == "/images/load" && req.Method == http.MethodPost:
+		_, _ = ioutil.ReadAll(req.Body)
+		e.loaded++
+		e.images["119.8.36.45/developer/etherpad:latest"] = true
+		_, _ = w.Write([]byte(`{"stream":"Loaded image: 119.8.36.45/developer/etherpad:latest\n"}`))
+	case strings.HasPrefix(req.URL.Path, "/images/") && req.Method == http.MethodDelete:
+		image := strings.TrimPrefix(req.URL.Path, "/images/")
+		if !e.images[image] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(e.images, image)
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// Start docker engine stand-in over tls which requires client certificate, returns the tls configuration of
+// the client loaded from the written certificate files
+func startTLSDockerEngine(t *testing.T, handler http.Handler) (*httptest.Server, *tls.Config) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "k8splugin"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certDer, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err, "create client certificate")
+	clientCert, _ := x509.ParseCertificate(certDer)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+
+	certDir, _ := ioutil.TempDir("", "docker-certs")
+	defer os.RemoveAll(certDir)
+	keyDer, _ := x509.MarshalECPrivateKey(key)
+	files := map[string]*pem.Block{
+		"ca.pem":   {Type: "CERTIFICATE", Bytes: server.Certificate().Raw},
+		"cert.pem": {Type: "CERTIFICATE", Bytes: certDer},
+		"key.pem":  {Type: "EC PRIVATE KEY", Bytes: keyDer},
+	}
+	for name, block := range files {
+		_ = ioutil.WriteFile(filepath.Join(certDir, name), pem.EncodeToMemory(block), 0600)
+	}
+	tlsConfig, err := util.GetClientTLSConfig(filepath.Join(certDir, "ca.pem"),
+		filepath.Join(certDir, "cert.pem"), filepath.Join(certDir, "key.pem"))
+	assert.Nil(t, err, "load client tls configuration")
+	return server, tlsConfig
+}
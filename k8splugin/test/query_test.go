@@ -0,0 +1,95 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8splugin/pkg/adapter"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const queryManifest = `
+---
+# Source: etherpad/templates/daemonset.yaml
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: etherpad-agent
+spec:
+  template:
+    metadata:
+      labels:
+        component: etherpad-agent
+---
+# Source: etherpad/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: etherpad
+spec:
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/name: etherpad
+`
+
+// Create running pod with given labels
+func getRunningPod(name string, labels map[string]string) *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Status: v1.PodStatus{Phase: v1.PodRunning}}
+}
+
+func TestGetPodStatistics(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		getRunningPod("etherpad-agent-x7k2p", map[string]string{"component": "etherpad-agent"}),
+		getRunningPod("etherpad-5d8f7", map[string]string{"app.kubernetes.io/name": "etherpad"}),
+		getRunningPod("other-7c9d4", map[string]string{"app": "etherpad"}))
+
+	// Metrics server is not available, pods are responded without container metrics
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer apiServer.Close()
+
+	appInfo, response, err := adapter.GetPodStatistics(clientset, &rest.Config{Host: apiServer.URL},
+		queryManifest, "default")
+	assert.Nil(t, err, "TestGetPodStatistics execution result")
+	assert.Nil(t, response, "TestGetPodStatistics release is not running")
+	var podNames []string
+	for _, podInfo := range appInfo.Pods {
+		assert.Equal(t, string(v1.PodRunning), podInfo.PodStatus, "TestGetPodStatistics pod status")
+		podNames = append(podNames, podInfo.PodName)
+	}
+	assert.ElementsMatch(t, []string{"etherpad-agent-x7k2p", "etherpad-5d8f7"}, podNames,
+		"TestGetPodStatistics pods of daemon set and deployment")
+}
+
+func TestGetPodStatisticsNotRunning(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		getRunningPod("etherpad-5d8f7", map[string]string{"app.kubernetes.io/name": "etherpad"}))
+
+	_, response, err := adapter.GetPodStatistics(clientset, &rest.Config{}, queryManifest, "default")
+	assert.Nil(t, err, "TestGetPodStatisticsNotRunning execution result")
+	assert.Equal(t, map[string]string{"status": "not running"}, response,
+		"TestGetPodStatisticsNotRunning pods of daemon set are not created")
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"encoding/json"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"k8splugin/conf"
+	"k8splugin/pkg/registration"
+	"k8splugin/util"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+)
+
+// Create access token with mecm admin role, as required by plugin registry of lcmcontroller
+func createAdminToken() string {
+	atClaims := jwt.MapClaims{}
+	atClaims["authorities"] = []string{util.MecmAdminRole}
+	atClaims["user_name"] = "admin"
+	atClaims["authorized"] = true
+	atClaims["userId"] = 1
+	atClaims["exp"] = time.Now().Add(time.Minute * 60).Unix()
+	at := jwt.NewWithClaims(jwt.SigningMethodHS256, atClaims)
+	token, _ := at.SignedString([]byte("jdnfksdmfksd"))
+	return token
+}
+
+func TestPluginRegistration(t *testing.T) {
+	var received registration.PluginInfo
+	status := http.StatusOK
+	lcmcontroller := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/lcmcontroller/v1/plugins", r.URL.Path, "Registration path is wrong")
+		// Plugin registry of lcmcontroller accepts only tokens with mecm admin role
+		accessToken := r.Header.Get("access_token")
+		if accessToken == "" || util.ValidateAccessToken(accessToken, []string{util.MecmAdminRole}) != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(status)
+	}))
+	defer lcmcontroller.Close()
+	lcmcontrollerUrl, _ := url.Parse(lcmcontroller.URL)
+
+	tokenFile, _ := ioutil.TempFile("", "access_token")
+	_, _ = tokenFile.WriteString(createAdminToken() + "\n")
+	tokenFile.Close()
+	defer os.Remove(tokenFile.Name())
+
+	config := &conf.ServerConfigurations{Servername: "edgegallery", LcmcontrollerAddr: lcmcontrollerUrl.Host,
+		LcmcontrollerSslNotEnabled: true, LcmcontrollerTokenFile: tokenFile.Name(), PluginName: "k8splugin",
+		AdvertiseAddr: "k8splugin:8095", VimTypes: "k8s,kubernetes"}
+	registrar, err := registration.NewRegistrar(config)
+	assert.Nil(t, err, "TestPluginRegistration execution result")
+
+	err = registrar.Register()
+	assert.Nil(t, err, "TestPluginRegistration execution result")
+	assert.Equal(t, "k8splugin", received.PluginName, "TestPluginRegistration plugin name is wrong")
+	assert.Equal(t, "k8splugin:8095", received.Address, "TestPluginRegistration address is wrong")
+	assert.True(t, received.SslEnabled, "TestPluginRegistration ssl is not enabled")
+	assert.Equal(t, "edgegallery", received.ServerName, "TestPluginRegistration server name is wrong")
+	assert.Equal(t, []string{"k8s", "kubernetes"}, received.VimTypes, "TestPluginRegistration vim types are wrong")
+	assert.NotEmpty(t, received.Capabilities, "TestPluginRegistration capabilities are empty")
+
+	status = http.StatusBadRequest
+	err = registrar.Register()
+	assert.NotNil(t, err, "TestPluginRegistration rejected registration is not failed")
+
+	// Registration with a tenant token is rejected
+	status = http.StatusOK
+	_ = ioutil.WriteFile(tokenFile.Name(), []byte(createToken(2)), 0600)
+	err = registrar.Register()
+	assert.NotNil(t, err, "TestPluginRegistration registration with invalid token is not failed")
+
+	_ = os.Remove(tokenFile.Name())
+	err = registrar.Register()
+	assert.NotNil(t, err, "TestPluginRegistration registration without token is not failed")
+
+	config.LcmcontrollerTokenFile = ""
+	_, err = registration.NewRegistrar(config)
+	assert.NotNil(t, err, "TestPluginRegistration registrar without token file is created")
+}
+
+func TestPluginRegistrationNotConfigured(t *testing.T) {
+	registrar, err := registration.NewRegistrar(&conf.ServerConfigurations{AdvertiseAddr: "k8splugin:8095"})
+	assert.Nil(t, err, "TestPluginRegistrationNotConfigured execution result")
+	assert.Nil(t, registrar, "TestPluginRegistrationNotConfigured registrar is created")
+}
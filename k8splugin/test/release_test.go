@@ -0,0 +1,149 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/agiledragon/gomonkey"
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8splugin/config"
+	"k8splugin/models"
+	"k8splugin/pkg/adapter"
+	"k8splugin/util"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const renderedManifest = `---
+# Source: etherpad/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: etherpad
+  labels:
+    app: etherpad
+spec:
+  selector:
+    matchLabels:
+      app: etherpad
+  template:
+    metadata:
+      labels:
+        app: etherpad
+---
+# Source: etherpad/templates/job.yaml
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: etherpad-init
+spec:
+  template:
+    metadata:
+      labels:
+        app: etherpad-init
+---
+# Source: etherpad/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: etherpad-svc
+`
+
+func TestGetReleaseName(t *testing.T) {
+	relName := adapter.GetReleaseName("Etherpad", appInstanceIdentifier)
+	assert.True(t, strings.HasPrefix(relName, "etherpad-"), "TestGetReleaseName chart name is not used")
+	assert.Equal(t, len("etherpad-")+util.ReleaseHashLength, len(relName), "TestGetReleaseName hash is wrong")
+	assert.Equal(t, relName, adapter.GetReleaseName("Etherpad", appInstanceIdentifier),
+		"TestGetReleaseName release name is not stable")
+	assert.NotEqual(t, relName, adapter.GetReleaseName("Etherpad", tenantIdentifier),
+		"TestGetReleaseName release names of instances are same")
+
+	relName = adapter.GetReleaseName(strings.Repeat("a", 60), appInstanceIdentifier)
+	assert.Equal(t, util.MaxReleaseNameLength, len(relName), "TestGetReleaseName long name is not truncated")
+}
+
+func testDeployReleaseName(t *testing.T) {
+	var c *config.AppAuthConfigBuilder
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), addValues,
+		func(*config.AppAuthConfigBuilder, *os.File) (string, error) {
+			return "test", nil
+		})
+	defer patch1.Reset()
+
+	patch2 := gomonkey.ApplyFunc(loader.Load, func(_ string) (*chart.Chart, error) {
+		return &chart.Chart{Metadata: &chart.Metadata{Name: "etherpad"}}, nil
+	})
+	defer patch2.Reset()
+
+	// Manifest is rendered by the post renderer of the installer
+	var manifest string
+	var i *action.Install
+	patch3 := gomonkey.ApplyMethod(reflect.TypeOf(i), "Run",
+		func(installer *action.Install, _ *chart.Chart, _ map[string]interface{}) (*release.Release, error) {
+			if installer.ReleaseName == adapter.GetReleaseName("etherpad", tenantIdentifier) {
+				return nil, errors.New("rendered manifests contain a resource that already exists. " +
+					"Unable to continue with install: Service \"etherpad-svc\" in namespace \"default\" exists")
+			}
+			rendered, err := installer.PostRenderer.Run(bytes.NewBufferString(renderedManifest))
+			if err != nil {
+				return nil, err
+			}
+			manifest = rendered.String()
+			return &release.Release{Name: installer.ReleaseName, Manifest: manifest}, nil
+		})
+	defer patch3.Reset()
+
+	client := &adapter.HelmClient{HostIP: ipAddress, Kubeconfig: configFile + ipAddress}
+	appPkgRecord := &models.AppPackage{TenantId: tenantIdentifier, HostIp: hostIpAddress, PackageId: packageId}
+	relName, err := client.Deploy(appPkgRecord, appInstanceIdentifier, ak, sk, "default", nil, nil,
+		&mockK8sPluginDb{appInstanceRecords: make(map[string]models.AppInstanceInfo)})
+	assert.Nil(t, err, "TestDeployReleaseName execution result")
+	assert.Equal(t, adapter.GetReleaseName("etherpad", appInstanceIdentifier), relName,
+		"TestDeployReleaseName release name is wrong")
+
+	// Immutable pod template of job is not labeled
+	assert.Equal(t, 1, strings.Count(manifest, util.ReleaseLabel), "TestDeployReleaseName job is labeled")
+
+	// Instance whose resources conflict with another release is rejected
+	_, err = client.Deploy(appPkgRecord, tenantIdentifier, ak, sk, "default", nil, nil,
+		&mockK8sPluginDb{appInstanceRecords: make(map[string]models.AppInstanceInfo)})
+	assert.EqualError(t, err, util.ResourceNameConflict, "TestDeployReleaseName conflict is not reported")
+
+	// Pods of sibling instance with same app label are not part of the release
+	pod := getLabeledPod("etherpad-5d8f7", "etherpad")
+	pod.Labels[util.ReleaseLabel] = relName
+	siblingPod := getLabeledPod("etherpad-7c9d4", "etherpad")
+	siblingPod.Labels[util.ReleaseLabel] = adapter.GetReleaseName("etherpad", tenantIdentifier)
+	clientset := fake.NewSimpleClientset(pod, siblingPod)
+
+	err = adapter.ValidateReleasePod(clientset, manifest, "default", "etherpad-5d8f7")
+	assert.Nil(t, err, "TestDeployReleaseName pod of release is not found")
+	err = adapter.ValidateReleasePod(clientset, manifest, "default", "etherpad-7c9d4")
+	assert.NotNil(t, err, "TestDeployReleaseName pod of sibling instance is found")
+
+	eventsInfo, err := adapter.GetWorkloadEvents(clientset, manifest, "default", nil)
+	assert.Nil(t, err, "TestDeployReleaseName execution result")
+	assert.Equal(t, 1, len(eventsInfo.PodDescInfo), "TestDeployReleaseName pods of instances are mixed")
+}
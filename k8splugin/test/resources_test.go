@@ -0,0 +1,149 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8splugin/models"
+	"k8splugin/pkg/adapter"
+	"k8splugin/util"
+	"testing"
+)
+
+const resourcesManifest = `
+---
+# Source: etherpad/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: etherpad
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      component: pad
+---
+# Source: etherpad/templates/disabled.yaml
+---
+# Source: etherpad/templates/job.yaml
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: etherpad-init
+---
+# Source: etherpad/templates/clusterrole.yaml
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: etherpad-reader
+---
+# Source: etherpad/templates/config.yaml
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: etherpad-config
+- apiVersion: v1
+  kind: PersistentVolumeClaim
+  metadata:
+    name: etherpad-data
+    namespace: storage
+---
+# Source: etherpad/templates/pad.yaml
+apiVersion: example.com/v1
+kind: Pad
+metadata:
+  name: etherpad-pad
+---
+# Source: etherpad/templates/monitor.yaml
+apiVersion: monitoring.example.com/v1
+kind: Monitor
+metadata:
+  name: etherpad-monitor
+`
+
+// Create unstructured object with the status
+func getUnstructured(apiVersion string, kind string, namespace string, name string,
+	status map[string]interface{}) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": apiVersion, "kind": kind,
+		"metadata": map[string]interface{}{"name": name, "namespace": namespace}}}
+	if status != nil {
+		object.Object["status"] = status
+	}
+	return object
+}
+
+// Create rest mapper of the kinds served by the cluster, monitor kind is not served
+func getRestMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for _, gvk := range []schema.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Group: "batch", Version: "v1", Kind: "Job"}, {Version: "v1", Kind: "ConfigMap"},
+		{Version: "v1", Kind: "PersistentVolumeClaim"}, {Group: "example.com", Version: "v1", Kind: "Pad"}} {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+		meta.RESTScopeRoot)
+	return mapper
+}
+
+func TestGetResources(t *testing.T) {
+	deployment := getUnstructured("apps/v1", "Deployment", "default", "etherpad",
+		map[string]interface{}{"readyReplicas": int64(1)})
+	_ = unstructured.SetNestedField(deployment.Object, int64(2), "spec", "replicas")
+	job := getUnstructured("batch/v1", "Job", "default", "etherpad-init", map[string]interface{}{
+		"conditions": []interface{}{map[string]interface{}{"type": "Complete", "status": "True"}}})
+	clusterRole := getUnstructured("rbac.authorization.k8s.io/v1", "ClusterRole", "", "etherpad-reader", nil)
+	claim := getUnstructured("v1", "PersistentVolumeClaim", "storage", "etherpad-data",
+		map[string]interface{}{"phase": "Bound"})
+	pad := getUnstructured("example.com/v1", "Pad", "default", "etherpad-pad", map[string]interface{}{
+		"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "False",
+			"message": "pad is syncing"}}})
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), deployment, job, clusterRole, claim,
+		pad)
+
+	resources, err := adapter.GetResources(dynamicClient, getRestMapper(), resourcesManifest, "default")
+	assert.Nil(t, err, "TestGetResources execution result")
+	assert.Equal(t, []models.ResourceInfo{
+		{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "etherpad",
+			Status: util.ResourceNotReady, Message: "1 of 2 replicas ready"},
+		{Group: "batch", Version: "v1", Kind: "Job", Namespace: "default", Name: "etherpad-init",
+			Status: util.ResourceComplete},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole", Name: "etherpad-reader",
+			Status: util.ResourceExists},
+		{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: "etherpad-config",
+			Status: util.ResourceMissing},
+		{Version: "v1", Kind: "PersistentVolumeClaim", Namespace: "storage", Name: "etherpad-data",
+			Status: "Bound"},
+		{Group: "example.com", Version: "v1", Kind: "Pad", Namespace: "default", Name: "etherpad-pad",
+			Status: util.ResourceNotReady, Message: "pad is syncing"},
+		{Group: "monitoring.example.com", Version: "v1", Kind: "Monitor", Name: "etherpad-monitor",
+			Status: util.ResourceUnknown, Message: "kind is not served by the cluster"},
+	}, resources, "TestGetResources resources are wrong")
+}
+
+func TestGetResourcesInvalidManifest(t *testing.T) {
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	_, err := adapter.GetResources(dynamicClient, getRestMapper(), "kind: [Deployment", "default")
+	assert.NotNil(t, err, "TestGetResourcesInvalidManifest invalid manifest is not rejected")
+}
@@ -21,10 +21,13 @@ import (
 	"github.com/dgrijalva/jwt-go"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8splugin/conf"
 	"k8splugin/models"
 	"k8splugin/pgdb"
 	"k8splugin/pkg/adapter"
+	"k8splugin/pkg/imageloader"
 	"k8splugin/pkg/server"
 	"k8splugin/util"
 	"os"
@@ -56,6 +59,12 @@ func TestServer(t *testing.T) {
 	})
 	defer patch2.Reset()
 
+	patch3 := gomonkey.ApplyFunc(imageloader.GetImageLoader,
+		func(_ *conf.ServerConfigurations) (imageloader.ImageLoader, error) {
+			return &mockImageLoader{}, nil
+		})
+	defer patch3.Reset()
+
 	// Common steps
 	dir, _ := os.Getwd()
 	//configPath :=  dir + "/testConfig.yaml"
@@ -82,14 +91,26 @@ func TestServer(t *testing.T) {
 	testUploadPkg(t, dir, config)
 	testDeploySuccess(t)
 	testDeployFailure(t)
+	testDeployReleaseName(t)
+	testDeployNotReady(t)
 	testWorkloadEvents(t)
 	testQueryInfo(t)
 	testUnDeploySuccess(t)
-	testDeletePkg(t, config)
+	testRollbackRestoredRevision(t)
+	testDeletePkg(t, dir, config)
 	testInstantiate(t, dir, config)
+	testUpgrade(t, config)
+	testHistory(t, config)
+	testRollback(t, config)
+	testScale(t, config)
+	testAutoscaling(t, config)
 	testQuery(t, config)
 	testPodDescribe(t, config)
+	testEndpoints(t, config)
+	testWorkloadLogs(t, config)
 	testTerminate(t, config)
+	testGetCapabilities(t, config)
+	testQueryVmImage(t, config)
 
 
 	testRemoval(t, config)
@@ -101,23 +122,110 @@ func TestServer(t *testing.T) {
 func testUploadPkg(t *testing.T, dir string, config *conf.Configurations) {
 	client := &mockGrpcClient{}
 	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
-	status, _ := client.UploadPkg(dir+"/"+"e17d23de-e562-4c81-b242-0d3926a2255f.csar", hostIpAddress, token)
+	status, _ := client.UploadPkg(dir+"/"+"e17d23de-e562-4c81-b242-0d3926a2255f.csar", hostIpAddress, token,
+		packageId)
 	assert.Equal(t, util.Success, status, "Upload Package failed")
 }
 
-func testDeletePkg(t *testing.T, config *conf.Configurations) {
+func testDeletePkg(t *testing.T, dir string, config *conf.Configurations) {
 	client := &mockGrpcClient{}
 	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
-	status, _ := client.DeletePkg()
-	assert.Equal(t, util.Success, status, "Instantiation failed")
+
+	// Images of the package are used by other package distributed to the host
+	status, _ := client.UploadPkg(dir+"/"+"e17d23de-e562-4c81-b242-0d3926a2255f.csar", hostIpAddress, token,
+		sharedPackageId)
+	assert.Equal(t, util.Success, status, "Upload Package failed")
+
+	imageDeletions = &imageDeletionRecorder{}
+	status, _ = client.DeletePkg(packageId)
+	assert.Equal(t, util.Success, status, "Delete Package failed")
+	assert.Equal(t, [][]string{{}}, imageDeletions.images, "Images used by other package are deleted")
+
+	status, _ = client.DeletePkg(sharedPackageId)
+	assert.Equal(t, util.Success, status, "Delete Package failed")
+	assert.Equal(t, [][]string{{}, {"119.8.36.45/developer/etherpad:latest"}}, imageDeletions.images,
+		"Images of the last package are not deleted")
 }
 
 func testInstantiate(t *testing.T, dir string, config *conf.Configurations) {
 	client := &mockGrpcClient{}
 	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
-	status, _ := client.Instantiate(dir+"/"+"e17d23de-e562-4c81-b242-0d3926a2255f.csar", hostIpAddress, token,
+	result, _ := client.Instantiate(dir+"/"+"e17d23de-e562-4c81-b242-0d3926a2255f.csar", hostIpAddress, token,
 		appInstanceIdentifier, ak, sk)
-	assert.Equal(t, util.Success, status, "Instantiation failed")
+	assert.Equal(t, util.Success, result, "Instantiation failed")
+
+	// Release whose workloads are not ready in time is reported with the reason
+	// Namespaces not owned by the tenant are rejected
+	_, err := client.InstantiateInNamespace(hostIpAddress, token, appInstanceIdentifier, "kube-system")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Instantiation in system namespace is not rejected")
+
+	response, err := client.InstantiateWithWait(hostIpAddress, token, appInstanceIdentifier, 1)
+	assert.Nil(t, err, "Instantiation with wait failed")
+	assert.Equal(t, util.Failure, response.Status, "Instantiation with wait failed")
+	assert.Equal(t, util.WorkloadsNotReady, response.Reason, "Instantiation with wait failed")
+
+	_, err = client.InstantiateWithWait(hostIpAddress, token, appInstanceIdentifier, util.MaxReadyTimeout+1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Instantiation with invalid timeout failed")
+}
+
+func testUpgrade(t *testing.T, config *conf.Configurations) {
+	client := &mockGrpcClient{}
+	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
+	status, _ := client.Upgrade(hostIpAddress, token, appInstanceIdentifier, ak, sk)
+	assert.Equal(t, util.Success, status, "Upgrade failed")
+}
+
+func testHistory(t *testing.T, config *conf.Configurations) {
+	client := &mockGrpcClient{}
+	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
+	response, _ := client.History(token, appInstanceIdentifier, hostIpAddress)
+	assert.Equal(t, "[{\"revision\":1}]", response, "History failed")
+}
+
+func testRollback(t *testing.T, config *conf.Configurations) {
+	client := &mockGrpcClient{}
+	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
+	response, _ := client.Rollback(hostIpAddress, token, appInstanceIdentifier, 1)
+	assert.Equal(t, util.Success, response.Status, "Rollback failed")
+	assert.Equal(t, packageId, response.PackageId, "Rollback restored package is wrong")
+	assert.Equal(t, ak, response.Ak, "Rollback restored ak is wrong")
+}
+
+func testScale(t *testing.T, config *conf.Configurations) {
+	client := &mockGrpcClient{}
+	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
+	status, _ := client.Scale(hostIpAddress, token, appInstanceIdentifier, 3)
+	assert.Equal(t, util.Success, status, "Scale failed")
+
+	status, _ = client.Scale(hostIpAddress, token, appInstanceIdentifier, -1)
+	assert.Equal(t, util.Failure, status, "Scale to invalid replicas failed")
+}
+
+func testAutoscaling(t *testing.T, config *conf.Configurations) {
+	client := &mockGrpcClient{}
+	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
+	result, _ := client.SetAutoscaling(hostIpAddress, token, appInstanceIdentifier,
+		`{"minReplicas": 1, "maxReplicas": 5, "targetCpuUtilization": 80}`)
+	assert.Equal(t, util.Success, result, "Set autoscaling failed")
+
+	result, _ = client.SetAutoscaling(hostIpAddress, token, appInstanceIdentifier,
+		`{"minReplicas": 3, "maxReplicas": 2, "targetCpuUtilization": 80}`)
+	assert.Equal(t, util.Failure, result, "Set autoscaling with invalid replicas failed")
+
+	result, _ = client.SetAutoscaling(hostIpAddress, token, appInstanceIdentifier,
+		`{"minReplicas": 1, "maxReplicas": 2}`)
+	assert.Equal(t, util.Failure, result, "Set autoscaling without target failed")
+
+	// Scaling is rejected while autoscaling policy is set
+	result, err := client.Scale(hostIpAddress, token, appInstanceIdentifier, 3)
+	assert.Equal(t, util.Failure, result, "Scale with autoscaling policy is not rejected")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Scale with autoscaling policy code is wrong")
+
+	result, _ = client.DeleteAutoscaling(hostIpAddress, token, appInstanceIdentifier)
+	assert.Equal(t, util.Success, result, "Delete autoscaling failed")
+
+	result, _ = client.Scale(hostIpAddress, token, appInstanceIdentifier, 3)
+	assert.Equal(t, util.Success, result, "Scale after deleting autoscaling policy failed")
 }
 
 func testQuery(t *testing.T, config *conf.Configurations) {
@@ -125,13 +233,56 @@ func testQuery(t *testing.T, config *conf.Configurations) {
 	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
 	status, _ := client.Query(token, appInstanceIdentifier, hostIpAddress)
 	assert.Equal(t, "{\"Output\":\"Success\"}", status, "Query failed")
+
+	// Health summary is responded with pod statistics, or alone
+	response, err := client.QueryHealth(token, appInstanceIdentifier, hostIpAddress, false)
+	assert.Nil(t, err, "Query health failed")
+	assert.Equal(t, util.Degraded, response.Health.Status, "Query health failed")
+	response, err = client.QueryHealth(token, appInstanceIdentifier, hostIpAddress, true)
+	assert.Nil(t, err, "Query health failed")
+	assert.Equal(t, "", response.Response, "Query health only failed")
+	assert.Equal(t, []string{"container etherpad of pod etherpad-0 restarted 6 times"}, response.Health.Reasons,
+		"Query health only failed")
 }
 
 func testPodDescribe(t *testing.T, config *conf.Configurations) {
 	client := &mockGrpcClient{}
 	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
-	status, _ := client.WorkloadEvents(token, appInstanceIdentifier, hostIpAddress)
-	assert.Equal(t, "{\"Output\":\"Success\"}", status, "Pod describe failed")
+	response, _ := client.WorkloadEvents(token, appInstanceIdentifier, hostIpAddress, "2020-10-01T08:00:00Z",
+		"Warning")
+	assert.Equal(t, "{\"Output\":\"Success\"}", response, "Pod describe failed")
+
+	_, err := client.WorkloadEvents(token, appInstanceIdentifier, hostIpAddress, "", "Error")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Pod describe with invalid type failed")
+
+	_, err = client.WorkloadEvents(token, appInstanceIdentifier, hostIpAddress, "yesterday", "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Pod describe with invalid since time failed")
+}
+
+func testEndpoints(t *testing.T, config *conf.Configurations) {
+	client := &mockGrpcClient{}
+	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
+	response, err := client.Endpoints(token, appInstanceIdentifier, hostIpAddress)
+	assert.Nil(t, err, "Endpoints failed")
+	assert.Equal(t, "{\"services\":[],\"ingresses\":[]}", response, "Endpoints failed")
+
+	_, err = client.Endpoints(token, "e921ce54-82c8-4532-b5c6-000000000000", hostIpAddress)
+	assert.Equal(t, codes.NotFound, status.Code(err), "Endpoints of unknown app instance failed")
+}
+
+func testWorkloadLogs(t *testing.T, config *conf.Configurations) {
+	client := &mockGrpcClient{}
+	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
+	response, err := client.WorkloadLogs(token, appInstanceIdentifier, hostIpAddress, "etherpad-0",
+		"2020-10-01T08:00:00Z")
+	assert.Nil(t, err, "Workload logs failed")
+	assert.Equal(t, "log line of etherpad-0\n", response, "Workload logs failed")
+
+	_, err = client.WorkloadLogs(token, appInstanceIdentifier, hostIpAddress, "Etherpad_0", "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Workload logs of invalid pod failed")
+
+	_, err = client.WorkloadLogs(token, appInstanceIdentifier, hostIpAddress, "etherpad-0", "yesterday")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Workload logs with invalid since time failed")
 }
 
 func testTerminate(t *testing.T, config *conf.Configurations) {
@@ -155,6 +306,27 @@ func testRemoval(t *testing.T, config *conf.Configurations) {
 	assert.Equal(t, util.Success, status, "Upload failed")
 }
 
+func testGetCapabilities(t *testing.T, config *conf.Configurations) {
+	client := &mockGrpcClient{}
+	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
+	resp, err := client.GetCapabilities(token)
+	assert.Nil(t, err, "Get capabilities failed")
+	assert.Contains(t, resp.Operations, "instantiate", "Get capabilities failed")
+	assert.Contains(t, resp.Operations, "createVmImage", "Get capabilities failed")
+	assert.Equal(t, []string{util.ContainerDeployment}, resp.DeploymentTypes, "Get capabilities failed")
+	assert.Equal(t, int64(util.MaxPackageFile), resp.MaxPackageSize, "Get capabilities failed")
+}
+
+func testQueryVmImage(t *testing.T, config *conf.Configurations) {
+	client := &mockGrpcClient{}
+	client.dialToServer(config.Server.Httpsaddr + ":" + config.Server.Serverport)
+	_, err := client.QueryVmImage(token, appInstanceIdentifier, hostIpAddress, "0123456789abcdef0123456789abcdef")
+	assert.Equal(t, codes.NotFound, status.Code(err), "Query unknown vm image failed")
+
+	_, err = client.QueryVmImage(token, appInstanceIdentifier, hostIpAddress, "invalid")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Query invalid vm image failed")
+}
+
 func startServer(server server.ServerGRPC) {
 	err := server.Listen()
 	if err != nil {
@@ -0,0 +1,216 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8splugin/pkg/snapshot"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var snapshotImage = "mecm-snapshot/" + appInstanceIdentifier + ":0123456789abcdef0123456789abcdef"
+
+const snapshotImageId = "0123456789abcdef0123456789abcdef"
+
+// Mock docker engine which keeps committed container images
+type mockSnapshotEngine struct {
+	images  map[string]string
+	exports int
+}
+
+func (m *mockSnapshotEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/commit":
+		query := r.URL.Query()
+		if query.Get("container") != "abc123" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		m.images[query.Get("repo")+":"+query.Get("tag")] = "image archive"
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet && r.URL.Path == "/images/"+snapshotImage+"/json":
+		if _, ok := m.images[snapshotImage]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"Size": 13}`))
+	case r.Method == http.MethodGet && r.URL.Path == "/images/"+snapshotImage+"/get":
+		m.exports++
+		_, _ = w.Write([]byte(m.images[snapshotImage]))
+	case r.Method == http.MethodDelete && r.URL.Path == "/images/"+snapshotImage:
+		delete(m.images, snapshotImage)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// Create pod of application with given container id
+func getSnapshotPod(containerId string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "etherpad-0", Namespace: "default"},
+		Spec: v1.PodSpec{Volumes: []v1.Volume{
+			{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}},
+			{Name: "data", VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "etherpad-data"}}},
+		}},
+		Status: v1.PodStatus{HostIP: "127.0.0.1", ContainerStatuses: []v1.ContainerStatus{
+			{Name: "sidecar", ContainerID: "docker://def456"},
+			{Name: "etherpad", ContainerID: containerId},
+		}},
+	}
+}
+
+func TestSnapshotCommitAndExport(t *testing.T) {
+	engine := &mockSnapshotEngine{images: make(map[string]string)}
+	engineServer, tlsConfig := startTLSDockerEngine(t, engine)
+	defer engineServer.Close()
+	engineUrl, _ := url.Parse(engineServer.URL)
+	cacheDir, _ := ioutil.TempDir("", "snapshots")
+	defer os.RemoveAll(cacheDir)
+
+	snapshotter := snapshot.NewSnapshotter(engineUrl.Port(), tlsConfig, "", cacheDir)
+	clientset := fake.NewSimpleClientset(getSnapshotPod("docker://abc123"))
+
+	container, err := snapshotter.FindContainer(clientset, "default", "etherpad-0/etherpad")
+	assert.Nil(t, err, "TestSnapshotCommitAndExport execution result")
+	assert.Equal(t, &snapshot.Container{PodName: "etherpad-0", ContainerName: "etherpad", ContainerId: "abc123",
+		NodeIp: "127.0.0.1", Namespace: "default", ClaimNames: []string{"etherpad-data"}}, container,
+		"TestSnapshotCommitAndExport container is wrong")
+	snapshotType, err := snapshotter.GetSnapshotType(container)
+	assert.Nil(t, err, "TestSnapshotCommitAndExport execution result")
+	assert.Equal(t, snapshot.DockerSnapshot, snapshotType, "TestSnapshotCommitAndExport snapshot type is wrong")
+
+	err = snapshotter.Commit(container, snapshotImage)
+	assert.Nil(t, err, "TestSnapshotCommitAndExport execution result")
+
+	size, err := snapshotter.GetImageSize(container.NodeIp, snapshotImage)
+	assert.Nil(t, err, "TestSnapshotCommitAndExport execution result")
+	assert.Equal(t, int64(13), size, "TestSnapshotCommitAndExport image size is wrong")
+
+	// Image is exported once and later downloads read the cached archive
+	for i := 0; i < 2; i++ {
+		archive, err := snapshotter.OpenExport(container.NodeIp, snapshotImage, snapshotImageId)
+		assert.Nil(t, err, "TestSnapshotCommitAndExport execution result")
+		_, _ = archive.Seek(6, io.SeekStart)
+		content, _ := ioutil.ReadAll(archive)
+		archive.Close()
+		assert.Equal(t, "archive", string(content), "TestSnapshotCommitAndExport image archive is wrong")
+	}
+	assert.Equal(t, 1, engine.exports, "TestSnapshotCommitAndExport image is exported again")
+
+	snapshotter.RemoveExport(snapshotImageId)
+	_, err = os.Stat(filepath.Join(cacheDir, snapshotImageId+".tar"))
+	assert.True(t, os.IsNotExist(err), "TestSnapshotCommitAndExport cached archive is not removed")
+
+	err = snapshotter.DeleteImage(container.NodeIp, snapshotImage)
+	assert.Nil(t, err, "TestSnapshotCommitAndExport execution result")
+	assert.Empty(t, engine.images, "TestSnapshotCommitAndExport image is not deleted")
+}
+
+func TestSnapshotVolumeSnapshots(t *testing.T) {
+	snapshotter := snapshot.NewSnapshotter("2376", nil, "csi-snapclass", "")
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	// Containers of other runtimes than docker are snapshotted through volume snapshots
+	container, err := snapshotter.FindContainer(fake.NewSimpleClientset(getSnapshotPod("containerd://abc123")),
+		"default", "etherpad-0/etherpad")
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+	assert.Empty(t, container.ContainerId, "TestSnapshotVolumeSnapshots container id is wrong")
+	snapshotType, err := snapshotter.GetSnapshotType(container)
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+	assert.Equal(t, snapshot.CsiSnapshot, snapshotType, "TestSnapshotVolumeSnapshots snapshot type is wrong")
+
+	names, err := snapshotter.CreateVolumeSnapshots(dynamicClient, container, snapshotImageId)
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+	assert.Equal(t, []string{"mecm-snapshot-" + snapshotImageId + "-0"}, names,
+		"TestSnapshotVolumeSnapshots snapshot names are wrong")
+	assert.Equal(t, names, snapshot.SplitVolumeSnapshots(snapshot.JoinVolumeSnapshots(names)),
+		"TestSnapshotVolumeSnapshots snapshot names are not kept")
+
+	volumeSnapshots := dynamicClient.Resource(snapshot.VolumeSnapshotResource).Namespace("default")
+	volumeSnapshot, err := volumeSnapshots.Get(context.Background(), names[0], metav1.GetOptions{})
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+	claimName, _, _ := unstructured.NestedString(volumeSnapshot.Object, "spec", "source", "persistentVolumeClaimName")
+	assert.Equal(t, "etherpad-data", claimName, "TestSnapshotVolumeSnapshots claim is wrong")
+	className, _, _ := unstructured.NestedString(volumeSnapshot.Object, "spec", "volumeSnapshotClassName")
+	assert.Equal(t, "csi-snapclass", className, "TestSnapshotVolumeSnapshots snapshot class is wrong")
+
+	snapshotStatus, err := snapshotter.GetVolumeSnapshotStatus(dynamicClient, "default", names)
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+	assert.False(t, snapshotStatus.Ready, "TestSnapshotVolumeSnapshots snapshot is ready before csi driver")
+
+	_ = unstructured.SetNestedField(volumeSnapshot.Object, true, "status", "readyToUse")
+	_ = unstructured.SetNestedField(volumeSnapshot.Object, "1Gi", "status", "restoreSize")
+	_, err = volumeSnapshots.Update(context.Background(), volumeSnapshot, metav1.UpdateOptions{})
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+	snapshotStatus, err = snapshotter.GetVolumeSnapshotStatus(dynamicClient, "default", names)
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+	assert.Equal(t, &snapshot.VolumeSnapshotStatus{Ready: true, Size: 1073741824}, snapshotStatus,
+		"TestSnapshotVolumeSnapshots snapshot status is wrong")
+
+	err = snapshotter.DeleteVolumeSnapshots(dynamicClient, "default", names)
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+	snapshotStatus, err = snapshotter.GetVolumeSnapshotStatus(dynamicClient, "default", names)
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+	assert.True(t, snapshotStatus.Failed, "TestSnapshotVolumeSnapshots deleted snapshot is not failed")
+
+	// Deleting snapshots which do not exist is successful
+	err = snapshotter.DeleteVolumeSnapshots(dynamicClient, "default", names)
+	assert.Nil(t, err, "TestSnapshotVolumeSnapshots execution result")
+}
+
+func TestSnapshotFindContainerFailure(t *testing.T) {
+	snapshotter := snapshot.NewSnapshotter("2376", nil, "", "")
+
+	// First container of pod is used when container name is not given
+	container, err := snapshotter.FindContainer(fake.NewSimpleClientset(getSnapshotPod("docker://abc123")),
+		"default", "etherpad-0")
+	assert.Nil(t, err, "TestSnapshotFindContainerFailure execution result")
+	assert.Equal(t, "sidecar", container.ContainerName, "TestSnapshotFindContainerFailure container is wrong")
+
+	// Docker snapshots are not used when docker engine access is not configured
+	snapshotType, _ := snapshotter.GetSnapshotType(container)
+	assert.Equal(t, snapshot.CsiSnapshot, snapshotType, "TestSnapshotFindContainerFailure snapshot type is wrong")
+
+	_, err = snapshotter.FindContainer(fake.NewSimpleClientset(), "default", "etherpad-0")
+	assert.NotNil(t, err, "TestSnapshotFindContainerFailure unknown pod is found")
+
+	_, err = snapshotter.FindContainer(fake.NewSimpleClientset(getSnapshotPod("docker://abc123")),
+		"default", "etherpad-0/unknown")
+	assert.NotNil(t, err, "TestSnapshotFindContainerFailure unknown container is found")
+
+	// Pod of other runtime than docker without persistent volume claims can not be snapshotted
+	pod := getSnapshotPod("containerd://abc123")
+	pod.Spec.Volumes = nil
+	container, err = snapshotter.FindContainer(fake.NewSimpleClientset(pod), "default", "etherpad-0/etherpad")
+	assert.Nil(t, err, "TestSnapshotFindContainerFailure execution result")
+	_, err = snapshotter.GetSnapshotType(container)
+	assert.NotNil(t, err, "TestSnapshotFindContainerFailure unsupported runtime is accepted")
+}
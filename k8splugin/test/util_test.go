@@ -21,6 +21,7 @@ import (
 	_ "crypto/tls"
 	"github.com/stretchr/testify/assert"
 	_ "io"
+	"io/ioutil"
 	"k8splugin/config"
 	"k8splugin/util"
 	_ "mime/multipart"
@@ -187,6 +188,32 @@ func TestAddValues(t *testing.T)  {
 		"TestAddValues execution result")
 }
 
+func TestAddValuesWithParameters(t *testing.T)  {
+	dir, _ := os.Getwd()
+	tarFile, err := os.Open(dir+"/"+"7e9b913f-748a-42b7-a088-abe3f750f04c.tgz",)
+	if err != nil {
+		return
+	}
+	defer tarFile.Close()
+	appAuthCfg := config.NewBuildAppAuthConfig(appInstanceIdentifier, ak, sk)
+	appAuthCfg.PackageId = packageId
+	appAuthCfg.Parameters = map[string]interface{}{
+		"replicaCount": 2,
+		"appconfig":    "overridden",
+	}
+	dirName, err := appAuthCfg.AddValues(tarFile)
+	if err != nil {
+		return
+	}
+	defer  os.RemoveAll(dirName)
+	defer os.Remove(dirName + ".tar.gz")
+
+	values, _ := ioutil.ReadFile(dirName + "/values.yaml")
+	assert.Contains(t, string(values), "replicaCount: 2", "TestAddValuesWithParameters execution result")
+	assert.Contains(t, string(values), "accesskey: " + ak, "TestAddValuesWithParameters execution result")
+	assert.Contains(t, string(values), "apppackageid: " + packageId, "TestAddValuesWithParameters execution result")
+}
+
 func TestGetTLSConfigSuccess(t *testing.T) {
 	dir, _ := os.Getwd()
 	config, err := util.GetConfiguration(dir)
@@ -200,6 +227,35 @@ func TestGetReleaseNamespaceSuccess(t *testing.T) {
 	assert.Equal(t, "", result, "TestGetReleaseNamespaceSuccess execution result")
 }
 
+func TestValidateNamespaceSuccess(t *testing.T) {
+	err := util.ValidateNamespace("tenant-1")
+	assert.Nil(t, err, "TestValidateNamespaceSuccess execution result")
+}
+
+func TestValidateNamespaceInvalid(t *testing.T) {
+	err := util.ValidateNamespace("Tenant_1")
+	assert.Error(t, err, "TestValidateNamespaceInvalid execution result")
+}
+
+func TestValidateTenantNamespaceSuccess(t *testing.T) {
+	for _, namespace := range []string{tenantIdentifier, tenantIdentifier + "-dev"} {
+		err := util.ValidateTenantNamespace(namespace, tenantIdentifier)
+		assert.Nil(t, err, "TestValidateTenantNamespaceSuccess execution result")
+	}
+}
+
+func TestValidateTenantNamespaceInvalid(t *testing.T) {
+	for _, namespace := range []string{"kube-system", "default", tenantIdentifier + "dev", tenantIdentifier + "-Dev"} {
+		err := util.ValidateTenantNamespace(namespace, tenantIdentifier)
+		assert.Error(t, err, "TestValidateTenantNamespaceInvalid execution result")
+	}
+}
+
+func TestGetAppNamespaceDefault(t *testing.T) {
+	result := util.GetAppNamespace("")
+	assert.Equal(t, util.Default, result, "TestGetAppNamespaceDefault execution result")
+}
+
 func TestInvalidPwd(t *testing.T) {
 	testVar := "invalidpwd"
 	_, err := util.ValidateDbParams(testVar)
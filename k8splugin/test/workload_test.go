@@ -0,0 +1,79 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8splugin/models"
+	"k8splugin/pkg/adapter"
+	"testing"
+)
+
+const workloadManifest = `
+---
+# Source: etherpad/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: etherpad
+spec:
+  replicas: 1
+---
+# Source: etherpad/templates/statefulset.yaml
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: etherpad-db
+  namespace: storage
+---
+# Source: etherpad/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: etherpad-svc
+`
+
+func TestScaleWorkloads(t *testing.T) {
+	replicas := int32(1)
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "etherpad", Namespace: "default"},
+			Spec: appsv1.DeploymentSpec{Replicas: &replicas}, Status: appsv1.DeploymentStatus{ReadyReplicas: 1}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "etherpad-db", Namespace: "storage"}})
+
+	err := adapter.ScaleWorkloads(clientset, workloadManifest, "default", 3)
+	assert.Nil(t, err, "TestScaleWorkloads execution result")
+
+	workloads, err := adapter.GetWorkloads(clientset, workloadManifest, "default")
+	assert.Nil(t, err, "TestScaleWorkloads execution result")
+	assert.Equal(t, []models.WorkloadInfo{
+		{Kind: "Deployment", Name: "etherpad", Replicas: 3, ReadyReplicas: 1},
+		{Kind: "StatefulSet", Name: "etherpad-db", Replicas: 3},
+	}, workloads, "TestScaleWorkloads workloads are not scaled")
+}
+
+func TestScaleWorkloadsFailure(t *testing.T) {
+	// Release without deployments or stateful sets can not be scaled
+	err := adapter.ScaleWorkloads(fake.NewSimpleClientset(), "kind: Service\nmetadata:\n  name: svc\n", "default", 2)
+	assert.NotNil(t, err, "TestScaleWorkloadsFailure release without workload is scaled")
+
+	// Workload of release which does not exist in cluster
+	err = adapter.ScaleWorkloads(fake.NewSimpleClientset(), workloadManifest, "default", 2)
+	assert.NotNil(t, err, "TestScaleWorkloadsFailure missing workload is scaled")
+}
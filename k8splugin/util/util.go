@@ -18,7 +18,9 @@ package util
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"io/ioutil"
 	"k8splugin/conf"
 	"math/rand"
 	"os"
@@ -28,6 +30,7 @@ import (
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
@@ -64,12 +67,20 @@ const (
 	DeployType = "helm"
 	AppInsId = "app_ins_id"
 	AppPkgId = "app_pkg_id"
+	HostIp = "host_ip"
+	AppPackageTable = "app_package"
 	maxHostNameLen = 253
 	maxAkLen = 20
 	maxSkLen = 64
 	MaxIPVal = 255
 	ServerNameRegex string = `^([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])(\.([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\-]{0,61}[a-zA-Z0-9]))*$`
 	Forbidden string = "forbidden"
+	NamespaceRegex string = `^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`
+	NamespaceIsInvalid = "namespace is invalid"
+	VmIdRegex string = `^[a-z0-9]([-a-z0-9.]{0,251}[a-z0-9])?(/[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?)?$`
+	ImageIdRegex string = `^[a-f0-9]{32}$`
+	PodNameRegex string = `^[a-z0-9]([-a-z0-9.]{0,251}[a-z0-9])?$`
+	ContainerNameRegex string = `^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`
 	MaxConfigFile              = 5242880
 	MaxPackageFile             = 536870912
 
@@ -82,10 +93,65 @@ const (
 	Query                  = "Query"
 	Instantiate            = "Instantiate"
 	Terminate              = "Terminate"
+	Upgrade                = "Upgrade"
+	Rollback               = "Rollback"
+	History                = "History"
+	RevisionIsInvalid      = "revision is invalid"
+	ParametersIsInvalid    = "parameters is invalid"
+	AppConfig              = "appconfig"
 	UploadConfig           = "UploadConfig"
 	UploadPackage          = "UploadPackage"
 	RemoveConfig           = "RemoveConfig"
 	DeletePackage          = "DeletePackage"
+	GetCapabilities        = "GetCapabilities"
+	Scale                  = "Scale"
+	ReplicasIsInvalid      = "replicas is invalid"
+	MaxReplicas            = 100
+	DefaultReadyTimeout    = 300
+	MaxReadyTimeout        = 3600
+	ReadyTimeoutIsInvalid  = "ready timeout is invalid"
+	WorkloadsNotReady      = "workloads of application are not ready within timeout"
+	ResourceNameConflict   = "resource names of application conflict with another application instance in the namespace"
+	Healthy                = "Healthy"
+	Progressing            = "Progressing"
+	Degraded               = "Degraded"
+	HealthFailed           = "Failed"
+	ResourceReady          = "Ready"
+	ResourceNotReady       = "NotReady"
+	ResourceComplete       = "Complete"
+	ResourceFailed         = "Failed"
+	ResourceExists         = "Exists"
+	ResourceMissing        = "Missing"
+	ResourceUnknown        = "Unknown"
+	SetAutoscaling         = "SetAutoscaling"
+	DeleteAutoscaling      = "DeleteAutoscaling"
+	AutoscalingIsInvalid   = "autoscaling policy is invalid"
+	AutoscalingIsSet       = "autoscaling policy is set, delete it before scaling"
+	AppInstanceLabel       = "mecm-app-instance-id"
+	ReleaseLabel           = "mecm-release-name"
+	MaxReleaseNameLength   = 53
+	ReleaseHashLength      = 8
+	WorkloadLogs           = "WorkloadLogs"
+	Endpoints              = "Endpoints"
+	PodNameIsInvalid       = "pod name is invalid"
+	ContainerNameIsInvalid = "container name is invalid"
+	TailLinesIsInvalid     = "tail lines is invalid"
+	SinceTimeIsInvalid     = "since time is invalid"
+	PodDoesNotExist        = "pod does not exist in application"
+	EventTypeIsInvalid     = "event type is invalid"
+	CreateVmImage          = "CreateVmImage"
+	QueryVmImage           = "QueryVmImage"
+	DeleteVmImage          = "DeleteVmImage"
+	DownloadVmImage        = "DownloadVmImage"
+	ImageId                = "image_id"
+	ImageIdIsInvalid       = "imageId is invalid"
+	VmIdIsInvalid          = "vmId is invalid"
+	ImageRecordDoesNotExit = "image record does not exist in database"
+	ImageChunkSize         = 1048576
+	ImageQueued            = "queued"
+	ImageActive            = "active"
+	ImageKilled            = "killed"
+	ContainerDeployment    = "container"
 	MecmTenantRole         = "ROLE_MECM_TENANT"
 	MecmAdminRole          = "ROLE_MECM_ADMIN"
 	MecmGuestRole          = "ROLE_MECM_GUEST"
@@ -96,11 +162,28 @@ const (
 	RpcName = " RpcName ["
 	Pod = "Pod"
 	Deployment = "Deployment"
+	StatefulSet = "StatefulSet"
+	DaemonSet = "DaemonSet"
+	Service = "Service"
+	Ingress = "Ingress"
+	PersistentVolumeClaim = "PersistentVolumeClaim"
 	FailedToJsonMarshal = "Failed to json marshal"
 	AppInsIdValid = "appInsId is invalid"
 	FailedToDelAppPkg = "failed to delete application package"
+	RegistryImageLoader = "registry"
+	RuntimeImageLoader = "runtime"
+	AllImageLoaders = "all"
+	DefaultDockerPort = "2376"
+	DefaultSnapshotCacheDir = "/usr/app/snapshots"
+	MetricsServerNotAvailable = "metrics server is not available"
 )
 
+// Rpc names of operations supported by plugin
+var SupportedOperations = []string{"instantiate", "terminate", "query", "upgrade", "rollback", "history",
+	"uploadConfig", "removeConfig", "workloadEvents", "uploadPackage", "deletePackage", "getCapabilities",
+	"createVmImage", "queryVmImage", "deleteVmImage", "downloadVmImage", "scale",
+	"setAutoscaling", "deleteAutoscaling", "workloadLogs", "queryHealth", "endpoints"}
+
 var cipherSuiteMap = map[string]uint16{
 	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256": tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
 	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384": tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
@@ -308,6 +391,78 @@ func ValidateIpv4Address(ipAddress string) error {
 	return validate.Var(ipAddress, "required,ipv4")
 }
 
+// Validate kubernetes namespace
+func ValidateNamespace(namespace string) error {
+	match, err := regexp.MatchString(NamespaceRegex, namespace)
+	if err != nil || !match {
+		return errors.New("namespace validation failed")
+	}
+	return nil
+}
+
+// Validate namespace is owned by the tenant, which is the tenant namespace or one prefixed with it, so that
+// system and other tenants namespaces are never used
+func ValidateTenantNamespace(namespace, tenantId string) error {
+	tenantNamespace := strings.ToLower(tenantId)
+	if namespace != tenantNamespace && !strings.HasPrefix(namespace, tenantNamespace+"-") {
+		return errors.New("namespace is not owned by tenant")
+	}
+	return ValidateNamespace(namespace)
+}
+
+// Validate vm id of container image snapshot, which is pod name optionally followed by container name
+func ValidateVmId(vmId string) error {
+	match, err := regexp.MatchString(VmIdRegex, vmId)
+	if err != nil || !match {
+		return errors.New("vm id validation failed")
+	}
+	return nil
+}
+
+// Validate kubernetes pod name
+func ValidatePodName(podName string) error {
+	match, err := regexp.MatchString(PodNameRegex, podName)
+	if err != nil || !match {
+		return errors.New("pod name validation failed")
+	}
+	return nil
+}
+
+// Validate container name of pod
+func ValidateContainerName(containerName string) error {
+	match, err := regexp.MatchString(ContainerNameRegex, containerName)
+	if err != nil || !match {
+		return errors.New("container name validation failed")
+	}
+	return nil
+}
+
+// Validate container image snapshot id
+func ValidateImageId(imageId string) error {
+	match, err := regexp.MatchString(ImageIdRegex, imageId)
+	if err != nil || !match {
+		return errors.New("image id validation failed")
+	}
+	return nil
+}
+
+// Generate id of container image snapshot
+func GenerateImageId() string {
+	return strings.Replace(uuid.New().String(), "-", "", -1)
+}
+
+// Get namespace of app instance, instances deployed without a namespace are in the release namespace
+func GetAppNamespace(namespace string) string {
+	if namespace != "" {
+		return namespace
+	}
+	releaseNamespace := GetReleaseNamespace()
+	if releaseNamespace != "" {
+		return releaseNamespace
+	}
+	return Default
+}
+
 // Create directory
 func CreateDir(path string) bool {
 	_, err := os.Stat(path)
@@ -353,6 +508,31 @@ func GetTLSConfig(config *conf.ServerConfigurations, certificate string, key str
 	}, nil
 }
 
+// Get tls configuration of client authenticated with client certificate, all of the files are required
+func GetClientTLSConfig(caCert string, clientCert string, clientKey string) (*tls.Config, error) {
+	if caCert == "" || clientCert == "" || clientKey == "" {
+		return nil, errors.New("ca certificate, client certificate and key are required")
+	}
+
+	loadedCert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+	if err != nil {
+		return nil, errors.New("could not load client key pair")
+	}
+	caPem, err := ioutil.ReadFile(caCert)
+	if err != nil {
+		return nil, errors.New("could not read ca certificate")
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caPem) {
+		return nil, errors.New("failed to append ca certificate")
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{loadedCert},
+		RootCAs:      rootCAs,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
 func getCipherSuites(sslCiphers string) []uint16 {
 	cipherSuiteArr := make([]uint16, 0, 5)
 	cipherSuiteNameList := strings.Split(sslCiphers, ",")
@@ -438,6 +618,18 @@ func GetReleaseNamespace() string {
 	return releaseNamespace
 }
 
+// Get image registry user
+func GetRegistryUser() string {
+	registryUser := os.Getenv("REGISTRY_USER")
+	return registryUser
+}
+
+// Get image registry password
+func GetRegistryPassword() string {
+	registryPassword := os.Getenv("REGISTRY_PASSWORD")
+	return registryPassword
+}
+
 // Validate ak
 func ValidateAk(ak string) error {
 	if len(ak) > maxAkLen {
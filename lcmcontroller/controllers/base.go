@@ -24,6 +24,7 @@ import (
 	"lcmcontroller/pkg/dbAdapter"
 	"lcmcontroller/pkg/pluginAdapter"
 	"lcmcontroller/util"
+	"sort"
 	"strings"
 )
 
@@ -110,6 +111,17 @@ func (c *BaseController) getAppInstId(clientIp string) (string, error) {
 	return appInsId, nil
 }
 
+// Get operation Id
+func (c *BaseController) getOperationId(clientIp string) (string, error) {
+	opId := c.Ctx.Input.Param(":opId")
+	opIdVar, err := util.ValidateName(opId, util.UuidRegex)
+	if err != nil || !opIdVar {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.OperationIdIsInvalid)
+		return "", errors.New(util.OperationIdIsInvalid)
+	}
+	return opId, nil
+}
+
 // Get app info record
 func (c *BaseController) getAppInfoRecord(appInsId string, clientIp string) (*models.AppInfoRecord, error) {
 	appInfoRecord := &models.AppInfoRecord{
@@ -176,9 +188,11 @@ func (c *BaseController) getVim(clientIp string, hostIp string) (string, error)
 
 func (c *BaseController) getPluginAdapter(_, clientIp string, vim string) (*pluginAdapter.PluginAdapter,
 	error) {
-	var pluginInfo string
-
-	pluginInfo = util.GetPluginInfo(vim)
+	pluginInfo, err := c.getPluginInfo(vim)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+		return nil, err
+	}
 
 	client, err := pluginAdapter.GetClient(pluginInfo)
 	if err != nil {
@@ -189,6 +203,67 @@ func (c *BaseController) getPluginAdapter(_, clientIp string, vim string) (*plug
 	return adapter, nil
 }
 
+// Check whether operation is supported by plugin, unsupported operation is responded with not implemented
+func (c *BaseController) checkOperationSupported(clientIp string, adapter *pluginAdapter.PluginAdapter,
+	operation string, accessToken string) error {
+	supported, err := adapter.IsOperationSupported(operation, accessToken)
+	if err != nil {
+		// Capabilities are unknown, the operation itself reports the plugin failure
+		log.Warn("failed to check whether operation " + operation + " is supported by plugin")
+		return nil
+	}
+	if !supported {
+		errMsg := "Operation " + operation + " is not supported by plugin of the host"
+		c.HandleLoggingForError(clientIp, util.StatusNotImplemented, errMsg)
+		return errors.New(errMsg)
+	}
+	return nil
+}
+
+// Get plugin info of vim, plugins in registry take precedence over the plugin environment variables
+func (c *BaseController) getPluginInfo(vim string) (string, error) {
+	// Default case of kubernetes for backward compatibility
+	if vim == "" {
+		vim = "k8s"
+	}
+
+	// Query matches vim type as substring of the comma separated vim types, exact match is checked below
+	var plugins []*models.PluginRecord
+	_, err := c.Db.QueryTable(util.PluginRecord, &plugins, util.PluginVimTypesFilter, vim)
+	if err != nil {
+		log.Error("failed to query plugin records of vim " + vim)
+		return "", err
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].PluginName < plugins[j].PluginName
+	})
+
+	for _, plugin := range plugins {
+		for _, vimType := range strings.Split(plugin.VimTypes, ",") {
+			if strings.EqualFold(strings.TrimSpace(vimType), vim) {
+				pluginAdapter.SetPluginConfig(pluginAdapter.PluginConfig{Address: plugin.Address,
+					SslEnabled: plugin.SslEnabled, ServerName: plugin.ServerName})
+				return plugin.Address, nil
+			}
+		}
+	}
+	return util.GetPluginInfo(vim), nil
+}
+
+// Get registered plugin records ordered by plugin name
+func (c *BaseController) getPluginRecords() ([]*models.PluginRecord, error) {
+	var plugins []*models.PluginRecord
+	_, err := c.Db.QueryTable(util.PluginRecord, &plugins, "")
+	if err != nil {
+		log.Error("failed to query plugin records")
+		return nil, err
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].PluginName < plugins[j].PluginName
+	})
+	return plugins, nil
+}
+
 // Handled logging for success case
 func (c *BaseController) handleLoggingForSuccess(clientIp string, msg string) {
 	log.Info("Response message for ClientIP [" + clientIp + util.Operation + c.Ctx.Request.Method + "]" +
@@ -230,6 +305,45 @@ func (c *BaseController) deleteAppInfoRecord(appInsId string) error {
 	return nil
 }
 
+// Get app instance state, records created before the state was introduced are considered instantiated
+func getAppInstanceState(appInfoRecord *models.AppInfoRecord) string {
+	if appInfoRecord.InstanceState == "" {
+		return util.Instantiated
+	}
+	return appInfoRecord.InstanceState
+}
+
+// Move app instance to the given state, illegal state transitions are rejected
+func (c *BaseController) updateAppInstanceState(appInfoRecord *models.AppInfoRecord, state string) error {
+	err := util.ValidateInstanceStateTransition(getAppInstanceState(appInfoRecord), state)
+	if err != nil {
+		return err
+	}
+
+	appInfoRecord.InstanceState = state
+	if strings.EqualFold(appInfoRecord.Origin, "mepm") {
+		appInfoRecord.SyncStatus = false
+	}
+	err = c.Db.InsertOrUpdateData(appInfoRecord, util.AppInsId)
+	if err != nil && err.Error() != util.LastInsertIdNotSupported {
+		log.Error("Failed to save app info record to database.")
+		return err
+	}
+	return nil
+}
+
+// Move app instance identified by app instance id to the given state
+func (c *BaseController) setAppInstanceState(appInsId, state string) error {
+	appInfoRecord := &models.AppInfoRecord{
+		AppInstanceId: appInsId,
+	}
+	err := c.Db.ReadData(appInfoRecord, util.AppInsId)
+	if err != nil {
+		return err
+	}
+	return c.updateAppInstanceState(appInfoRecord, state)
+}
+
 // Delete app package record
 func (c *BaseController) deleteAppPackageRecord(appPkgId string, tenantId string) error {
 	appPkgRecord := &models.AppPackageRecord{
@@ -258,20 +372,28 @@ func (c *BaseController) deleteAppPackageHostRecord(hostIp, appPkgId, tenantId s
 
 // Delete tenant record
 func (c *BaseController) deleteTenantRecord(clientIp, tenantId string) error {
+	err := c.deleteUnusedTenantRecord(tenantId)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return err
+	}
+	return nil
+}
+
+// Delete tenant record if no app info record refers to it
+func (c *BaseController) deleteUnusedTenantRecord(tenantId string) error {
 	tenantRecord := &models.TenantInfoRecord{
 		TenantId: tenantId,
 	}
 
 	count, err := c.Db.QueryCountForTable("app_info_record", util.TenantId, tenantId)
 	if err != nil {
-		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
 		return err
 	}
 
 	if count == 0 {
 		err = c.Db.DeleteData(tenantRecord, util.TenantId)
 		if err != nil {
-			c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
 			return err
 		}
 	}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	log "github.com/sirupsen/logrus"
+	"lcmcontroller/pkg/pluginAdapter"
+	"lcmcontroller/util"
+	"unsafe"
+)
+
+// @Title Get endpoints
+// @Description Get services, node ports, load balancer addresses and ingress paths of application instance
+// @Param	tenantId	path 	string	true   "tenantId"
+// @Param	appInstanceId   path 	string	true   "appInstanceId"
+// @Param       access_token    header  string  true   "access token"
+// @Success 200 ok
+// @Failure 400 bad request
+// @Failure 404 app instance doesn't exist
+// @router /tenants/:tenantId/app_instances/:appInstanceId/endpoints [get]
+func (c *LcmController) GetEndpoints() {
+	log.Info("Get endpoints request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	defer util.ClearByteArray(bKey)
+	tenantId, err := c.getTenantId(clientIp)
+	if err != nil {
+		return
+	}
+	err = util.ValidateAccessToken(accessToken,
+		[]string{util.MecmTenantRole, util.MecmGuestRole, util.MecmAdminRole}, tenantId)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusUnauthorized, util.AuthorizationFailed)
+		return
+	}
+
+	appInsId, err := c.getAppInstId(clientIp)
+	if err != nil {
+		return
+	}
+
+	appInfoRecord, err := c.getAppInfoRecord(appInsId, clientIp)
+	if err != nil {
+		return
+	}
+
+	instanceState := getAppInstanceState(appInfoRecord)
+	if instanceState != util.Instantiated {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "app instance state is:"+instanceState)
+		return
+	}
+
+	vim, err := c.getVim(clientIp, appInfoRecord.MecHost)
+	if err != nil {
+		return
+	}
+
+	adapter, err := c.getPluginAdapter(appInfoRecord.DeployType, clientIp, vim)
+	if err != nil {
+		return
+	}
+
+	err = c.checkOperationSupported(clientIp, adapter, pluginAdapter.OperationEndpoints, accessToken)
+	if err != nil {
+		return
+	}
+
+	response, err := adapter.GetEndpoints(accessToken, appInfoRecord.MecHost, appInsId)
+	if err != nil {
+		c.handlePluginFailure(clientIp, err)
+		return
+	}
+	c.Ctx.Output.Header(util.ContentType, util.ApplicationJson)
+	_, err = c.Ctx.ResponseWriter.Write([]byte(response))
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToWriteRes)
+		return
+	}
+	c.handleLoggingForSuccess(clientIp, "Get endpoints is successful")
+}
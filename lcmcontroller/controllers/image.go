@@ -20,11 +20,14 @@ package controllers
 import (
 	"encoding/json"
 	"errors"
+	beegoCtx "github.com/astaxie/beego/context"
 	log "github.com/sirupsen/logrus"
 	"lcmcontroller/models"
 	"lcmcontroller/pkg/pluginAdapter"
 	"lcmcontroller/util"
+	"net/http"
 	"strconv"
+	"strings"
 	"unsafe"
 )
 
@@ -45,7 +48,7 @@ type ImageController struct {
 func (c *ImageController) CreateImage() {
 	log.Info("Image creation request received.")
 
-	accessToken, bKey, appInfoRecord, adapter, clientIp, err := c.getInputParams()
+	accessToken, bKey, appInfoRecord, adapter, clientIp, err := c.getInputParams(pluginAdapter.OperationCreateVmImage)
 	if err != nil {
 		util.ClearByteArray(bKey)
 		return
@@ -89,7 +92,7 @@ func (c *ImageController) CreateImage() {
 func (c *ImageController) DeleteImage() {
 	log.Info("Image deletion request received.")
 
-	accessToken, bKey, appInfoRecord, adapter, clientIp, err := c.getInputParams()
+	accessToken, bKey, appInfoRecord, adapter, clientIp, err := c.getInputParams(pluginAdapter.OperationDeleteVmImage)
 	if err != nil {
 		util.ClearByteArray(bKey)
 		return
@@ -124,7 +127,7 @@ func (c *ImageController) DeleteImage() {
 // @router /tenants/:tenantId/app_instances/:appInstanceId/images/:imageId [get]
 func (c *ImageController) GetImage() {
 	log.Info("Query image request received.")
-	accessToken, bKey, appInfoRecord, adapter, clientIp, err := c.getInputParams()
+	accessToken, bKey, appInfoRecord, adapter, clientIp, err := c.getInputParams(pluginAdapter.OperationQueryVmImage)
 	if err != nil {
 		util.ClearByteArray(bKey)
 		return
@@ -152,57 +155,125 @@ func (c *ImageController) GetImage() {
 }
 
 // @Title Download Image file
-// @Description download a specific chunk of image file
+// @Description download image file, the whole image is streamed unless a byte range or a chunk is requested
 // @Param   tenantId        path 	string	true   "tenantId"
 // @Param   appInstanceId   path 	string	true   "appInstanceId"
 // @Param   imageId         path 	string	true   "imageId"
 // @Param   access_token    header  string  true   "access token"
-// @Param   chunk_num       header  string  true   "chunk number"
+// @Param   Range           header  string  false  "byte range, e.g. bytes=0-1023"
+// @Param   chunk_num       header  string  false  "chunk number"
 // @Success 200 ok
+// @Success 206 partial content
 // @Failure 404 image or chunk doesn't exist
+// @Failure 416 range not satisfiable
 // @router /tenants/:tenantId/app_instances/:appInstanceId/images/:imageId/file [get]
 func (c *ImageController) GetImageFile() {
 	log.Info("Download image file request received.")
 
-	accessToken, bKey, appInfoRecord, adapter, clientIp, err := c.getInputParams()
+	accessToken, bKey, appInfoRecord, adapter, clientIp, err := c.getInputParams(pluginAdapter.OperationDownloadVmImage)
 	if err != nil {
 		util.ClearByteArray(bKey)
 		return
 	}
+	defer util.ClearByteArray(bKey)
 
 	imageId, err := c.getImgId(clientIp, bKey)
 	if nil != err {
 		return
 	}
 
-	chunkNum, err := c.getChunkNum(clientIp)
+	writer, err := c.getImageWriter(clientIp, adapter, appInfoRecord, accessToken, imageId)
 	if err != nil {
-		util.ClearByteArray(bKey)
 		return
 	}
 
-	if chunkNum == 0 {
-		responseWriter := c.Ctx.ResponseWriter
-		_, err = adapter.DownloadVmImage(responseWriter, appInfoRecord.MecHost, accessToken, appInfoRecord.AppInstanceId, imageId,
-			chunkNum)
-		util.ClearByteArray(bKey)
-		if err != nil {
-			// To check if any more error code needs to be returned.
+	_, err = adapter.DownloadVmImage(writer, appInfoRecord.MecHost, accessToken, appInfoRecord.AppInstanceId,
+		imageId, writer.startChunk)
+	if err != nil && err != errRangeComplete {
+		if writer.written == 0 {
 			c.HandleLoggingForError(clientIp, util.BadRequest, err.Error())
 			return
 		}
+		// Response is already started, so failure can only be logged
+		log.Error("VM image download is interrupted: " + err.Error())
+		return
 	}
-	util.ClearByteArray(bKey)
-	_, ok := util.VmImageMap[chunkNum]
-	if ok {
-		_, _ = c.Ctx.ResponseWriter.Write(util.VmImageMap[chunkNum])
-		delete(util.VmImageMap, chunkNum)
-		c.handleLoggingForSuccess(clientIp, "VM Image download chunk is successful")
-	} else {
-		c.HandleLoggingForError(clientIp, util.StatusInternalServerError,
-			"data is not exist for given chunk number")
+	writer.writeHeader()
+	c.handleLoggingForSuccess(clientIp, "VM Image download is successful")
+}
+
+// Get image writer for the requested byte range or chunk, chunk header is kept for older clients. Download
+// starts from the chunk containing the start of the range
+func (c *ImageController) getImageWriter(clientIp string, adapter *pluginAdapter.PluginAdapter,
+	appInfoRecord *models.AppInfoRecord, accessToken, imageId string) (*imageWriter, error) {
+	writer := &imageWriter{response: c.Ctx.ResponseWriter, end: -1, status: http.StatusOK}
+	rangeHeader := c.Ctx.Request.Header.Get("Range")
+	chunkHeader := c.Ctx.Request.Header.Get("chunk_num")
+	if rangeHeader == "" && chunkHeader == "" {
+		return writer, nil
+	}
+
+	imageInfo, err := c.getImageInfo(adapter, appInfoRecord, accessToken, imageId)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, "failed to get image info")
+		return nil, err
+	}
+
+	if rangeHeader == "" {
+		chunkNum, err := c.getChunkNum(clientIp)
+		if err != nil {
+			return nil, err
+		}
+		if imageInfo.ChunkSize <= 0 || (imageInfo.SumChunkNum > 0 && int64(chunkNum) >= imageInfo.SumChunkNum) {
+			c.HandleLoggingForError(clientIp, util.StatusNotFound, "data is not exist for given chunk number")
+			return nil, errors.New("chunk does not exist")
+		}
+		writer.start = int64(chunkNum) * imageInfo.ChunkSize
+		writer.end = writer.start + imageInfo.ChunkSize - 1
+		writer.seekChunk(imageInfo.ChunkSize)
+		return writer, nil
+	}
+
+	start, end, err := parseByteRange(rangeHeader, imageInfo.ImageSize)
+	if err != nil {
+		if imageInfo.ImageSize > 0 {
+			c.Ctx.ResponseWriter.Header().Set("Content-Range", "bytes */"+strconv.FormatInt(imageInfo.ImageSize, 10))
+		}
+		c.HandleLoggingForError(clientIp, http.StatusRequestedRangeNotSatisfiable, err.Error())
+		return nil, err
+	}
+	if end < 0 {
+		// Range end can not be reported without image size, so whole image is returned
+		return writer, nil
 	}
 
+	size := "*"
+	if imageInfo.ImageSize > 0 {
+		size = strconv.FormatInt(imageInfo.ImageSize, 10)
+		writer.response.Header().Set("Content-Length", strconv.FormatInt(end-start+1, 10))
+	}
+	writer.start = start
+	writer.end = end
+	writer.seekChunk(imageInfo.ChunkSize)
+	writer.status = http.StatusPartialContent
+	writer.response.Header().Set("Content-Range", "bytes "+strconv.FormatInt(start, 10)+"-"+
+		strconv.FormatInt(end, 10)+"/"+size)
+	return writer, nil
+}
+
+// Get image info from plugin
+func (c *ImageController) getImageInfo(adapter *pluginAdapter.PluginAdapter, appInfoRecord *models.AppInfoRecord,
+	accessToken, imageId string) (*models.VmImageInfo, error) {
+	response, err := adapter.QueryVmImage(appInfoRecord.MecHost, accessToken, appInfoRecord.AppInstanceId, imageId)
+	if err != nil {
+		return nil, err
+	}
+	var imageInfo models.VmImageInfo
+	err = json.Unmarshal([]byte(response), &imageInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &imageInfo, nil
 }
 
 // Get Image Id
@@ -220,14 +291,14 @@ func (c *ImageController) getChunkNum(clientIp string) (int32, error) {
 	chunkString := c.Ctx.Request.Header.Get("chunk_num")
 
 	i, err := strconv.ParseInt(chunkString, 10, 32)
-	if err != nil {
+	if err != nil || i < 0 {
 		c.HandleLoggingForError(clientIp, util.BadRequest, "Chunk number is invalid")
 		return 0, errors.New("Chunk number is invalid")
 	}
 	return int32(i), nil
 }
 
-func (c *ImageController) getInputParams() (accessToken string, bKey []byte, appInfoRecord *models.AppInfoRecord,
+func (c *ImageController) getInputParams(operation string) (accessToken string, bKey []byte, appInfoRecord *models.AppInfoRecord,
 	adapter *pluginAdapter.PluginAdapter, clientIp string, err error) {
 	clientIp = c.Ctx.Input.IP()
 	err = util.ValidateSrcAddress(clientIp)
@@ -262,6 +333,11 @@ func (c *ImageController) getInputParams() (accessToken string, bKey []byte, app
 	if err != nil {
 		return accessToken, bKey, appInfoRecord, adapter, clientIp, err
 	}
+
+	err = c.checkOperationSupported(clientIp, adapter, operation, accessToken)
+	if err != nil {
+		return accessToken, bKey, appInfoRecord, adapter, clientIp, err
+	}
 	return accessToken, bKey, appInfoRecord, adapter, clientIp, nil
 }
 
@@ -281,4 +357,108 @@ func (c *ImageController) sendResponse(response, clientIp string) error {
 		return err
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// Error returned by image writer when requested range is written, which stops the download
+var errRangeComplete = errors.New("requested range is written")
+
+// Writer which writes requested byte range of image stream to the response, image stream starts from the
+// start chunk
+type imageWriter struct {
+	response      *beegoCtx.Response
+	startChunk    int32
+	offset        int64
+	start         int64
+	end           int64
+	written       int64
+	status        int
+	headerWritten bool
+}
+
+// Start image stream from the chunk containing the start of the range, whole image is streamed when chunk
+// size is not known
+func (w *imageWriter) seekChunk(chunkSize int64) {
+	if chunkSize <= 0 {
+		return
+	}
+	w.startChunk = int32(w.start / chunkSize)
+	w.offset = int64(w.startChunk) * chunkSize
+}
+
+// Write part of image data which is in the requested range
+func (w *imageWriter) Write(data []byte) (int, error) {
+	low := w.offset
+	if low < w.start {
+		low = w.start
+	}
+	high := w.offset + int64(len(data))
+	if w.end >= 0 && high > w.end+1 {
+		high = w.end + 1
+	}
+
+	if low < high {
+		w.writeHeader()
+		n, err := w.response.Write(data[low-w.offset : high-w.offset])
+		w.written += int64(n)
+		if err != nil {
+			return 0, err
+		}
+	}
+	w.offset += int64(len(data))
+	if w.end >= 0 && w.offset > w.end {
+		return len(data), errRangeComplete
+	}
+	return len(data), nil
+}
+
+// Write response header once before the first image data
+func (w *imageWriter) writeHeader() {
+	if w.headerWritten {
+		return
+	}
+	w.headerWritten = true
+	w.response.Header().Set("Content-Type", "application/octet-stream")
+	w.response.Header().Set("Accept-Ranges", "bytes")
+	if w.status != http.StatusOK {
+		w.response.WriteHeader(w.status)
+	}
+}
+
+// Parse single byte range of range header, end is -1 when range is open and image size is not known
+func parseByteRange(header string, size int64) (start int64, end int64, err error) {
+	if !strings.HasPrefix(header, "bytes=") || strings.Contains(header, ",") {
+		return 0, 0, errors.New("range is invalid or not supported")
+	}
+	bounds := strings.SplitN(strings.TrimPrefix(header, "bytes="), "-", 2)
+	if len(bounds) != 2 {
+		return 0, 0, errors.New("range is invalid")
+	}
+
+	if bounds[0] == "" {
+		// Suffix range with the last bytes of image
+		suffix, err := strconv.ParseInt(bounds[1], 10, 64)
+		if err != nil || suffix <= 0 || size <= 0 {
+			return 0, 0, errors.New("suffix range is invalid or image size is not known")
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return size - suffix, size - 1, nil
+	}
+
+	start, err = strconv.ParseInt(bounds[0], 10, 64)
+	if err != nil || start < 0 || (size > 0 && start >= size) {
+		return 0, 0, errors.New("range start is invalid")
+	}
+	end = -1
+	if bounds[1] != "" {
+		end, err = strconv.ParseInt(bounds[1], 10, 64)
+		if err != nil || end < start {
+			return 0, 0, errors.New("range end is invalid")
+		}
+	}
+	if size > 0 && (end < 0 || end >= size) {
+		end = size - 1
+	}
+	return start, end, nil
+}
@@ -18,7 +18,6 @@
 package controllers
 
 import (
-	"archive/zip"
 	"bytes"
 	"encoding/json"
 	"errors"
@@ -29,10 +28,14 @@ import (
 	"mime/multipart"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/ghodss/yaml"
+	"lcmcontroller/pkg/csar"
 	"lcmcontroller/pkg/pluginAdapter"
 	"lcmcontroller/util"
 	"os"
@@ -90,7 +93,12 @@ func (c *LcmController) UploadConfig() {
 		return
 	}
 
-	pluginInfo := util.GetPluginInfo(vim)
+	pluginInfo, err := c.getPluginInfo(vim)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+		return
+	}
 	client, err := pluginAdapter.GetClient(pluginInfo)
 	if err != nil {
 		util.ClearByteArray(bKey)
@@ -135,74 +143,16 @@ func (c *LcmController) validateYamlFile(clientIp string, file multipart.File) e
 
 // extract CSAR package
 func (c *LcmController) extractCsarPackage(packagePath string) (string, error) {
-	zipReader, _ := zip.OpenReader(packagePath)
-	if len(zipReader.File) > util.TooManyFile {
-		return "", errors.New("Too many files contains in zip file")
-	}
-	var totalWrote int64
 	packageDir := path.Dir(packagePath)
-	err := os.MkdirAll(packageDir, 0750)
+	err := csar.Extract(packagePath, packageDir, csar.ExtractLimits{MaxFiles: util.TooManyFile,
+		MaxFileSize: util.SingleFileTooBig, MaxTotalSize: util.TooBig})
 	if err != nil {
-		log.Error(util.FailedToMakeDir)
-		return "" ,errors.New(util.FailedToMakeDir)
-	}
-	for _, file := range zipReader.Reader.File {
-
-		zippedFile, err := file.Open()
-		if err != nil || zippedFile == nil {
-			log.Error("Failed to open zip file")
-			continue
-		}
-		if file.UncompressedSize64 > util.SingleFileTooBig || totalWrote > util.TooBig {
-			log.Error("File size limit is exceeded")
-		}
-
-		defer zippedFile.Close()
-
-		isContinue, wrote := c.extractFiles(file, zippedFile, totalWrote, packageDir)
-		if isContinue {
-			continue
-		}
-		totalWrote = wrote
+		log.Error("failed to extract csar package: " + err.Error())
+		return "", err
 	}
 	return packageDir, nil
 }
 
-// Extract files
-func (c *LcmController) extractFiles(file *zip.File, zippedFile io.ReadCloser, totalWrote int64, dirName string) (bool, int64) {
-	targetDir := dirName
-	extractedFilePath := filepath.Join(
-		targetDir,
-		file.Name,
-	)
-
-	if file.FileInfo().IsDir() {
-		err := os.MkdirAll(extractedFilePath, 0750)
-		if err != nil {
-			log.Error("Failed to create directory")
-		}
-	} else {
-		outputFile, err := os.OpenFile(
-			extractedFilePath,
-			os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
-			0750,
-		)
-		if err != nil || outputFile == nil {
-			log.Error("The output file is nil")
-			return true, totalWrote
-		}
-
-		defer outputFile.Close()
-
-		wt, err := io.Copy(outputFile, zippedFile)
-		if err != nil {
-			log.Error("Failed to copy zipped file")
-		}
-		totalWrote += wt
-	}
-	return false, totalWrote
-}
-
 // get file with extension
 func (c *LcmController) getFileContainsExtension(clientIp string, pkgDir string, ext string) (string, error) {
 	d, err := os.Open(pkgDir)
@@ -227,6 +177,40 @@ func (c *LcmController) getFileContainsExtension(clientIp string, pkgDir string,
 	return "", errors.New(util.FileNameNotFound + ext)
 }
 
+// Validate application package extracted next to its archive, validation errors are returned to the client
+func (c *LcmController) validatePackage(clientIp string, pkgDir string, pkgFilePath string) error {
+	validator, err := csar.NewValidator(util.GetAppConfig(util.PackageTrustStore), csar.ValidatorOptions{
+		VmPackagesAllowed:   true,
+		ManifestNotEnforced: util.GetAppConfig(util.PackageManifestNotEnforced) == "true",
+		IgnoredFiles:        []string{filepath.Base(pkgFilePath)}})
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return err
+	}
+
+	err = validator.Validate(pkgDir)
+	if err == nil {
+		return nil
+	}
+
+	removeErr := os.RemoveAll(pkgDir)
+	if removeErr != nil {
+		log.Error("failed to remove invalid application package")
+	}
+	validationErrs, ok := err.(csar.ValidationErrors)
+	if !ok {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return err
+	}
+	c.Data["json"] = models.PackageValidationResponse{Message: util.PackageValidationFailed,
+		ValidationErrors: validationErrs}
+	c.Ctx.ResponseWriter.WriteHeader(util.BadRequest)
+	c.ServeJSON()
+	log.Info("Response message for ClientIP [" + clientIp + util.Operation + c.Ctx.Request.Method + "]" +
+		util.Resource + c.Ctx.Input.URL() + "] Result [Failure: " + util.PackageValidationFailed + ".]")
+	return err
+}
+
 // Get application package details
 func (c *LcmController) getPackageDetailsFromPackage(clientIp string,
 	packageDir string) (models.AppPkgDetails, error) {
@@ -285,7 +269,12 @@ func (c *LcmController) RemoveConfig() {
 		util.ClearByteArray(bKey)
 		return
 	}
-	pluginInfo := util.GetPluginInfo(vim)
+	pluginInfo, err := c.getPluginInfo(vim)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+		return
+	}
 	client, err := pluginAdapter.GetClient(pluginInfo)
 	if err != nil {
 		util.ClearByteArray(bKey)
@@ -317,7 +306,7 @@ func (c *LcmController) RemoveConfig() {
 // @Param   tenantId        path 	string	true   "tenantId"
 // @Param   appInstanceId   path 	string	true   "appInstanceId"
 // @Param   access_token    header      string  true   "access token"
-// @Success 200 ok
+// @Success 202 accepted
 // @Failure 400 bad request
 // @router /tenants/:tenantId/app_instances/:appInstanceId/instantiate [post]
 func (c *LcmController) Instantiate() {
@@ -360,7 +349,7 @@ func (c *LcmController) Instantiate() {
 		util.ClearByteArray(bKey)
 		return
 	}
-	if appPkgHostRecord.Status != "Distributed" {
+	if appPkgHostRecord.Status != util.Distributed {
 		c.HandleLoggingForError(clientIp, util.BadRequest,
 			"application package distribution status is:" + appPkgHostRecord.Status)
 		util.ClearByteArray(bKey)
@@ -385,7 +374,12 @@ func (c *LcmController) Instantiate() {
 		return
 	}
 
-	pluginInfo := util.GetPluginInfo(vim)
+	pluginInfo, err := c.getPluginInfo(vim)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+		return
+	}
 	client, err := pluginAdapter.GetClient(pluginInfo)
 	if err != nil {
 		util.ClearByteArray(bKey)
@@ -393,6 +387,13 @@ func (c *LcmController) Instantiate() {
 		return
 	}
 
+	parameters, err := getDeployParameters(req.Parameters)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ParametersIsInvalid)
+		return
+	}
+
 	err, appAuthConfig, acm := processAkSkConfig(appInsId, appName)
 	if err != nil {
 		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
@@ -413,6 +414,7 @@ func (c *LcmController) Instantiate() {
 	appInfoParams.AppPackageId = packageId
 	appInfoParams.AppName = appName
 	appInfoParams.Origin = req.Origin
+	appInfoParams.InstanceState = util.Instantiating
 
 	err = c.insertOrUpdateAppInfoRecord(clientIp, appInfoParams)
 	if err != nil {
@@ -420,66 +422,979 @@ func (c *LcmController) Instantiate() {
 		return
 	}
 
-	adapter := pluginAdapter.NewPluginAdapter(pluginInfo, client)
-	err, _ = adapter.Instantiate(tenantId, hostIp, packageId, accessToken, appAuthConfig)
-	util.ClearByteArray(bKey)
+	operation := &models.LcmOperation{
+		OperationType: util.OperationInstantiate,
+		TenantId:      tenantId,
+		AppInstanceId: appInsId,
+		AppPackageId:  packageId,
+		HostIp:        hostIp,
+	}
+	err = c.createLcmOperation(clientIp, operation)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.handleErrorForInstantiateApp(acm, appInsId, tenantId)
+		return
+	}
+
+	adapter := pluginAdapter.NewPluginAdapter(pluginInfo, client)
+	readyOptions := pluginAdapter.ReadyOptions{WaitForReady: req.WaitForReady, ReadyTimeout: req.ReadyTimeout}
+	go c.processInstantiate(operation, adapter, accessToken, appAuthConfig, req.Namespace, parameters, readyOptions)
+
+	c.handleOperationAccepted(clientIp, operation, "Application instantiation is accepted")
+}
+
+// Process application instantiation, runs in background after the request is accepted
+func (c *LcmController) processInstantiate(operation *models.LcmOperation, adapter *pluginAdapter.PluginAdapter,
+	accessToken string, appAuthConfig config.AppAuthConfig, namespace, parameters string,
+	readyOptions pluginAdapter.ReadyOptions) {
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	err, _ := adapter.Instantiate(operation.TenantId, operation.HostIp, operation.AppPackageId, accessToken,
+		appAuthConfig, namespace, parameters, readyOptions)
+	util.ClearByteArray(bKey)
+	if err != nil {
+		// Failed instance is kept to be cleaned up by terminate, which also removes the auth config, release
+		// which is not ready in time is already uninstalled by the plugin
+		stateErr := c.setAppInstanceState(operation.AppInstanceId, util.InstantiationFailed)
+		if stateErr != nil {
+			log.Error("Failed to update app instance state: " + stateErr.Error())
+		}
+		c.completeLcmOperation(operation, err)
+		return
+	}
+
+	err = c.setAppInstanceState(operation.AppInstanceId, util.Instantiated)
+	c.completeLcmOperation(operation, err)
+}
+
+func (c *LcmController) validateToken(accessToken string, req models.InstantiateRequest,  clientIp string) (string, string, string, string, string, error) {
+
+	if len(c.Ctx.Input.RequestBody) > util.RequestBodyLength {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.RequestBodyTooLarge)
+		return "", "", "", "", "", errors.New(util.RequestBodyTooLarge)
+	}
+
+	appInsId, tenantId, hostIp, packageId, appName, err := c.ValidateInstantiateInputParameters(clientIp, req)
+	if err != nil {
+		return "", "", "", "", "", err
+	}
+	err = util.ValidateAccessToken(accessToken, []string{util.MecmTenantRole, util.MecmAdminRole}, tenantId)
+	if err != nil {
+		c.HandleLoggingForTokenFailure(clientIp, err.Error())
+		return "", "", "", "", "", err
+	}
+	return appInsId, tenantId, hostIp, packageId, appName, nil
+}
+
+// Get json encoded deployment parameters, empty when no parameters are given
+func getDeployParameters(parameters map[string]interface{}) (string, error) {
+	if len(parameters) == 0 {
+		return "", nil
+	}
+	deployParameters, err := json.Marshal(parameters)
+	if err != nil {
+		return "", err
+	}
+	return string(deployParameters), nil
+}
+
+// Process Ak Sk configuration
+func processAkSkConfig(appInsId, appName string) (error, config.AppAuthConfig, config.AppConfigAdapter) {
+	appAuthConfig := config.NewAppAuthCfg(appInsId)
+	err := appAuthConfig.GenerateAkSK()
+	if err != nil {
+		return err, config.AppAuthConfig{}, config.AppConfigAdapter{}
+	}
+
+	acm := config.NewAppConfigMgr(appInsId, appName, appAuthConfig)
+	err = acm.PostAppAuthConfig()
+	if err != nil {
+		return err, config.AppAuthConfig{}, config.AppConfigAdapter{}
+	}
+	return nil, appAuthConfig, acm
+}
+
+
+
+// @Title Terminate application
+// @Description Terminate application
+// @Param	tenantId	path 	string	true   "tenantId"
+// @Param	appInstanceId   path 	string	true   "appInstanceId"
+// @Param       access_token    header  string  true   "access token"
+// @Success 202 accepted
+// @Failure 400 bad request
+// @router /tenants/:tenantId/app_instances/:appInstanceId/terminate [post]
+func (c *LcmController) Terminate() {
+	log.Info("Application termination request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+
+	tenantId, err := c.isPermitted(accessToken, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	appInsId, err := c.getAppInstId(clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	appInfoRecord, err := c.getAppInfoRecord(appInsId, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	vim, err := c.getVim(clientIp, appInfoRecord.MecHost)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	adapter, err := c.getPluginAdapter(appInfoRecord.DeployType, clientIp, vim)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	previousState := getAppInstanceState(appInfoRecord)
+	err = util.ValidateInstanceStateTransition(previousState, util.Terminating)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, err.Error())
+		return
+	}
+
+	operation := &models.LcmOperation{
+		OperationType: util.OperationTerminate,
+		TenantId:      tenantId,
+		AppInstanceId: appInsId,
+		AppPackageId:  appInfoRecord.AppPackageId,
+		HostIp:        appInfoRecord.MecHost,
+	}
+	err = c.createLcmOperation(clientIp, operation)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = c.updateAppInstanceState(appInfoRecord, util.Terminating)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.completeLcmOperation(operation, err)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return
+	}
+
+	go c.processTerminate(operation, adapter, accessToken, appInfoRecord.Origin, previousState)
+
+	c.handleOperationAccepted(clientIp, operation, "Application termination is accepted")
+}
+
+// Process application termination, runs in background after the request is accepted
+func (c *LcmController) processTerminate(operation *models.LcmOperation, adapter *pluginAdapter.PluginAdapter,
+	accessToken, origin, previousState string) {
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	_, err := adapter.Terminate(operation.HostIp, accessToken, operation.AppInstanceId)
+	util.ClearByteArray(bKey)
+	if err != nil {
+		// Failed instance may not be deployed at all, hence its records are cleaned up regardless
+		if previousState != util.InstantiationFailed {
+			// Workload of the instance is still running, so the instance is returned to its previous state
+			c.failTermination(operation, err, previousState)
+			return
+		}
+		log.Info("Ignoring terminate failure of failed app instance: " + err.Error())
+	}
+
+	// Workload is removed, instance whose records are not deleted is failed so that terminate can be retried
+	err = c.deleteTerminatedAppRecords(operation.AppInstanceId, operation.TenantId, origin)
+	if err != nil {
+		c.failTermination(operation, err, util.InstantiationFailed)
+		return
+	}
+	c.completeLcmOperation(operation, nil)
+}
+
+// Move app instance to the given state and mark termination operation as failed
+func (c *LcmController) failTermination(operation *models.LcmOperation, opErr error, state string) {
+	err := c.setAppInstanceState(operation.AppInstanceId, state)
+	if err != nil {
+		log.Error("Failed to update app instance state: " + err.Error())
+	}
+	c.completeLcmOperation(operation, opErr)
+}
+
+// Delete records of terminated application
+func (c *LcmController) deleteTerminatedAppRecords(appInsId, tenantId, origin string) error {
+	acm := config.NewAppConfigMgr(appInsId, "", config.AppAuthConfig{})
+	err := acm.DeleteAppAuthConfig()
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAppInfoRecord(appInsId)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteUnusedTenantRecord(tenantId)
+	if err != nil {
+		return err
+	}
+
+	appInsKeyRec := &models.AppInstanceStaleRec{
+		AppInstanceId: appInsId,
+		TenantId:      tenantId,
+	}
+	if strings.EqualFold(origin, "mepm") {
+		err = c.Db.InsertOrUpdateData(appInsKeyRec, util.AppInsId)
+		if err != nil && err.Error() != util.LastInsertIdNotSupported {
+			log.Error("Failed to save app instance key record to database.")
+			return err
+		}
+	}
+	return nil
+}
+
+// @Title Upgrade application
+// @Description Upgrade application instance to a new package version
+// @Param	tenantId	path 	string	true   "tenantId"
+// @Param	appInstanceId   path 	string	true   "appInstanceId"
+// @Param       access_token    header  string  true   "access token"
+// @Param       body        body    models.UpgradeRequest   true      "The package to upgrade to"
+// @Success 202 accepted
+// @Failure 400 bad request
+// @Failure 404 not found
+// @router /tenants/:tenantId/app_instances/:appInstanceId/upgrade [post]
+func (c *LcmController) Upgrade() {
+	log.Info("Application upgrade request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+
+	tenantId, err := c.isPermitted(accessToken, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	appInsId, err := c.getAppInstId(clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	var req models.UpgradeRequest
+	err = json.Unmarshal(c.Ctx.Input.RequestBody, &req)
+	if err != nil || len(req.PackageId) == 0 || len(req.PackageId) > 64 {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.PackageIdIsInvalid)
+		return
+	}
+
+	appInfoRecord, err := c.getAppInfoRecord(appInsId, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = util.ValidateInstanceStateTransition(getAppInstanceState(appInfoRecord), util.Upgrading)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, err.Error())
+		return
+	}
+
+	appPkgHostRecord := &models.AppPackageHostRecord{
+		PkgHostKey: req.PackageId + tenantId + appInfoRecord.MecHost,
+	}
+	readErr := c.Db.ReadData(appPkgHostRecord, util.PkgHostKey)
+	if readErr != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.StatusNotFound,
+			"App package host record not exists")
+		return
+	}
+	if appPkgHostRecord.Status != util.Distributed {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest,
+			"application package distribution status is:" + appPkgHostRecord.Status)
+		return
+	}
+
+	vim, err := c.getVim(clientIp, appInfoRecord.MecHost)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	adapter, err := c.getPluginAdapter(appInfoRecord.DeployType, clientIp, vim)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = c.checkOperationSupported(clientIp, adapter, pluginAdapter.OperationUpgrade, accessToken)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	// New ak sk values are injected into the upgraded release, these are registered only once the upgrade
+	// is successful so that the running release keeps working with its current values on failure
+	appAuthConfig := config.NewAppAuthCfg(appInsId)
+	err = appAuthConfig.GenerateAkSK()
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return
+	}
+
+	operation := &models.LcmOperation{
+		OperationType: util.OperationUpgrade,
+		TenantId:      tenantId,
+		AppInstanceId: appInsId,
+		AppPackageId:  req.PackageId,
+		HostIp:        appInfoRecord.MecHost,
+	}
+	err = c.createLcmOperation(clientIp, operation)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = c.updateAppInstanceState(appInfoRecord, util.Upgrading)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.completeLcmOperation(operation, err)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return
+	}
+
+	go c.processUpgrade(operation, adapter, accessToken, appInfoRecord.AppName, appAuthConfig)
+
+	c.handleOperationAccepted(clientIp, operation, "Application upgrade is accepted")
+}
+
+// Process application upgrade, runs in background after the request is accepted
+func (c *LcmController) processUpgrade(operation *models.LcmOperation, adapter *pluginAdapter.PluginAdapter,
+	accessToken string, appName string, appAuthConfig config.AppAuthConfig) {
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	err, _ := adapter.Upgrade(operation.TenantId, operation.HostIp, operation.AppPackageId, accessToken,
+		appAuthConfig)
+	util.ClearByteArray(bKey)
+	if err != nil {
+		// Failed upgrade leaves the current release in place, so the instance is still instantiated
+		stateErr := c.setAppInstanceState(operation.AppInstanceId, util.Instantiated)
+		if stateErr != nil {
+			log.Error("Failed to update app instance state: " + stateErr.Error())
+		}
+		c.completeLcmOperation(operation, err)
+		return
+	}
+
+	// Ak sk values injected into the upgraded release are registered
+	acm := config.NewAppConfigMgr(operation.AppInstanceId, appName, appAuthConfig)
+	authErr := acm.PostAppAuthConfig()
+	if authErr != nil {
+		log.Error("Failed to register auth config of upgraded app instance: " + authErr.Error())
+	}
+
+	// Package of the app instance is changed only once the upgrade is successful
+	appInfoRecord := &models.AppInfoRecord{
+		AppInstanceId: operation.AppInstanceId,
+	}
+	err = c.Db.ReadData(appInfoRecord, util.AppInsId)
+	if err == nil {
+		appInfoRecord.AppPackageId = operation.AppPackageId
+		err = c.updateAppInstanceState(appInfoRecord, util.Instantiated)
+	}
+	if err == nil {
+		err = authErr
+	}
+	c.completeLcmOperation(operation, err)
+}
+
+// @Title Rollback application
+// @Description Rollback application instance to a previous revision
+// @Param	tenantId	path 	string	true   "tenantId"
+// @Param	appInstanceId   path 	string	true   "appInstanceId"
+// @Param       access_token    header  string  true   "access token"
+// @Param       body        body    models.RollbackRequest   true      "The revision to rollback to, 0 for previous"
+// @Success 202 accepted
+// @Failure 400 bad request
+// @Failure 404 not found
+// @router /tenants/:tenantId/app_instances/:appInstanceId/rollback [post]
+func (c *LcmController) Rollback() {
+	log.Info("Application rollback request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+
+	tenantId, err := c.isPermitted(accessToken, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	appInsId, err := c.getAppInstId(clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	var req models.RollbackRequest
+	err = json.Unmarshal(c.Ctx.Input.RequestBody, &req)
+	if err != nil || req.Revision < 0 {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.RevisionIsInvalid)
+		return
+	}
+
+	appInfoRecord, err := c.getAppInfoRecord(appInsId, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = util.ValidateInstanceStateTransition(getAppInstanceState(appInfoRecord), util.RollingBack)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, err.Error())
+		return
+	}
+
+	vim, err := c.getVim(clientIp, appInfoRecord.MecHost)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	adapter, err := c.getPluginAdapter(appInfoRecord.DeployType, clientIp, vim)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = c.checkOperationSupported(clientIp, adapter, pluginAdapter.OperationRollback, accessToken)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = c.checkRollbackRevision(clientIp, adapter, accessToken, appInfoRecord, req.Revision)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	operation := &models.LcmOperation{
+		OperationType: util.OperationRollback,
+		TenantId:      tenantId,
+		AppInstanceId: appInsId,
+		AppPackageId:  appInfoRecord.AppPackageId,
+		HostIp:        appInfoRecord.MecHost,
+	}
+	err = c.createLcmOperation(clientIp, operation)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = c.updateAppInstanceState(appInfoRecord, util.RollingBack)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.completeLcmOperation(operation, err)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return
+	}
+
+	go c.processRollback(operation, adapter, accessToken, req.Revision)
+
+	c.handleOperationAccepted(clientIp, operation, "Application rollback is accepted")
+}
+
+// Check revision to rollback to is in the release history, revision 0 rolls back to the previous revision so the
+// release needs more than one revision
+func (c *LcmController) checkRollbackRevision(clientIp string, adapter *pluginAdapter.PluginAdapter,
+	accessToken string, appInfoRecord *models.AppInfoRecord, revision int32) error {
+	err := c.checkOperationSupported(clientIp, adapter, pluginAdapter.OperationHistory, accessToken)
+	if err != nil {
+		return err
+	}
+
+	response, err := adapter.History(accessToken, appInfoRecord.AppInstanceId, appInfoRecord.MecHost)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			c.HandleLoggingForError(clientIp, util.StatusNotFound, err.Error())
+			return err
+		}
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return err
+	}
+	var revisions []models.ReleaseRevision
+	err = json.Unmarshal([]byte(response), &revisions)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return err
+	}
+
+	if revision == 0 && len(revisions) > 1 {
+		return nil
+	}
+	for _, releaseRevision := range revisions {
+		if revision != 0 && releaseRevision.Revision == revision {
+			return nil
+		}
+	}
+	c.HandleLoggingForError(clientIp, util.BadRequest, util.RevisionNotFound)
+	return errors.New(util.RevisionNotFound)
+}
+
+// Process application rollback, runs in background after the request is accepted
+func (c *LcmController) processRollback(operation *models.LcmOperation, adapter *pluginAdapter.PluginAdapter,
+	accessToken string, revision int32) {
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	restored, err := adapter.Rollback(operation.HostIp, accessToken, operation.AppInstanceId, revision)
+	util.ClearByteArray(bKey)
+	if err != nil {
+		// Failed rollback leaves the current release in place, so the instance is still instantiated
+		stateErr := c.setAppInstanceState(operation.AppInstanceId, util.Instantiated)
+		if stateErr != nil {
+			log.Error("Failed to update app instance state: " + stateErr.Error())
+		}
+		c.completeLcmOperation(operation, err)
+		return
+	}
+
+	appInfoRecord := &models.AppInfoRecord{
+		AppInstanceId: operation.AppInstanceId,
+	}
+	err = c.Db.ReadData(appInfoRecord, util.AppInsId)
+	if err != nil {
+		c.completeLcmOperation(operation, err)
+		return
+	}
+
+	// Ak sk values of the restored revision are registered again, as these are replaced by later upgrades
+	var authErr error
+	if restored.Ak != "" && restored.Sk != "" {
+		appAuthConfig := config.AppAuthConfig{AppInsId: operation.AppInstanceId, Ak: restored.Ak, Sk: restored.Sk}
+		acm := config.NewAppConfigMgr(operation.AppInstanceId, appInfoRecord.AppName, appAuthConfig)
+		authErr = acm.PostAppAuthConfig()
+		if authErr != nil {
+			log.Error("Failed to register auth config of rolled back app instance: " + authErr.Error())
+		}
+	}
+
+	// Package of the app instance is changed to the package of the restored revision
+	if restored.PackageId != "" {
+		appInfoRecord.AppPackageId = restored.PackageId
+		operation.AppPackageId = restored.PackageId
+	}
+	err = c.updateAppInstanceState(appInfoRecord, util.Instantiated)
+	if err == nil {
+		err = authErr
+	}
+	c.completeLcmOperation(operation, err)
+}
+
+// @Title Scale application
+// @Description Scale workloads of application instance to the given number of replicas, scaling is rejected
+// while autoscaling policy is set as the autoscaler would override the replicas
+// @Param	tenantId	path 	string	true   "tenantId"
+// @Param	appInstanceId   path 	string	true   "appInstanceId"
+// @Param       access_token    header  string  true   "access token"
+// @Param       body        body    models.ScaleRequest   true      "The number of replicas"
+// @Success 202 accepted
+// @Failure 400 bad request
+// @Failure 409 autoscaling policy is set
+// @router /tenants/:tenantId/app_instances/:appInstanceId/scale [post]
+func (c *LcmController) Scale() {
+	log.Info("Application scale request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+
+	tenantId, err := c.isPermitted(accessToken, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	appInsId, err := c.getAppInstId(clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	var req models.ScaleRequest
+	err = json.Unmarshal(c.Ctx.Input.RequestBody, &req)
+	if err != nil || req.Replicas == nil || *req.Replicas < 0 || *req.Replicas > util.MaxReplicas {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ReplicasIsInvalid)
+		return
+	}
+
+	appInfoRecord, err := c.getAppInfoRecord(appInsId, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+	if appInfoRecord.AutoscalingPolicy != "" {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.StatusConflict, util.AutoscalingIsSet)
+		return
+	}
+
+	err = util.ValidateInstanceStateTransition(getAppInstanceState(appInfoRecord), util.Scaling)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, err.Error())
+		return
+	}
+
+	vim, err := c.getVim(clientIp, appInfoRecord.MecHost)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	adapter, err := c.getPluginAdapter(appInfoRecord.DeployType, clientIp, vim)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = c.checkOperationSupported(clientIp, adapter, pluginAdapter.OperationScale, accessToken)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	operation := &models.LcmOperation{
+		OperationType: util.OperationScale,
+		TenantId:      tenantId,
+		AppInstanceId: appInsId,
+		AppPackageId:  appInfoRecord.AppPackageId,
+		HostIp:        appInfoRecord.MecHost,
+	}
+	err = c.createLcmOperation(clientIp, operation)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	err = c.updateAppInstanceState(appInfoRecord, util.Scaling)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.completeLcmOperation(operation, err)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return
+	}
+
+	go c.processScale(operation, adapter, accessToken, *req.Replicas)
+
+	c.handleOperationAccepted(clientIp, operation, "Application scale is accepted")
+}
+
+// Process application scale, runs in background after the request is accepted. Failed scale leaves
+// the deployed release in place, so app instance returns to instantiated state
+func (c *LcmController) processScale(operation *models.LcmOperation, adapter *pluginAdapter.PluginAdapter,
+	accessToken string, replicas int32) {
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	_, err := adapter.Scale(operation.HostIp, accessToken, operation.AppInstanceId, replicas)
+	util.ClearByteArray(bKey)
+
+	stateErr := c.setAppInstanceState(operation.AppInstanceId, util.Instantiated)
+	if err == nil {
+		err = stateErr
+	} else if stateErr != nil {
+		log.Error("Failed to update app instance state: " + stateErr.Error())
+	}
+	c.completeLcmOperation(operation, err)
+}
+
+// @Title Set application autoscaling policy
+// @Description Create or update horizontal autoscaling policy of application instance workloads
+// @Param	tenantId	path 	string	true   "tenantId"
+// @Param	appInstanceId   path 	string	true   "appInstanceId"
+// @Param       access_token    header  string  true   "access token"
+// @Param       body        body    models.AutoscalingPolicy   true      "The autoscaling policy"
+// @Success 200 ok
+// @Failure 400 bad request
+// @router /tenants/:tenantId/app_instances/:appInstanceId/autoscaling [put]
+func (c *LcmController) SetAutoscaling() {
+	log.Info("Application set autoscaling request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+
+	_, err = c.isPermitted(accessToken, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	var policy models.AutoscalingPolicy
+	err = json.Unmarshal(c.Ctx.Input.RequestBody, &policy)
+	if err != nil || !isAutoscalingPolicyValid(&policy) {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.AutoscalingIsInvalid)
+		return
+	}
+	policyJson, err := json.Marshal(policy)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.MarshalError)
+		return
+	}
+
+	appInfoRecord, adapter, err := c.getAutoscalingAdapter(clientIp, accessToken,
+		pluginAdapter.OperationSetAutoscaling)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	_, err = adapter.SetAutoscaling(appInfoRecord.MecHost, accessToken, appInfoRecord.AppInstanceId,
+		string(policyJson))
+	util.ClearByteArray(bKey)
+	if err != nil {
+		c.HandleLoggingForFailure(clientIp, err.Error())
+		return
+	}
+
+	err = c.saveAutoscalingPolicy(appInfoRecord, string(policyJson))
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return
+	}
+	c.Data["json"] = policy
+	c.handleLoggingForSuccess(clientIp, "Set autoscaling policy is successful")
+	c.ServeJSON()
+}
+
+// @Title Get application autoscaling policy
+// @Description Get horizontal autoscaling policy of application instance
+// @Param	tenantId	path 	string	true   "tenantId"
+// @Param	appInstanceId   path 	string	true   "appInstanceId"
+// @Param       access_token    header  string  true   "access token"
+// @Success 200 ok
+// @Failure 404 autoscaling policy is not set
+// @router /tenants/:tenantId/app_instances/:appInstanceId/autoscaling [get]
+func (c *LcmController) GetAutoscaling() {
+	log.Info("Application get autoscaling request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	tenantId, err := c.getTenantId(clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+	err = util.ValidateAccessToken(accessToken,
+		[]string{util.MecmTenantRole, util.MecmGuestRole, util.MecmAdminRole}, tenantId)
+	util.ClearByteArray(bKey)
+	if err != nil {
+		c.HandleLoggingForTokenFailure(clientIp, err.Error())
+		return
+	}
+
+	appInsId, err := c.getAppInstId(clientIp)
+	if err != nil {
+		return
+	}
+
+	appInfoRecord, err := c.getAppInfoRecord(appInsId, clientIp)
+	if err != nil {
+		return
+	}
+
+	if appInfoRecord.AutoscalingPolicy == "" {
+		c.HandleLoggingForError(clientIp, util.StatusNotFound, util.AutoscalingNotSet)
+		return
+	}
+	c.Ctx.ResponseWriter.Header().Set(util.ContentType, util.ApplicationJson)
+	_, err = c.Ctx.ResponseWriter.Write([]byte(appInfoRecord.AutoscalingPolicy))
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToWriteRes)
+		return
+	}
+	c.handleLoggingForSuccess(clientIp, "Get autoscaling policy is successful")
+}
+
+// @Title Delete application autoscaling policy
+// @Description Delete horizontal autoscaling policy of application instance, replicas are kept as scaled
+// @Param	tenantId	path 	string	true   "tenantId"
+// @Param	appInstanceId   path 	string	true   "appInstanceId"
+// @Param       access_token    header  string  true   "access token"
+// @Success 200 ok
+// @Failure 404 autoscaling policy is not set
+// @router /tenants/:tenantId/app_instances/:appInstanceId/autoscaling [delete]
+func (c *LcmController) DeleteAutoscaling() {
+	log.Info("Application delete autoscaling request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+
+	_, err = c.isPermitted(accessToken, clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	appInfoRecord, adapter, err := c.getAutoscalingAdapter(clientIp, accessToken,
+		pluginAdapter.OperationDelAutoscaling)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+	if appInfoRecord.AutoscalingPolicy == "" {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.StatusNotFound, util.AutoscalingNotSet)
+		return
+	}
+
+	_, err = adapter.DeleteAutoscaling(appInfoRecord.MecHost, accessToken, appInfoRecord.AppInstanceId)
+	util.ClearByteArray(bKey)
+	if err != nil {
+		c.HandleLoggingForFailure(clientIp, err.Error())
+		return
+	}
+
+	err = c.saveAutoscalingPolicy(appInfoRecord, "")
 	if err != nil {
-		c.handleErrorForInstantiateApp(acm, clientIp, appInsId, tenantId)
 		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
 		return
 	}
-
-	c.handleLoggingForSuccess(clientIp, "Application instantiated successfully")
+	c.handleLoggingForSuccess(clientIp, "Delete autoscaling policy is successful")
 	c.ServeJSON()
 }
 
-func (c *LcmController) validateToken(accessToken string, req models.InstantiateRequest,  clientIp string) (string, string, string, string, string, error) {
-
-	if len(c.Ctx.Input.RequestBody) > util.RequestBodyLength {
-		c.HandleLoggingForError(clientIp, util.BadRequest, util.RequestBodyTooLarge)
-		return "", "", "", "", "", errors.New(util.RequestBodyTooLarge)
+// Get app info record and plugin adapter of instantiated app instance for autoscaling operation
+func (c *LcmController) getAutoscalingAdapter(clientIp, accessToken,
+	operation string) (*models.AppInfoRecord, *pluginAdapter.PluginAdapter, error) {
+	appInsId, err := c.getAppInstId(clientIp)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	appInsId, tenantId, hostIp, packageId, appName, err := c.ValidateInstantiateInputParameters(clientIp, req)
+	appInfoRecord, err := c.getAppInfoRecord(appInsId, clientIp)
 	if err != nil {
-		return "", "", "", "", "", err
+		return nil, nil, err
 	}
-	err = util.ValidateAccessToken(accessToken, []string{util.MecmTenantRole, util.MecmAdminRole}, tenantId)
+
+	if getAppInstanceState(appInfoRecord) != util.Instantiated {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.InstanceNotInstantiated)
+		return nil, nil, errors.New(util.InstanceNotInstantiated)
+	}
+
+	vim, err := c.getVim(clientIp, appInfoRecord.MecHost)
 	if err != nil {
-		c.HandleLoggingForTokenFailure(clientIp, err.Error())
-		return "", "", "", "", "", err
+		return nil, nil, err
 	}
-	return appInsId, tenantId, hostIp, packageId, appName, nil
-}
 
-// Process Ak Sk configuration
-func processAkSkConfig(appInsId, appName string) (error, config.AppAuthConfig, config.AppConfigAdapter) {
-	appAuthConfig := config.NewAppAuthCfg(appInsId)
-	err := appAuthConfig.GenerateAkSK()
+	adapter, err := c.getPluginAdapter(appInfoRecord.DeployType, clientIp, vim)
 	if err != nil {
-		return err, config.AppAuthConfig{}, config.AppConfigAdapter{}
+		return nil, nil, err
 	}
 
-	acm := config.NewAppConfigMgr(appInsId, appName, appAuthConfig)
-	err = acm.PostAppAuthConfig()
+	err = c.checkOperationSupported(clientIp, adapter, operation, accessToken)
 	if err != nil {
-		return err, config.AppAuthConfig{}, config.AppConfigAdapter{}
+		return nil, nil, err
 	}
-	return nil, appAuthConfig, acm
+	return appInfoRecord, adapter, nil
 }
 
+// Save autoscaling policy of app instance, empty policy means autoscaling is not enabled
+func (c *LcmController) saveAutoscalingPolicy(appInfoRecord *models.AppInfoRecord, policy string) error {
+	appInfoRecord.AutoscalingPolicy = policy
+	if strings.EqualFold(appInfoRecord.Origin, "mepm") {
+		appInfoRecord.SyncStatus = false
+	}
+	err := c.Db.InsertOrUpdateData(appInfoRecord, util.AppInsId)
+	if err != nil && err.Error() != util.LastInsertIdNotSupported {
+		log.Error("Failed to save app info record to database.")
+		return err
+	}
+	return nil
+}
 
+// Check replica bounds and that at least one utilization target is given
+func isAutoscalingPolicyValid(policy *models.AutoscalingPolicy) bool {
+	if policy.MinReplicas < 1 || policy.MaxReplicas < policy.MinReplicas || policy.MaxReplicas > util.MaxReplicas {
+		return false
+	}
+	if policy.TargetCpuUtilization < 0 || policy.TargetMemoryUtilization < 0 {
+		return false
+	}
+	return policy.TargetCpuUtilization > 0 || policy.TargetMemoryUtilization > 0
+}
 
-// @Title Terminate application
-// @Description Terminate application
+// @Title Application revision history
+// @Description Query revision history of application instance
 // @Param	tenantId	path 	string	true   "tenantId"
 // @Param	appInstanceId   path 	string	true   "appInstanceId"
 // @Param       access_token    header  string  true   "access token"
 // @Success 200 ok
 // @Failure 400 bad request
-// @router /tenants/:tenantId/app_instances/:appInstanceId/terminate [post]
-func (c *LcmController) Terminate() {
-	log.Info("Application termination request received.")
+// @Failure 404 not found
+// @router /tenants/:tenantId/app_instances/:appInstanceId/history [get]
+func (c *LcmController) History() {
+	log.Info("Application revision history request received.")
 
 	clientIp := c.Ctx.Input.IP()
 	err := util.ValidateSrcAddress(clientIp)
@@ -490,10 +1405,16 @@ func (c *LcmController) Terminate() {
 	c.displayReceivedMsg(clientIp)
 	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
 	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
-
-	tenantId, err := c.isPermitted(accessToken, clientIp)
+	tenantId, err := c.getTenantId(clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+	err = util.ValidateAccessToken(accessToken,
+		[]string{util.MecmTenantRole, util.MecmGuestRole, util.MecmAdminRole}, tenantId)
 	if err != nil {
 		util.ClearByteArray(bKey)
+		c.HandleLoggingForTokenFailure(clientIp, err.Error())
 		return
 	}
 
@@ -521,47 +1442,29 @@ func (c *LcmController) Terminate() {
 		return
 	}
 
-	_, err = adapter.Terminate(appInfoRecord.MecHost, accessToken, appInfoRecord.AppInstanceId)
-	util.ClearByteArray(bKey)
-	if err != nil {
-		c.HandleLoggingForFailure(clientIp, err.Error())
-		return
-	}
-
-	acm := config.NewAppConfigMgr(appInsId, "", config.AppAuthConfig{})
-	err = acm.DeleteAppAuthConfig()
+	err = c.checkOperationSupported(clientIp, adapter, pluginAdapter.OperationHistory, accessToken)
 	if err != nil {
-		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		util.ClearByteArray(bKey)
 		return
 	}
 
-	var origin = appInfoRecord.Origin
-
-	err = c.deleteAppInfoRecord(appInsId)
+	response, err := adapter.History(accessToken, appInsId, appInfoRecord.MecHost)
+	util.ClearByteArray(bKey)
 	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			c.HandleLoggingForError(clientIp, util.StatusNotFound, err.Error())
+			return
+		}
 		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
 		return
 	}
-
-	err = c.deleteTenantRecord(clientIp, tenantId)
+	c.Ctx.ResponseWriter.Header().Set(util.ContentType, util.ApplicationJson)
+	_, err = c.Ctx.ResponseWriter.Write([]byte(response))
 	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToWriteRes)
 		return
 	}
-
-	appInsKeyRec := &models.AppInstanceStaleRec{
-		AppInstanceId: appInsId,
-		TenantId:      tenantId,
-	}
-	if strings.EqualFold(origin, "mepm") {
-		err = c.Db.InsertOrUpdateData(appInsKeyRec, util.AppInsId)
-		if err != nil && err.Error() != util.LastInsertIdNotSupported {
-			log.Error("Failed to save app instance key record to database.")
-			return
-		}
-	}
-
-	c.handleLoggingForSuccess(clientIp, "Termination is successful")
-	c.ServeJSON()
+	c.handleLoggingForSuccess(clientIp, "Query revision history is successful")
 }
 
 // @Title App Deployment status
@@ -635,6 +1538,44 @@ func (c *LcmController) HealthCheck() {
 	_, _ = c.Ctx.ResponseWriter.Write([]byte("ok"))
 }
 
+// @Title Plugin connection status
+// @Description Query status of pooled plugin connections
+// @Param   access_token    header  string  true    "access token"
+// @Success 200 ok
+// @Failure 400 bad request
+// @router /plugins/status [get]
+func (c *LcmController) PluginStatus() {
+	log.Info("Plugin connection status request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	err = util.ValidateAccessToken(accessToken, []string{util.MecmAdminRole}, "")
+	util.ClearByteArray(bKey)
+	if err != nil {
+		c.HandleLoggingForTokenFailure(clientIp, err.Error())
+		return
+	}
+
+	res, err := json.Marshal(pluginAdapter.GetPoolStatus())
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToMarshal)
+		return
+	}
+	c.Ctx.ResponseWriter.Header().Set(util.ContentType, util.ApplicationJson)
+	_, err = c.Ctx.ResponseWriter.Write(res)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToWriteRes)
+		return
+	}
+	c.handleLoggingForSuccess(clientIp, "Query plugin connection status is successful")
+}
+
 // @Title Query
 // @Description perform query operation
 // @Param	tenantId	path 	string	true	"tenantId"
@@ -679,6 +1620,13 @@ func (c *LcmController) Query() {
 		return
 	}
 
+	instanceState := getAppInstanceState(appInfoRecord)
+	if instanceState != util.Instantiated {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.BadRequest, "app instance state is:" + instanceState)
+		return
+	}
+
 	vim, err := c.getVim(clientIp, appInfoRecord.MecHost)
 	if err != nil {
 		util.ClearByteArray(bKey)
@@ -975,6 +1923,7 @@ func (c *LcmController) insertOrUpdateAppInfoRecord(clientIp string, appInfoPara
 		AppName:      appInfoParams.AppName,
 		Origin:       origin,
 		SyncStatus:   syncStatus,
+		InstanceState: appInfoParams.InstanceState,
 		MecHostRec:      hostInfoRec,
 	}
 
@@ -1114,21 +2063,22 @@ func (c *LcmController) diskUsage(prometheusServiceName string, prometheusPort,
 	return diskUtilization, nil
 }
 
-func (c *LcmController) handleErrorForInstantiateApp(acm config.AppConfigAdapter,
-	clientIp, appInsId, tenantId string) {
+// Handle error for instantiate application, cleans up the records created for instantiation
+func (c *LcmController) handleErrorForInstantiateApp(acm config.AppConfigAdapter, appInsId, tenantId string) {
 	err := acm.DeleteAppAuthConfig()
 	if err != nil {
-		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		log.Error("Failed to delete app auth config: " + err.Error())
 		return
 	}
 	err = c.deleteAppInfoRecord(appInsId)
 	if err != nil {
-		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		log.Error("Failed to delete app info record: " + err.Error())
 		return
 	}
 
-	err = c.deleteTenantRecord(clientIp, tenantId)
+	err = c.deleteUnusedTenantRecord(tenantId)
 	if err != nil {
+		log.Error("Failed to delete tenant record: " + err.Error())
 		return
 	}
 }
@@ -1144,9 +2094,8 @@ func createDirectory(dir string) error {
 	return nil
 }
 
-func (c *LcmController) saveApplicationPackage(clientIp string, tenantId string, packageId string,
-	header *multipart.FileHeader, file multipart.File) (string, error) {
-
+// Create application package directory and return the package file path
+func createPackageDirectory(tenantId string, packageId string) (string, error) {
 	err := createDirectory(PackageFolderPath + tenantId)
 	if err != nil {
 		return "", err
@@ -1156,8 +2105,17 @@ func (c *LcmController) saveApplicationPackage(clientIp string, tenantId string,
 	if err != nil {
 		return "", err
 	}
+	return PackageFolderPath + tenantId + "/" + packageId + "/" + packageId + ".csar", nil
+}
+
+func (c *LcmController) saveApplicationPackage(clientIp string, tenantId string, packageId string,
+	header *multipart.FileHeader, file multipart.File) (string, error) {
+
+	pkgPath, err := createPackageDirectory(tenantId, packageId)
+	if err != nil {
+		return "", err
+	}
 
-	pkgPath := PackageFolderPath + tenantId + "/" + packageId + "/" + packageId + ".csar"
 	err = c.createPackagePath(pkgPath, clientIp, file)
 	if err != nil {
 		return "", err
@@ -1172,6 +2130,8 @@ func (c *LcmController) saveApplicationPackage(clientIp string, tenantId string,
 // @Param	tenantId	    path 	string	true	"tenantId"
 // @Param	appInstanceId   path 	string	true	"appInstanceId"
 // @Param   access_token    header  string  true    "access token"
+// @Param   since_time      query   string  false   "RFC3339 time, only events seen after it are returned"
+// @Param   type            query   string  false   "event type, Normal or Warning"
 // @Success 200 ok
 // @Failure 400 bad request
 // @router /tenants/:tenantId/app_instances/:appInstanceId/workload/events  [get]
@@ -1205,6 +2165,12 @@ func (c *LcmController) GetWorkloadDescription() {
 		return
 	}
 
+	filter, err := c.getEventFilter(clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
 	appInfoRecord, err := c.getAppInfoRecord(appInsId, clientIp)
 	if err != nil {
 		util.ClearByteArray(bKey)
@@ -1222,7 +2188,7 @@ func (c *LcmController) GetWorkloadDescription() {
 		util.ClearByteArray(bKey)
 		return
 	}
-	response, err := adapter.GetWorkloadDescription(accessToken, appInfoRecord.MecHost, appInsId)
+	response, err := adapter.GetWorkloadDescription(accessToken, appInfoRecord.MecHost, appInsId, filter)
 	util.ClearByteArray(bKey)
 	if err != nil {
 		res := strings.Contains(err.Error(), "not found")
@@ -1241,6 +2207,26 @@ func (c *LcmController) GetWorkloadDescription() {
 	c.handleLoggingForSuccess(clientIp, "Workload description is successful")
 }
 
+// Get workload event filter from query parameters
+func (c *LcmController) getEventFilter(clientIp string) (pluginAdapter.EventFilter, error) {
+	filter := pluginAdapter.EventFilter{
+		SinceTime: c.GetString("since_time"),
+		Type:      c.GetString("type"),
+	}
+	if filter.SinceTime != "" {
+		_, err := time.Parse(time.RFC3339, filter.SinceTime)
+		if err != nil {
+			c.HandleLoggingForError(clientIp, util.BadRequest, util.SinceTimeIsInvalid)
+			return filter, err
+		}
+	}
+	if filter.Type != "" && filter.Type != util.EventTypeNormal && filter.Type != util.EventTypeWarning {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.EventTypeIsInvalid)
+		return filter, errors.New(util.EventTypeIsInvalid)
+	}
+	return filter, nil
+}
+
 // @Title Sync app instances records
 // @Description Sync app instances records
 // @Param   tenantId    path 	string	    true   "tenantId"
@@ -1519,46 +2505,60 @@ func (c *LcmController) UploadPackage() {
 		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetClient)
 		return
 	}
-	pkgDir, err := c.extractCsarPackage(pkgFilePath)
+	util.ClearByteArray(bKey)
+	err = c.processUploadedPackage(clientIp, appId, tenantId, packageId, origin, pkgFilePath)
 	if err != nil {
-		util.ClearByteArray(bKey)
-		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetClient)
 		return
 	}
 
-	pkgDetails, err := c.getPackageDetailsFromPackage(clientIp, pkgDir)
+	c.handleLoggingForSuccess(clientIp, "Uploaded application package successfully")
+
+	appPkgResp, _ := json.Marshal(map[string]string{"appId" : appId,
+		                                            "packageId" : packageId})
+	_, _ = c.Ctx.ResponseWriter.Write(appPkgResp)
+}
+
+// Extract and validate saved application package and add its records
+func (c *LcmController) processUploadedPackage(clientIp, appId, tenantId, packageId, origin,
+	pkgFilePath string) error {
+
+	pkgDir, err := c.extractCsarPackage(pkgFilePath)
 	if err != nil {
-		util.ClearByteArray(bKey)
-		c.HandleLoggingForError(clientIp, util.BadRequest, "failed to get app package details")
-		return
+		c.HandleLoggingForError(clientIp, util.BadRequest, err.Error())
+		return err
 	}
 
-	err = c.insertOrUpdateTenantRecord(clientIp, tenantId)
+	err = c.validatePackage(clientIp, pkgDir, pkgFilePath)
 	if err != nil {
-		util.ClearByteArray(bKey)
-		return
+		return err
 	}
 
-	err = c.insertOrUpdateAppPkgRecord(appId, clientIp, tenantId, packageId, pkgDetails, origin)
+	pkgDetails, err := c.getPackageDetailsFromPackage(clientIp, pkgDir)
 	if err != nil {
-		util.ClearByteArray(bKey)
-		return
+		c.HandleLoggingForError(clientIp, util.BadRequest, "failed to get app package details")
+		return err
 	}
 
-	c.handleLoggingForSuccess(clientIp, "Uploaded application package successfully")
+	err = c.insertOrUpdateTenantRecord(clientIp, tenantId)
+	if err != nil {
+		return err
+	}
 
-	appPkgResp, _ := json.Marshal(map[string]string{"appId" : appId,
-		                                            "packageId" : packageId})
-	_, _ = c.Ctx.ResponseWriter.Write(appPkgResp)
+	return c.insertOrUpdateAppPkgRecord(appId, clientIp, tenantId, packageId, pkgDetails, origin)
 }
 
 func (c *LcmController) ValidateDistributeInputParameters(clientIp string, req models.DistributeRequest) (string, error) {
 
+	hostIps := make(map[string]bool)
 	for _, hostIp := range req.HostIp {
 		err := util.ValidateIpv4Address(hostIp)
 		if err != nil {
 		    return "", errors.New("invalid host IP")
 	    }
+		if hostIps[hostIp] {
+			return "", errors.New("duplicate host IP")
+		}
+		hostIps[hostIp] = true
 	}
 
 	packageId, err := c.getUrlPackageId(clientIp)
@@ -1607,6 +2607,11 @@ func (c *LcmController) ValidateInstantiateInputParameters(clientIp string, req
 		return "", "", "",  "", "", errors.New(util.AppNameIsNotValid)
 	}
 
+	if req.ReadyTimeout < 0 || req.ReadyTimeout > util.MaxReadyTimeout {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ReadyTimeoutIsInvalid)
+		return "", "", "",  "", "", errors.New(util.ReadyTimeoutIsInvalid)
+	}
+
 	appInsId, err := c.getAppInstId(clientIp)
 	if err != nil {
 		return "", "", "",  "", "", err
@@ -1617,6 +2622,15 @@ func (c *LcmController) ValidateInstantiateInputParameters(clientIp string, req
 		return "", "", "",  "", "", err
 	}
 
+	// Only namespaces owned by the tenant can be given, system and other tenants namespaces are rejected
+	if req.Namespace != "" {
+		err = util.ValidateTenantNamespace(req.Namespace, tenantId)
+		if err != nil {
+			c.HandleLoggingForError(clientIp, util.BadRequest, util.NamespaceIsInvalid)
+			return "", "", "",  "", "", errors.New(util.NamespaceIsInvalid)
+		}
+	}
+
 	return appInsId, tenantId, hostIp, packageId, appName, nil
 }
 
@@ -1625,7 +2639,7 @@ func (c *LcmController) ValidateInstantiateInputParameters(clientIp string, req
 // @Param   access_token  header     string true   "access token"
 // @Param   packageId     header     string true   "package ID"
 // @Param   hostIp        body       string true   "host IP"
-// @Success 200 ok
+// @Success 202 accepted
 // @Failure 400 bad request
 // @router /packages/:packageId [post]
 func (c *LcmController) DistributePackage() {
@@ -1679,14 +2693,27 @@ func (c *LcmController) DistributePackage() {
 		return
 	}
 
-	err = c.processUploadPackage(hosts, clientIp, tenantId, packageId, accessToken)
-	util.ClearByteArray(bKey)
+	hostPluginInfo, err := c.prepareUploadPackage(hosts, clientIp, tenantId, packageId)
 	if err != nil {
+		util.ClearByteArray(bKey)
 		return
 	}
 
-	c.handleLoggingForSuccess(clientIp, "Distributed application package successfully")
-	c.ServeJSON()
+	operation := &models.LcmOperation{
+		OperationType: util.OperationDistribute,
+		TenantId:      tenantId,
+		AppPackageId:  packageId,
+		HostIp:        strings.Join(hosts.HostIp, ","),
+	}
+	err = c.createLcmOperation(clientIp, operation)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+
+	go c.processUploadPackage(operation, hosts.HostIp, hostPluginInfo, accessToken)
+
+	c.handleOperationAccepted(clientIp, operation, "Application package distribution is accepted")
 }
 
 // @Title Delete application package on host
@@ -1728,7 +2755,12 @@ func (c *LcmController) DeletePackageOnHost() {
 		return
 	}
 
-	pluginInfo := util.GetPluginInfo(vim)
+	pluginInfo, err := c.getPluginInfo(vim)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+		return
+	}
 	client, err := pluginAdapter.GetClient(pluginInfo)
 	if err != nil {
 		util.ClearByteArray(bKey)
@@ -2209,38 +3241,138 @@ func (c *LcmController) SynchronizeAppPackageStaleRecord() {
 	c.handleLoggingForSuccess(clientIp, "Stale app package records synchronization is successful")
 }
 
-// Process upload package
-func (c *LcmController) processUploadPackage(hosts models.DistributeRequest,
-	clientIp, tenantId, packageId, accessToken string) error {
+// Prepare upload package, resolves plugin of each host and marks the hosts as distributing
+func (c *LcmController) prepareUploadPackage(hosts models.DistributeRequest,
+	clientIp, tenantId, packageId string) (map[string]string, error) {
+	hostPluginInfo := make(map[string]string)
 	for _, hostIp := range hosts.HostIp {
 		vim, err := c.getVim(clientIp, hostIp)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		hostPluginInfo[hostIp], err = c.getPluginInfo(vim)
+		if err != nil {
+			c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+			return nil, err
 		}
+	}
 
-		pluginInfo := util.GetPluginInfo(vim)
-		client, err := pluginAdapter.GetClient(pluginInfo)
+	for _, hostIp := range hosts.HostIp {
+		err := c.updateAppPkgRecord(hosts, clientIp, tenantId, packageId, hostIp, util.Distributing)
 		if err != nil {
-			c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetClient)
-			return err
+			return nil, err
 		}
+	}
+	return hostPluginInfo, nil
+}
 
-		pkgFilePath := PackageFolderPath + tenantId + "/" + packageId + "/" + packageId + ".csar"
+// Process upload package, runs in background after the request is accepted. Hosts are distributed
+// concurrently and the outcome of every host is recorded, failure of a host does not stop the others
+func (c *LcmController) processUploadPackage(operation *models.LcmOperation, hostIps []string,
+	hostPluginInfo map[string]string, accessToken string) {
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	tenantId := operation.TenantId
+	packageId := operation.AppPackageId
+	pkgFilePath := PackageFolderPath + tenantId + "/" + packageId + "/" + packageId + ".csar"
+	concurrency := util.GetAppConfigPositiveInt(util.DistributionConcurrency, util.DefaultDistributionConcurrency)
+	timeout := time.Duration(util.GetAppConfigPositiveInt(util.DistributionTimeout,
+		util.DefaultDistributionTimeout)) * time.Second
+
+	adapters, clientErrs := getPluginAdapters(hostPluginInfo)
+	hostErrs := make([]error, len(hostIps))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, hostIp := range hostIps {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, hostIp string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			pluginInfo := hostPluginInfo[hostIp]
+			err := clientErrs[pluginInfo]
+			if err == nil {
+				_, err = adapters[pluginInfo].UploadPackage(tenantId, pkgFilePath, hostIp, packageId, accessToken,
+					timeout)
+			}
+			hostErrs[i] = c.recordDistributionResult(tenantId, packageId, hostIp, err)
+		}(i, hostIp)
+	}
+	wg.Wait()
+	util.ClearByteArray(bKey)
+	c.completeLcmOperation(operation, getDistributionError(hostIps, hostErrs))
+}
 
-		adapter := pluginAdapter.NewPluginAdapter(pluginInfo, client)
-		_, err = adapter.UploadPackage(tenantId, pkgFilePath, hostIp, packageId, accessToken)
-		//c.deletePackage(path.Dir(pkgFilePath))
+// Create plugin adapter for each plugin of distribution hosts, adapters are shared by the hosts of a plugin
+func getPluginAdapters(hostPluginInfo map[string]string) (map[string]*pluginAdapter.PluginAdapter,
+	map[string]error) {
+	adapters := make(map[string]*pluginAdapter.PluginAdapter)
+	clientErrs := make(map[string]error)
+	for _, pluginInfo := range hostPluginInfo {
+		if _, ok := adapters[pluginInfo]; ok {
+			continue
+		}
+		client, err := pluginAdapter.GetClient(pluginInfo)
 		if err != nil {
-			c.HandleLoggingForFailure(clientIp, err.Error())
-			err = c.updateAppPkgRecord(hosts, clientIp, tenantId, packageId, hostIp, "Error")
+			clientErrs[pluginInfo] = err
+		}
+		adapters[pluginInfo] = pluginAdapter.NewPluginAdapter(pluginInfo, client)
+	}
+	return adapters, clientErrs
+}
+
+// Record distribution result of host in app package host record
+func (c *LcmController) recordDistributionResult(tenantId, packageId, hostIp string, distErr error) error {
+	status := util.Distributed
+	errMsg := ""
+	if distErr != nil {
+		log.Error("Failed to distribute package to host " + hostIp + ": " + distErr.Error())
+		status = util.DistributionError
+		errMsg = distErr.Error()
+	}
+
+	err := c.updateAppPkgHostStatus(tenantId, packageId, hostIp, status, errMsg)
+	if err != nil {
+		log.Error("Failed to update app package host record: " + err.Error())
+		if distErr == nil {
 			return err
 		}
+	}
+	return distErr
+}
 
-		err = c.updateAppPkgRecord(hosts, clientIp, tenantId, packageId, hostIp, "Distributed")
+// Get distribution error which lists the outcome of every failed host
+func getDistributionError(hostIps []string, hostErrs []error) error {
+	failures := make([]string, 0)
+	for i, err := range hostErrs {
 		if err != nil {
-			return err
+			failures = append(failures, hostIps[i]+": "+err.Error())
 		}
 	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return errors.New("distribution failed on " + strconv.Itoa(len(failures)) + " of " +
+		strconv.Itoa(len(hostIps)) + " hosts: " + strings.Join(failures, "; "))
+}
+
+// Update distribution status of app package host record
+func (c *LcmController) updateAppPkgHostStatus(tenantId, packageId, hostIp, status, errMsg string) error {
+	appPkgHostRecord := &models.AppPackageHostRecord{
+		PkgHostKey: packageId + tenantId + hostIp,
+	}
+
+	err := c.Db.ReadData(appPkgHostRecord, util.PkgHostKey)
+	if err != nil {
+		return err
+	}
+
+	appPkgHostRecord.Status = status
+	appPkgHostRecord.Error = errMsg
+	err = c.Db.InsertOrUpdateData(appPkgHostRecord, util.PkgHostKey)
+	if err != nil && err.Error() != util.LastInsertIdNotSupported {
+		return err
+	}
 	return nil
 }
 
@@ -2468,7 +3600,11 @@ func (c *LcmController) deletePackage(appPkgHost *models.AppPackageHostRecord,
 		return err
 	}
 
-	pluginInfo := util.GetPluginInfo(vim)
+	pluginInfo, err := c.getPluginInfo(vim)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+		return err
+	}
 	client, err := pluginAdapter.GetClient(pluginInfo)
 	if err != nil {
 		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetClient)
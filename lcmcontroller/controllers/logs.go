@@ -0,0 +1,271 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"bytes"
+	"errors"
+	beegoCtx "github.com/astaxie/beego/context"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"lcmcontroller/pkg/pluginAdapter"
+	"lcmcontroller/util"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+)
+
+// @Title Workload logs
+// @Description Stream logs of application instance pod as chunked plain text, or as server-sent events when
+// the client accepts text/event-stream
+// @Param	tenantId	path 	string	true   "tenantId"
+// @Param	appInstanceId   path 	string	true   "appInstanceId"
+// @Param       access_token    header  string  true   "access token"
+// @Param       pod             query   string  true   "pod name"
+// @Param       container       query   string  false  "container name, required when pod has several containers"
+// @Param       tail_lines      query   int     false  "number of lines from the end of the logs"
+// @Param       since_time      query   string  false  "RFC3339 time, only logs after it are returned"
+// @Param       follow          query   bool    false  "follow the logs until the client disconnects"
+// @Success 200 ok
+// @Failure 400 bad request
+// @Failure 404 pod doesn't exist
+// @router /tenants/:tenantId/app_instances/:appInstanceId/workload/logs [get]
+func (c *LcmController) WorkloadLogs() {
+	log.Info("Workload logs request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	defer util.ClearByteArray(bKey)
+	tenantId, err := c.getTenantId(clientIp)
+	if err != nil {
+		return
+	}
+	err = util.ValidateAccessToken(accessToken,
+		[]string{util.MecmTenantRole, util.MecmGuestRole, util.MecmAdminRole}, tenantId)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusUnauthorized, util.AuthorizationFailed)
+		return
+	}
+
+	appInsId, err := c.getAppInstId(clientIp)
+	if err != nil {
+		return
+	}
+
+	options, err := c.getLogOptions(clientIp)
+	if err != nil {
+		return
+	}
+
+	appInfoRecord, err := c.getAppInfoRecord(appInsId, clientIp)
+	if err != nil {
+		return
+	}
+
+	instanceState := getAppInstanceState(appInfoRecord)
+	if instanceState != util.Instantiated {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "app instance state is:"+instanceState)
+		return
+	}
+
+	vim, err := c.getVim(clientIp, appInfoRecord.MecHost)
+	if err != nil {
+		return
+	}
+
+	adapter, err := c.getPluginAdapter(appInfoRecord.DeployType, clientIp, vim)
+	if err != nil {
+		return
+	}
+
+	err = c.checkOperationSupported(clientIp, adapter, pluginAdapter.OperationWorkloadLogs, accessToken)
+	if err != nil {
+		return
+	}
+
+	writer := newLogWriter(c.Ctx.ResponseWriter,
+		strings.Contains(c.Ctx.Request.Header.Get(util.Accept), util.EventStream))
+	err = adapter.WorkloadLogs(c.Ctx.Request.Context(), writer, appInfoRecord.MecHost, accessToken, appInsId,
+		options)
+	if err != nil {
+		if writer.written == 0 {
+			c.handlePluginFailure(clientIp, err)
+			return
+		}
+		// Response is already started, so failure can only be reported in the stream
+		writer.writeError("workload logs are interrupted")
+		log.Error("Workload logs are interrupted: " + err.Error())
+		return
+	}
+	writer.close()
+	c.handleLoggingForSuccess(clientIp, "Workload logs are successful")
+}
+
+// Get log options from query parameters
+func (c *LcmController) getLogOptions(clientIp string) (pluginAdapter.LogOptions, error) {
+	options := pluginAdapter.LogOptions{
+		PodName:       c.GetString("pod"),
+		ContainerName: c.GetString("container"),
+		SinceTime:     c.GetString("since_time"),
+	}
+
+	err := util.ValidatePodName(options.PodName)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.PodNameIsInvalid)
+		return options, err
+	}
+	if options.ContainerName != "" {
+		err = util.ValidateContainerName(options.ContainerName)
+		if err != nil {
+			c.HandleLoggingForError(clientIp, util.BadRequest, util.ContainerNameIsInvalid)
+			return options, err
+		}
+	}
+	if tailLines := c.GetString("tail_lines"); tailLines != "" {
+		options.TailLines, err = strconv.ParseInt(tailLines, 10, 64)
+		if err != nil || options.TailLines < 0 {
+			c.HandleLoggingForError(clientIp, util.BadRequest, util.TailLinesIsInvalid)
+			return options, errors.New(util.TailLinesIsInvalid)
+		}
+	}
+	if options.SinceTime != "" {
+		_, err = time.Parse(time.RFC3339, options.SinceTime)
+		if err != nil {
+			c.HandleLoggingForError(clientIp, util.BadRequest, util.SinceTimeIsInvalid)
+			return options, err
+		}
+	}
+	if follow := c.GetString("follow"); follow != "" {
+		options.Follow, err = strconv.ParseBool(follow)
+		if err != nil {
+			c.HandleLoggingForError(clientIp, util.BadRequest, util.FollowIsInvalid)
+			return options, err
+		}
+	}
+	return options, nil
+}
+
+// Handle failure of plugin operation, status of plugin error is responded as http status
+func (c *LcmController) handlePluginFailure(clientIp string, err error) {
+	switch status.Code(err) {
+	case codes.NotFound:
+		c.HandleLoggingForError(clientIp, util.StatusNotFound, status.Convert(err).Message())
+	case codes.InvalidArgument:
+		c.HandleLoggingForError(clientIp, util.BadRequest, status.Convert(err).Message())
+	default:
+		c.HandleLoggingForFailure(clientIp, err.Error())
+	}
+}
+
+// Writer which streams logs to the response, each log line is sent as event data of server-sent events
+type logWriter struct {
+	response    *beegoCtx.Response
+	eventStream bool
+	partial     []byte
+	written     int64
+}
+
+// Create log writer of the response
+func newLogWriter(response *beegoCtx.Response, eventStream bool) *logWriter {
+	return &logWriter{response: response, eventStream: eventStream}
+}
+
+// Write logs to the response and flush them, so that followed logs are received without delay
+func (w *logWriter) Write(data []byte) (int, error) {
+	if w.written == 0 {
+		w.writeHeader()
+	}
+	w.written += int64(len(data))
+
+	if !w.eventStream {
+		_, err := w.response.Write(data)
+		if err != nil {
+			return 0, err
+		}
+		w.response.Flush()
+		return len(data), nil
+	}
+
+	// Line which is not complete is kept till the rest of it is received
+	w.partial = append(w.partial, data...)
+	end := bytes.LastIndexByte(w.partial, '\n')
+	if end < 0 {
+		return len(data), nil
+	}
+	err := w.writeEvents(w.partial[:end])
+	w.partial = append(w.partial[:0], w.partial[end+1:]...)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// Write last incomplete line of server-sent events
+func (w *logWriter) close() {
+	if w.written == 0 {
+		w.writeHeader()
+	}
+	if w.eventStream && len(w.partial) > 0 {
+		_ = w.writeEvents(w.partial)
+		w.partial = nil
+	}
+}
+
+// Write error event of server-sent events, plain text logs are ended without error
+func (w *logWriter) writeError(errMsg string) {
+	if !w.eventStream {
+		return
+	}
+	_, _ = w.response.Write([]byte("event: error\ndata: " + errMsg + "\n\n"))
+	w.response.Flush()
+}
+
+// Write log lines as server-sent events
+func (w *logWriter) writeEvents(lines []byte) error {
+	var events bytes.Buffer
+	for _, line := range bytes.Split(lines, []byte("\n")) {
+		events.WriteString("data: ")
+		events.Write(bytes.TrimSuffix(line, []byte("\r")))
+		events.WriteString("\n\n")
+	}
+	_, err := w.response.Write(events.Bytes())
+	if err != nil {
+		return err
+	}
+	w.response.Flush()
+	return nil
+}
+
+// Write response header before the first logs
+func (w *logWriter) writeHeader() {
+	if w.eventStream {
+		w.response.Header().Set(util.ContentType, util.EventStream)
+		w.response.Header().Set("Cache-Control", "no-cache")
+	} else {
+		w.response.Header().Set(util.ContentType, "text/plain; charset=utf-8")
+	}
+	w.response.Header().Set("X-Content-Type-Options", "nosniff")
+}
@@ -22,8 +22,13 @@ import (
 	log "github.com/sirupsen/logrus"
 	"lcmcontroller/config"
 	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
 	"lcmcontroller/util"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unsafe"
 )
 
 // Mec host Controller
@@ -287,8 +292,17 @@ func (c *MecHostController) TerminateApplication(clientIp string, appInsId strin
 		return err
 	}
 
-	_, err = adapter.Terminate(appInfoRecord.MecHost, "", appInfoRecord.AppInstanceId)
+	previousState := getAppInstanceState(appInfoRecord)
+	err = c.updateAppInstanceState(appInfoRecord, util.Terminating)
 	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, err.Error())
+		return err
+	}
+
+	_, err = adapter.Terminate(appInfoRecord.MecHost, "", appInfoRecord.AppInstanceId)
+	if err != nil && previousState != util.InstantiationFailed {
+		// Workload of the instance is still running, so the instance is returned to its previous state
+		_ = c.updateAppInstanceState(appInfoRecord, previousState)
 		c.HandleLoggingForFailure(clientIp, err.Error())
 		return err
 	}
@@ -296,6 +310,7 @@ func (c *MecHostController) TerminateApplication(clientIp string, appInsId strin
 	acm := config.NewAppConfigMgr(appInfoRecord.AppInstanceId, "", config.AppAuthConfig{})
 	err = acm.DeleteAppAuthConfig()
 	if err != nil {
+		_ = c.updateAppInstanceState(appInfoRecord, util.InstantiationFailed)
 		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
 		return err
 	}
@@ -369,7 +384,9 @@ func (c *MecHostController) GetMecHost() {
 }
 
 // @Title Query AppInstance information
-// @Description AppInstance information
+// @Description AppInstance information, health of instantiated app instances is queried from plugins when
+// requested as it takes a plugin call per app instance
+// @Param   health          query   bool    false  "query health of app instances"
 // @Success 200 ok
 // @Failure 400 bad request
 // @router /tenants/:tenantId/app_instances [get]
@@ -387,6 +404,14 @@ func (c *MecHostController) GetAppInstance() {
 	if err != nil {
 		return
 	}
+	queryHealth := false
+	if health := c.GetString("health"); health != "" {
+		queryHealth, err = strconv.ParseBool(health)
+		if err != nil {
+			c.HandleLoggingForError(clientIp, util.BadRequest, util.HealthIsInvalid)
+			return
+		}
+	}
 
 	var appInfoRecords []*models.AppInfoRecord
 	var appInfoRec []*models.AppInfoRec
@@ -399,6 +424,12 @@ func (c *MecHostController) GetAppInstance() {
 	if err != nil {
 		return
 	}
+	if queryHealth {
+		accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+		bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+		c.addAppInstanceHealth(appInfoRec, accessToken)
+		util.ClearByteArray(bKey)
+	}
 	response, err := json.Marshal(appInfoRec)
 	if err != nil {
 		return
@@ -407,6 +438,78 @@ func (c *MecHostController) GetAppInstance() {
 	c.handleLoggingForSuccess(clientIp, "Query App Instance info is successful")
 }
 
+// Add health summary of instantiated app instances, health is omitted when it can not be queried from plugin
+func (c *MecHostController) addAppInstanceHealth(appInfoRec []*models.AppInfoRec, accessToken string) {
+	if accessToken == "" {
+		return
+	}
+
+	adapters := make(map[string]*pluginAdapter.PluginAdapter)
+	semaphore := make(chan struct{}, util.HealthQueryConcurrency)
+	var wg sync.WaitGroup
+	for _, appInfo := range appInfoRec {
+		if appInfo.InstanceState != "" && appInfo.InstanceState != util.Instantiated {
+			continue
+		}
+		adapter := c.getHealthAdapter(appInfo.MecHost, accessToken, adapters)
+		if adapter == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func(appInfo *models.AppInfoRec, adapter *pluginAdapter.PluginAdapter) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			health, err := adapter.QueryHealth(accessToken, appInfo.AppInstanceId, appInfo.MecHost)
+			if err != nil {
+				log.Warn("failed to query health of app instance " + appInfo.AppInstanceId)
+				return
+			}
+			appInfo.Health = health
+		}(appInfo, adapter)
+	}
+	wg.Wait()
+}
+
+// Get plugin adapter of mec host when its plugin supports health query, adapters are shared by hosts of a vim.
+// Capability request is limited by health query timeout so that unreachable plugins do not block the query
+func (c *MecHostController) getHealthAdapter(hostIp string, accessToken string,
+	adapters map[string]*pluginAdapter.PluginAdapter) *pluginAdapter.PluginAdapter {
+	mecHostRecord := &models.MecHost{
+		MecHostId: hostIp,
+	}
+	if err := c.Db.ReadData(mecHostRecord, util.HostIp); err != nil {
+		return nil
+	}
+
+	vim := mecHostRecord.Vim
+	if adapter, ok := adapters[vim]; ok {
+		return adapter
+	}
+	adapters[vim] = nil
+
+	pluginInfo, err := c.getPluginInfo(vim)
+	if err != nil {
+		log.Warn("failed to get plugin of vim " + vim + " for health query")
+		return nil
+	}
+	client, err := pluginAdapter.GetClient(pluginInfo)
+	if err != nil {
+		log.Warn("failed to get client of plugin " + pluginInfo + " for health query")
+		return nil
+	}
+	adapter := pluginAdapter.NewPluginAdapter(pluginInfo, client)
+	supported, err := adapter.IsOperationSupportedWithTimeout(pluginAdapter.OperationQueryHealth, accessToken,
+		util.HealthQueryTimeout*time.Second)
+	if err != nil || !supported {
+		return nil
+	}
+	adapters[vim] = adapter
+	return adapter
+}
+
 // @Title Batch terminate application
 // @Description Batch terminate application
 // @Param   tenantId    path 	string	                  true   "tenantId"
@@ -0,0 +1,208 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/dbAdapter"
+	"lcmcontroller/util"
+	"time"
+	"unsafe"
+)
+
+// @Title Query LCM operation
+// @Description Query the state of an asynchronous lcm operation
+// @Param	tenantId	path 	string	true	"tenantId"
+// @Param	opId            path 	string	true	"operation id"
+// @Param       access_token    header  string  true    "access token"
+// @Success 200 ok
+// @Failure 400 bad request
+// @Failure 404 not found
+// @router /tenants/:tenantId/operations/:opId [get]
+func (c *LcmController) QueryOperation() {
+	log.Info("Query lcm operation request received.")
+
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	tenantId, err := c.getTenantId(clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return
+	}
+	err = util.ValidateAccessToken(accessToken,
+		[]string{util.MecmTenantRole, util.MecmGuestRole, util.MecmAdminRole}, tenantId)
+	util.ClearByteArray(bKey)
+	if err != nil {
+		c.HandleLoggingForTokenFailure(clientIp, err.Error())
+		return
+	}
+
+	opId, err := c.getOperationId(clientIp)
+	if err != nil {
+		return
+	}
+
+	operation := &models.LcmOperation{
+		OperationId: opId,
+	}
+	readErr := c.Db.ReadData(operation, util.OperationId)
+	if readErr != nil || operation.TenantId != tenantId {
+		c.HandleLoggingForError(clientIp, util.StatusNotFound,
+			"Lcm operation record does not exist in database")
+		return
+	}
+
+	res, err := json.Marshal(operation)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToMarshal)
+		return
+	}
+	_, err = c.Ctx.ResponseWriter.Write(res)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToWriteRes)
+		return
+	}
+	c.handleLoggingForSuccess(clientIp, "Query lcm operation is successful")
+}
+
+// Create lcm operation record in processing state
+func (c *BaseController) createLcmOperation(clientIp string, operation *models.LcmOperation) error {
+	operation.OperationId = util.GenerateUUID()
+	operation.State = util.OperationProcessing
+	operation.StartTime = time.Now()
+
+	err := c.Db.InsertOrUpdateData(operation, util.OperationId)
+	if err != nil && err.Error() != util.LastInsertIdNotSupported {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError,
+			"Failed to save lcm operation record to database")
+		return err
+	}
+	return nil
+}
+
+// Complete lcm operation record with the result of the operation, it does not
+// use request context as it is invoked after the response is sent
+func (c *BaseController) completeLcmOperation(operation *models.LcmOperation, opErr error) {
+	operation.EndTime = time.Now()
+	operation.State = util.OperationSuccess
+	if opErr != nil {
+		operation.State = util.OperationFailed
+		operation.Error = opErr.Error()
+	}
+
+	err := c.Db.InsertOrUpdateData(operation, util.OperationId)
+	if err != nil && err.Error() != util.LastInsertIdNotSupported {
+		log.Error("Failed to save lcm operation record to database.")
+		return
+	}
+	log.Info(operation.OperationType + " operation [" + operation.OperationId + "] completed with state [" +
+		operation.State + "]")
+}
+
+// Write accepted response with operation id
+func (c *BaseController) handleOperationAccepted(clientIp string, operation *models.LcmOperation, msg string) {
+	opResp, err := json.Marshal(models.LcmOperationResponse{OperationId: operation.OperationId})
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToMarshal)
+		return
+	}
+	c.Ctx.ResponseWriter.Header().Set(util.ContentType, util.ApplicationJson)
+	c.Ctx.ResponseWriter.WriteHeader(util.StatusAccepted)
+	_, err = c.Ctx.ResponseWriter.Write(opResp)
+	if err != nil {
+		log.Error(util.FailedToWriteRes)
+		return
+	}
+	c.handleLoggingForSuccess(clientIp, msg)
+}
+
+// States to which app instances are recovered when their operation is interrupted. Instances which are being created
+// or removed are failed so that they can be terminated, other instances keep their release and are instantiated
+var interruptedInstanceStates = map[string]string{
+	util.Instantiating: util.InstantiationFailed,
+	util.Terminating:   util.InstantiationFailed,
+	util.Upgrading:     util.Instantiated,
+	util.RollingBack:   util.Instantiated,
+	util.Scaling:       util.Instantiated,
+}
+
+// Recover lcm operations which were processing when the controller stopped, their background workers do not run
+// anymore. Operations are failed and their app instances and package distributions are moved to states from which
+// they can be retried
+func RecoverLcmOperations(db dbAdapter.Database) {
+	c := &BaseController{Db: db}
+
+	var operations []*models.LcmOperation
+	_, err := db.QueryTable(util.LcmOperationTable, &operations, "state", util.OperationProcessing)
+	if err != nil {
+		log.Error("Failed to query processing lcm operations: " + err.Error())
+		return
+	}
+	for _, operation := range operations {
+		log.Info("Failing interrupted " + operation.OperationType + " operation [" + operation.OperationId + "]")
+		c.completeLcmOperation(operation, errors.New(util.OperationInterrupted))
+		if operation.AppInstanceId != "" {
+			c.recoverAppInstanceState(operation.AppInstanceId)
+		}
+	}
+
+	var appPkgHostRecords []*models.AppPackageHostRecord
+	_, err = db.QueryTable(util.AppPackageHostTable, &appPkgHostRecords, "status", util.Distributing)
+	if err != nil {
+		log.Error("Failed to query distributing packages: " + err.Error())
+		return
+	}
+	for _, appPkgHostRecord := range appPkgHostRecords {
+		appPkgHostRecord.Status = util.DistributionError
+		appPkgHostRecord.Error = util.OperationInterrupted
+		err = db.InsertOrUpdateData(appPkgHostRecord, util.PkgHostKey)
+		if err != nil && err.Error() != util.LastInsertIdNotSupported {
+			log.Error("Failed to recover package distribution: " + err.Error())
+		}
+	}
+}
+
+// Move app instance of interrupted operation to the state from which it can be retried
+func (c *BaseController) recoverAppInstanceState(appInsId string) {
+	appInfoRecord := &models.AppInfoRecord{
+		AppInstanceId: appInsId,
+	}
+	err := c.Db.ReadData(appInfoRecord, util.AppInsId)
+	if err != nil {
+		// Record of terminated app instance may already be deleted
+		return
+	}
+	state, ok := interruptedInstanceStates[appInfoRecord.InstanceState]
+	if !ok {
+		return
+	}
+	log.Info("Recovering app instance " + appInsId + " from " + appInfoRecord.InstanceState + " to " + state)
+	err = c.updateAppInstanceState(appInfoRecord, state)
+	if err != nil {
+		log.Error("Failed to recover app instance state: " + err.Error())
+	}
+}
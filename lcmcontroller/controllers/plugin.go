@@ -0,0 +1,366 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Plugin controller
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"lcmcontroller/util"
+	"strings"
+	"unsafe"
+)
+
+// Plugin Controller
+type PluginController struct {
+	BaseController
+}
+
+// @Title Register plugin
+// @Description Register plugin, plugin with same name is updated so that registration is idempotent
+// @Param   access_token  header     string true   "access token"
+// @Param   body          body       models.PluginInfo   true      "The plugin information"
+// @Success 200 ok
+// @Failure 400 bad request
+// @router /plugins [post]
+func (c *PluginController) RegisterPlugin() {
+	log.Info("Register plugin request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+
+	err = c.validateAdminToken(clientIp)
+	if err != nil {
+		return
+	}
+
+	request, err := c.getPluginRequest(clientIp)
+	if err != nil {
+		return
+	}
+
+	plugins, err := c.getPluginRecords()
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+		return
+	}
+
+	var existing *models.PluginRecord
+	for _, plugin := range plugins {
+		if plugin.PluginName == request.PluginName {
+			existing = plugin
+			break
+		}
+	}
+
+	pluginId := util.GenerateUUID()
+	if existing != nil {
+		pluginId = existing.PluginId
+	} else if len(plugins) >= util.MaxNumberOfPlugins {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "Maximum number of plugins are exceeded")
+		return
+	}
+
+	err = c.savePluginRecord(clientIp, pluginId, request, existing)
+	if err != nil {
+		return
+	}
+	c.handleLoggingForSuccess(clientIp, "Register plugin is successful")
+}
+
+// @Title Query plugins
+// @Description Query registered plugins
+// @Param   access_token  header     string true   "access token"
+// @Success 200 ok
+// @Failure 400 bad request
+// @router /plugins [get]
+func (c *PluginController) GetPlugins() {
+	log.Info("Query plugins request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+
+	err = c.validateAdminToken(clientIp)
+	if err != nil {
+		return
+	}
+
+	plugins, err := c.getPluginRecords()
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+		return
+	}
+	pluginsRes := make([]models.PluginInfo, 0, len(plugins))
+	for _, plugin := range plugins {
+		pluginsRes = append(pluginsRes, toPluginInfo(plugin))
+	}
+
+	c.writePluginResponse(clientIp, pluginsRes)
+	c.handleLoggingForSuccess(clientIp, "Query plugins is successful")
+}
+
+// @Title Update plugin
+// @Description Update registered plugin
+// @Param   access_token  header     string true   "access token"
+// @Param   pluginId      path       string true   "plugin id"
+// @Param   body          body       models.PluginInfo   true      "The plugin information"
+// @Success 200 ok
+// @Failure 400 bad request
+// @router /plugins/:pluginId [put]
+func (c *PluginController) UpdatePlugin() {
+	log.Info("Update plugin request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+
+	err = c.validateAdminToken(clientIp)
+	if err != nil {
+		return
+	}
+
+	existing, err := c.getPluginRecord(clientIp)
+	if err != nil {
+		return
+	}
+
+	request, err := c.getPluginRequest(clientIp)
+	if err != nil {
+		return
+	}
+
+	plugins, err := c.getPluginRecords()
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToGetPluginInfo)
+		return
+	}
+	for _, plugin := range plugins {
+		if plugin.PluginName == request.PluginName && plugin.PluginId != existing.PluginId {
+			c.HandleLoggingForError(clientIp, util.BadRequest, "Plugin name already exists")
+			return
+		}
+	}
+
+	err = c.savePluginRecord(clientIp, existing.PluginId, request, existing)
+	if err != nil {
+		return
+	}
+	c.handleLoggingForSuccess(clientIp, "Update plugin is successful")
+}
+
+// @Title Deregister plugin
+// @Description Deregister plugin
+// @Param   access_token  header     string true   "access token"
+// @Param   pluginId      path       string true   "plugin id"
+// @Success 200 ok
+// @Failure 400 bad request
+// @router /plugins/:pluginId [delete]
+func (c *PluginController) DeletePlugin() {
+	log.Info("Deregister plugin request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+
+	err = c.validateAdminToken(clientIp)
+	if err != nil {
+		return
+	}
+
+	plugin, err := c.getPluginRecord(clientIp)
+	if err != nil {
+		return
+	}
+
+	err = c.Db.DeleteData(plugin, util.PluginId)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return
+	}
+	pluginAdapter.RemovePlugin(plugin.Address)
+
+	c.handleLoggingForSuccess(clientIp, "Deregister plugin is successful")
+	c.ServeJSON()
+}
+
+// Validate access token of plugin management requests, admin role is required
+func (c *PluginController) validateAdminToken(clientIp string) error {
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	err := util.ValidateAccessToken(accessToken, []string{util.MecmAdminRole}, "")
+	util.ClearByteArray(bKey)
+	if err != nil {
+		c.HandleLoggingForTokenFailure(clientIp, err.Error())
+		return err
+	}
+	return nil
+}
+
+// Get plugin record of plugin id in url
+func (c *PluginController) getPluginRecord(clientIp string) (*models.PluginRecord, error) {
+	pluginId := c.Ctx.Input.Param(":pluginId")
+	valid, err := util.ValidateName(pluginId, util.UuidRegex)
+	if err != nil || !valid {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.PluginIdIsInvalid)
+		return nil, errors.New(util.PluginIdIsInvalid)
+	}
+
+	plugin := &models.PluginRecord{
+		PluginId: pluginId,
+	}
+	err = c.Db.ReadData(plugin, util.PluginId)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusNotFound, util.PluginDoesNotExist)
+		return nil, err
+	}
+	return plugin, nil
+}
+
+// Get and validate plugin request
+func (c *PluginController) getPluginRequest(clientIp string) (models.PluginInfo, error) {
+	var request models.PluginInfo
+	if len(c.Ctx.Input.RequestBody) > util.RequestBodyLength {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.RequestBodyTooLarge)
+		return request, errors.New(util.RequestBodyTooLarge)
+	}
+
+	err := json.Unmarshal(c.Ctx.Input.RequestBody, &request)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.FailedToUnmarshal)
+		return request, err
+	}
+
+	name, err := util.ValidateName(request.PluginName, util.NameRegex)
+	if err != nil || !name || request.PluginName == "" {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "Plugin name is invalid")
+		return request, errors.New("plugin name is invalid")
+	}
+
+	err = util.ValidatePluginAddress(request.Address)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "Plugin address is invalid")
+		return request, err
+	}
+
+	err = util.ValidateServerName(request.ServerName)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "Server name is invalid")
+		return request, err
+	}
+
+	if len(request.VimTypes) == 0 {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "Vim types are not specified")
+		return request, errors.New("vim types are not specified")
+	}
+	for _, vimType := range request.VimTypes {
+		vim, err := util.ValidateName(vimType, util.NameRegex)
+		if err != nil || !vim || vimType == "" {
+			c.HandleLoggingForError(clientIp, util.BadRequest, "Vim type is invalid")
+			return request, errors.New("vim type is invalid")
+		}
+	}
+
+	for _, capability := range request.Capabilities {
+		valid, err := util.ValidateName(capability, util.NameRegex)
+		if err != nil || !valid || capability == "" {
+			c.HandleLoggingForError(clientIp, util.BadRequest, "Capability is invalid")
+			return request, errors.New("capability is invalid")
+		}
+	}
+	return request, nil
+}
+
+// Save plugin record and write the saved plugin in response, connection of previous plugin address is
+// closed when the address is changed
+func (c *PluginController) savePluginRecord(clientIp, pluginId string, request models.PluginInfo,
+	existing *models.PluginRecord) error {
+	plugin := &models.PluginRecord{
+		PluginId:     pluginId,
+		PluginName:   request.PluginName,
+		Address:      request.Address,
+		SslEnabled:   request.SslEnabled,
+		ServerName:   request.ServerName,
+		VimTypes:     strings.Join(request.VimTypes, ","),
+		Capabilities: strings.Join(request.Capabilities, ","),
+	}
+	err := c.Db.InsertOrUpdateData(plugin, util.PluginId)
+	if err != nil && err.Error() != util.LastInsertIdNotSupported {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError,
+			"Failed to save plugin record to database.")
+		return err
+	}
+
+	if existing != nil && existing.Address != plugin.Address {
+		pluginAdapter.RemovePlugin(existing.Address)
+	}
+	pluginAdapter.SetPluginConfig(pluginAdapter.PluginConfig{Address: plugin.Address,
+		SslEnabled: plugin.SslEnabled, ServerName: plugin.ServerName})
+
+	c.writePluginResponse(clientIp, toPluginInfo(plugin))
+	return nil
+}
+
+// Write plugin response
+func (c *PluginController) writePluginResponse(clientIp string, response interface{}) {
+	res, err := json.Marshal(response)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToMarshal)
+		return
+	}
+	c.Ctx.ResponseWriter.Header().Set(util.ContentType, util.ApplicationJson)
+	_, err = c.Ctx.ResponseWriter.Write(res)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToWriteRes)
+	}
+}
+
+// Convert plugin record to plugin info
+func toPluginInfo(plugin *models.PluginRecord) models.PluginInfo {
+	info := models.PluginInfo{
+		PluginId:     plugin.PluginId,
+		PluginName:   plugin.PluginName,
+		Address:      plugin.Address,
+		SslEnabled:   plugin.SslEnabled,
+		ServerName:   plugin.ServerName,
+		VimTypes:     []string{},
+		Capabilities: []string{},
+	}
+	if plugin.VimTypes != "" {
+		info.VimTypes = strings.Split(plugin.VimTypes, ",")
+	}
+	if plugin.Capabilities != "" {
+		info.Capabilities = strings.Split(plugin.Capabilities, ",")
+	}
+	return info
+}
@@ -0,0 +1,567 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/dbAdapter"
+	"lcmcontroller/util"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+const (
+	uploadsFolder       = "uploads/"
+	chunkFilePerm       = 0640
+	sha256HexRegex      = "^[a-fA-F0-9]{64}$"
+	maxUploadChunks     = 65536
+	uploadSweepInterval = 10 * time.Minute
+)
+
+var uploadSweepOnce sync.Once
+
+// @Title Create package upload
+// @Description Create a resumable application package upload session
+// @Param	tenantId	    path 	string	true	"tenantId"
+// @Param   access_token    header  string  true    "access token"
+// @Param   body            body    models.PackageUploadRequest  true   "package upload request"
+// @Success 200 ok
+// @Failure 400 bad request
+// @router /tenants/:tenantId/packages/uploads [post]
+func (c *LcmController) CreatePackageUpload() {
+	log.Info("Create package upload request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	tenantId, err := c.isPermitted(accessToken, clientIp)
+	util.ClearByteArray(bKey)
+	if err != nil {
+		return
+	}
+
+	var req models.PackageUploadRequest
+	err = json.Unmarshal(c.Ctx.Input.RequestBody, &req)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.FailedToUnmarshal)
+		return
+	}
+
+	session, err := c.newPackageUploadSession(clientIp, tenantId, req)
+	if err != nil {
+		return
+	}
+
+	var sessions []*models.PackageUploadSession
+	_, err = c.Db.QueryTable(util.PackageUploadSessionTable, &sessions, util.TenantId, tenantId)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return
+	}
+	if len(deleteStalePackageUploadSessions(c.Db, sessions)) >= util.MaxNumberOfUploadSessions {
+		c.HandleLoggingForError(clientIp, util.BadRequest,
+			"Maximum number of package upload sessions are exceeded for given tenant")
+		return
+	}
+
+	err = os.MkdirAll(getUploadDir(session), 0750)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToMakeDir)
+		return
+	}
+
+	err = c.Db.InsertOrUpdateData(session, util.UploadId)
+	if err != nil && err.Error() != util.LastInsertIdNotSupported {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError,
+			"Failed to save package upload session to database")
+		return
+	}
+
+	c.writePackageUploadStatus(clientIp, session, "Created package upload session successfully")
+}
+
+// @Title Upload package chunk
+// @Description Upload a chunk of application package, body is the raw chunk content
+// @Param	tenantId	    path 	string	true	"tenantId"
+// @Param	uploadId	    path 	string	true	"uploadId"
+// @Param	chunkNumber	    path 	string	true	"chunk number starting from 0"
+// @Param   access_token    header  string  true    "access token"
+// @Param   chunk_checksum  header  string  true    "sha256 checksum of chunk"
+// @Success 200 ok
+// @Failure 400 bad request
+// @Failure 404 not found
+// @router /tenants/:tenantId/packages/uploads/:uploadId/chunks/:chunkNumber [put]
+func (c *LcmController) UploadPackageChunk() {
+	log.Info("Upload package chunk request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+
+	session, err := c.getPackageUploadSession(clientIp)
+	if err != nil {
+		return
+	}
+
+	chunkNumber, err := strconv.Atoi(c.Ctx.Input.Param(":chunkNumber"))
+	if err != nil || chunkNumber < 0 || chunkNumber >= getChunkCount(session) {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ChunkNumberIsInvalid)
+		return
+	}
+
+	chunk := c.Ctx.Input.RequestBody
+	if int64(len(chunk)) != getChunkSize(session, chunkNumber) {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "Chunk size does not match upload session")
+		return
+	}
+
+	digest := sha256.Sum256(chunk)
+	checksum := c.Ctx.Request.Header.Get(util.ChunkChecksum)
+	if !strings.EqualFold(checksum, hex.EncodeToString(digest[:])) {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "Chunk checksum does not match")
+		return
+	}
+
+	// Chunk is renamed in place once written, so that partially written chunks are never reported as received
+	chunkPath := getChunkPath(session, chunkNumber)
+	err = ioutil.WriteFile(chunkPath+".tmp", chunk, chunkFilePerm)
+	if err == nil {
+		err = os.Rename(chunkPath+".tmp", chunkPath)
+	}
+	if err != nil {
+		_ = os.Remove(chunkPath + ".tmp")
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, "Failed to save package chunk")
+		return
+	}
+
+	c.writePackageUploadStatus(clientIp, session, "Uploaded package chunk "+strconv.Itoa(chunkNumber)+" successfully")
+}
+
+// @Title Query package upload
+// @Description Query received chunks of application package upload
+// @Param	tenantId	    path 	string	true	"tenantId"
+// @Param	uploadId	    path 	string	true	"uploadId"
+// @Param   access_token    header  string  true    "access token"
+// @Success 200 ok
+// @Failure 400 bad request
+// @Failure 404 not found
+// @router /tenants/:tenantId/packages/uploads/:uploadId [get]
+func (c *LcmController) QueryPackageUpload() {
+	log.Info("Query package upload request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+
+	session, err := c.getPackageUploadSession(clientIp)
+	if err != nil {
+		return
+	}
+	c.writePackageUploadStatus(clientIp, session, "Query package upload is successful")
+}
+
+// @Title Finalize package upload
+// @Description Assemble uploaded chunks and process the application package
+// @Param	tenantId	    path 	string	true	"tenantId"
+// @Param	uploadId	    path 	string	true	"uploadId"
+// @Param   access_token    header  string  true    "access token"
+// @Success 200 ok
+// @Failure 400 bad request
+// @Failure 404 not found
+// @router /tenants/:tenantId/packages/uploads/:uploadId/finalize [post]
+func (c *LcmController) FinalizePackageUpload() {
+	log.Info("Finalize package upload request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+
+	session, err := c.getPackageUploadSession(clientIp)
+	if err != nil {
+		return
+	}
+
+	status := getPackageUploadStatus(session)
+	if len(status.MissingChunks) != 0 {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.PackageUploadIncomplete)
+		return
+	}
+
+	pkgFilePath, err := c.assemblePackage(clientIp, session)
+	if err != nil {
+		return
+	}
+
+	err = c.processUploadedPackage(clientIp, session.AppId, session.TenantId, session.PackageId, session.Origin,
+		pkgFilePath)
+	if err != nil {
+		return
+	}
+
+	err = c.deletePackageUploadSession(session)
+	if err != nil {
+		log.Error("Failed to delete package upload session: " + err.Error())
+	}
+
+	c.handleLoggingForSuccess(clientIp, "Uploaded application package successfully")
+
+	appPkgResp, _ := json.Marshal(models.AppPackageResponse{AppId: session.AppId, PackageId: session.PackageId})
+	_, _ = c.Ctx.ResponseWriter.Write(appPkgResp)
+}
+
+// @Title Abort package upload
+// @Description Abort application package upload and remove received chunks
+// @Param	tenantId	    path 	string	true	"tenantId"
+// @Param	uploadId	    path 	string	true	"uploadId"
+// @Param   access_token    header  string  true    "access token"
+// @Success 200 ok
+// @Failure 400 bad request
+// @Failure 404 not found
+// @router /tenants/:tenantId/packages/uploads/:uploadId [delete]
+func (c *LcmController) AbortPackageUpload() {
+	log.Info("Abort package upload request received.")
+	clientIp := c.Ctx.Input.IP()
+	err := util.ValidateSrcAddress(clientIp)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.ClientIpaddressInvalid)
+		return
+	}
+	c.displayReceivedMsg(clientIp)
+
+	session, err := c.getPackageUploadSession(clientIp)
+	if err != nil {
+		return
+	}
+
+	err = c.deletePackageUploadSession(session)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, err.Error())
+		return
+	}
+	c.handleLoggingForSuccess(clientIp, "Aborted package upload successfully")
+}
+
+// Create package upload session from request, default identifiers are generated as in package upload
+func (c *LcmController) newPackageUploadSession(clientIp string, tenantId string,
+	req models.PackageUploadRequest) (*models.PackageUploadSession, error) {
+
+	appIdVar, err := util.ValidateName(req.AppId, util.NameRegex)
+	if err != nil || !appIdVar || len(req.AppId) > 32 {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "app id is invalid")
+		return nil, errors.New("app id is invalid")
+	}
+	if len(req.AppId) == 0 {
+		req.AppId = util.GenerateUUID()
+	}
+
+	packageIdVar, err := util.ValidateName(req.PackageId, util.NameRegex)
+	if err != nil || !packageIdVar || len(req.PackageId) > 64 {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.PackageIdIsInvalid)
+		return nil, errors.New(util.PackageIdIsInvalid)
+	}
+	if len(req.PackageId) == 0 {
+		req.PackageId = req.AppId + util.GenerateUUID()
+	}
+
+	originVar, err := util.ValidateName(req.Origin, util.NameRegex)
+	if err != nil || !originVar {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.OriginIsInvalid)
+		return nil, errors.New(util.OriginIsInvalid)
+	}
+
+	err = util.ValidateFileExtensionCsar(req.FileName)
+	if err != nil || len(req.FileName) > util.MaxFileNameSize {
+		c.HandleLoggingForError(clientIp, util.BadRequest,
+			"File shouldn't contains any extension or filename is larger than max size")
+		return nil, errors.New("file name is invalid")
+	}
+
+	err = util.ValidateFileSize(req.TotalSize, util.MaxAppPackageFile)
+	if err != nil || req.TotalSize <= 0 {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "File size is invalid or larger than max size")
+		return nil, errors.New("file size is invalid")
+	}
+
+	if req.ChunkSize == 0 {
+		req.ChunkSize = util.DefaultUploadChunkSize
+	}
+	if req.ChunkSize < 0 || req.ChunkSize > util.MaxUploadChunkSize ||
+		(req.TotalSize+req.ChunkSize-1)/req.ChunkSize > maxUploadChunks {
+		c.HandleLoggingForError(clientIp, util.BadRequest, "Chunk size is invalid")
+		return nil, errors.New("chunk size is invalid")
+	}
+
+	if req.Checksum != "" {
+		checksumVar, _ := regexp.MatchString(sha256HexRegex, req.Checksum)
+		if !checksumVar {
+			c.HandleLoggingForError(clientIp, util.BadRequest, "Checksum is invalid")
+			return nil, errors.New("checksum is invalid")
+		}
+	}
+
+	return &models.PackageUploadSession{
+		UploadId:   util.GenerateUUID(),
+		TenantId:   tenantId,
+		AppId:      req.AppId,
+		PackageId:  req.PackageId,
+		Origin:     req.Origin,
+		TotalSize:  req.TotalSize,
+		ChunkSize:  req.ChunkSize,
+		Checksum:   strings.ToLower(req.Checksum),
+		CreateTime: time.Now(),
+	}, nil
+}
+
+// Validate access token and get package upload session of request, chunk requests are not
+// limited to request body length so the token is validated here
+func (c *LcmController) getPackageUploadSession(clientIp string) (*models.PackageUploadSession, error) {
+	accessToken := c.Ctx.Request.Header.Get(util.AccessToken)
+	bKey := *(*[]byte)(unsafe.Pointer(&accessToken))
+	tenantId, err := c.getTenantId(clientIp)
+	if err != nil {
+		util.ClearByteArray(bKey)
+		return nil, err
+	}
+	err = util.ValidateAccessToken(accessToken, []string{util.MecmTenantRole, util.MecmAdminRole}, tenantId)
+	util.ClearByteArray(bKey)
+	if err != nil {
+		c.HandleLoggingForTokenFailure(clientIp, err.Error())
+		return nil, err
+	}
+
+	uploadId := c.Ctx.Input.Param(":uploadId")
+	uploadIdVar, err := util.ValidateName(uploadId, util.UuidRegex)
+	if err != nil || !uploadIdVar {
+		c.HandleLoggingForError(clientIp, util.BadRequest, util.UploadIdIsInvalid)
+		return nil, errors.New(util.UploadIdIsInvalid)
+	}
+
+	session := &models.PackageUploadSession{
+		UploadId: uploadId,
+	}
+	readErr := c.Db.ReadData(session, util.UploadId)
+	if readErr != nil || session.TenantId != tenantId || len(deleteStalePackageUploadSessions(c.Db,
+		[]*models.PackageUploadSession{session})) == 0 {
+		c.HandleLoggingForError(clientIp, util.StatusNotFound,
+			"Package upload session does not exist in database")
+		return nil, errors.New("package upload session does not exist")
+	}
+	return session, nil
+}
+
+// Assemble received chunks into application package file and verify its checksum
+func (c *LcmController) assemblePackage(clientIp string, session *models.PackageUploadSession) (string, error) {
+	pkgFilePath, err := createPackageDirectory(session.TenantId, session.PackageId)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToMakeDir)
+		return "", err
+	}
+
+	pkgFile, err := os.OpenFile(pkgFilePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, chunkFilePerm)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, "Failed to create package file")
+		return "", err
+	}
+	defer pkgFile.Close()
+
+	hasher := sha256.New()
+	writer := io.MultiWriter(pkgFile, hasher)
+	var size int64
+	for chunkNumber := 0; chunkNumber < getChunkCount(session); chunkNumber++ {
+		wrote, err := copyChunk(writer, getChunkPath(session, chunkNumber))
+		size += wrote
+		if err != nil {
+			c.HandleLoggingForError(clientIp, util.StatusInternalServerError, "Failed to assemble package")
+			return "", err
+		}
+	}
+
+	checksum := hex.EncodeToString(hasher.Sum(nil))
+	if size != session.TotalSize || (session.Checksum != "" && checksum != session.Checksum) {
+		_ = os.RemoveAll(PackageFolderPath + session.TenantId + "/" + session.PackageId)
+		c.HandleLoggingForError(clientIp, util.BadRequest, "Package checksum does not match")
+		return "", errors.New("package checksum does not match")
+	}
+	return pkgFilePath, nil
+}
+
+// Copy chunk file to writer
+func copyChunk(writer io.Writer, chunkPath string) (int64, error) {
+	chunkFile, err := os.Open(chunkPath)
+	if err != nil {
+		return 0, err
+	}
+	defer chunkFile.Close()
+	return io.Copy(writer, chunkFile)
+}
+
+// Delete package upload session record and received chunks
+func (c *LcmController) deletePackageUploadSession(session *models.PackageUploadSession) error {
+	return deletePackageUploadSession(c.Db, session)
+}
+
+// Delete package upload session record and received chunks
+func deletePackageUploadSession(db dbAdapter.Database, session *models.PackageUploadSession) error {
+	err := os.RemoveAll(getUploadDir(session))
+	if err != nil {
+		return errors.New("failed to delete package chunks")
+	}
+	err = db.DeleteData(session, util.UploadId)
+	if err != nil {
+		return errors.New("failed to delete package upload session")
+	}
+	return nil
+}
+
+// Start periodic deletion of package upload sessions which are not finalized within their ttl
+func StartPackageUploadSweep(db dbAdapter.Database) {
+	uploadSweepOnce.Do(func() {
+		go runPackageUploadSweep(db, uploadSweepInterval)
+	})
+}
+
+// Run periodic deletion of stale package upload sessions
+func runPackageUploadSweep(db dbAdapter.Database, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		SweepPackageUploadSessions(db)
+	}
+}
+
+// Delete all package upload sessions which are not finalized within their ttl
+func SweepPackageUploadSessions(db dbAdapter.Database) {
+	var sessions []*models.PackageUploadSession
+	_, err := db.QueryTable(util.PackageUploadSessionTable, &sessions, "")
+	if err != nil {
+		log.Error("Failed to query package upload sessions: " + err.Error())
+		return
+	}
+	deleteStalePackageUploadSessions(db, sessions)
+}
+
+// Delete stale package upload sessions and return the sessions which are still valid
+func deleteStalePackageUploadSessions(db dbAdapter.Database,
+	sessions []*models.PackageUploadSession) []*models.PackageUploadSession {
+
+	ttl := time.Duration(util.GetAppConfigPositiveInt(util.UploadSessionTtl, util.DefaultUploadSessionTtl)) *
+		time.Second
+	valid := make([]*models.PackageUploadSession, 0, len(sessions))
+	for _, session := range sessions {
+		if time.Since(session.CreateTime) < ttl {
+			valid = append(valid, session)
+			continue
+		}
+		log.Info("Deleting stale package upload session " + session.UploadId)
+		err := deletePackageUploadSession(db, session)
+		if err != nil {
+			log.Error("Failed to delete stale package upload session: " + err.Error())
+		}
+	}
+	return valid
+}
+
+// Write package upload status response
+func (c *LcmController) writePackageUploadStatus(clientIp string, session *models.PackageUploadSession,
+	msg string) {
+	res, err := json.Marshal(getPackageUploadStatus(session))
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToMarshal)
+		return
+	}
+	c.Ctx.ResponseWriter.Header().Set(util.ContentType, util.ApplicationJson)
+	_, err = c.Ctx.ResponseWriter.Write(res)
+	if err != nil {
+		c.HandleLoggingForError(clientIp, util.StatusInternalServerError, util.FailedToWriteRes)
+		return
+	}
+	c.handleLoggingForSuccess(clientIp, msg)
+}
+
+// Get package upload status from the received chunk files
+func getPackageUploadStatus(session *models.PackageUploadSession) models.PackageUploadStatus {
+	status := models.PackageUploadStatus{
+		UploadId:       session.UploadId,
+		AppId:          session.AppId,
+		PackageId:      session.PackageId,
+		TotalSize:      session.TotalSize,
+		ChunkSize:      session.ChunkSize,
+		ReceivedChunks: make([]models.UploadedChunk, 0),
+		MissingChunks:  make([]int, 0),
+	}
+	for chunkNumber := 0; chunkNumber < getChunkCount(session); chunkNumber++ {
+		info, err := os.Stat(getChunkPath(session, chunkNumber))
+		if err != nil || info.Size() != getChunkSize(session, chunkNumber) {
+			status.MissingChunks = append(status.MissingChunks, chunkNumber)
+			continue
+		}
+		status.ReceivedChunks = append(status.ReceivedChunks, models.UploadedChunk{ChunkNumber: chunkNumber,
+			Offset: int64(chunkNumber) * session.ChunkSize, Size: info.Size()})
+		status.ReceivedSize += info.Size()
+	}
+	return status
+}
+
+// Get number of chunks of package upload
+func getChunkCount(session *models.PackageUploadSession) int {
+	return int((session.TotalSize + session.ChunkSize - 1) / session.ChunkSize)
+}
+
+// Get expected size of chunk, only the last chunk can be smaller than chunk size
+func getChunkSize(session *models.PackageUploadSession, chunkNumber int) int64 {
+	remaining := session.TotalSize - int64(chunkNumber)*session.ChunkSize
+	if remaining < session.ChunkSize {
+		return remaining
+	}
+	return session.ChunkSize
+}
+
+// Get directory of received chunks
+func getUploadDir(session *models.PackageUploadSession) string {
+	return PackageFolderPath + uploadsFolder + session.TenantId + "/" + session.UploadId
+}
+
+// Get path of received chunk
+func getChunkPath(session *models.PackageUploadSession, chunkNumber int) string {
+	return getUploadDir(session) + "/" + strconv.Itoa(chunkNumber)
+}
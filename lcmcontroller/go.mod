@@ -29,6 +29,7 @@ require (
 	github.com/shiena/ansicolor v0.0.0-20200904210342-c7312218db18 // indirect
 	github.com/sirupsen/logrus v1.6.0
 	github.com/stretchr/testify v1.6.1
+	go.mozilla.org/pkcs7 v0.0.0-20210826202110-33d05740a352
 	github.com/ulule/limiter/v3 v3.8.0
 	golang.org/x/net v0.0.0-20200301022130-244492dfa37a
 	google.golang.org/grpc v1.31.0
@@ -55,6 +55,14 @@ type InstantiateRequest struct {
 	HostIp        string `protobuf:"bytes,5,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
 	Ak            string `protobuf:"bytes,6,opt,name=ak,proto3" json:"ak,omitempty"`
 	Sk            string `protobuf:"bytes,7,opt,name=sk,proto3" json:"sk,omitempty"`
+	Parameters    string `protobuf:"bytes,8,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// json object of helm values overrides, e.g. {"replicaCount": 2, "image": {"tag": "v2"}}
+	Namespace string `protobuf:"bytes,9,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// namespace of the app release, derived from tenantId when empty, otherwise it must be owned by the
+	// tenant i.e. be the lowercase tenantId or prefixed with it followed by "-"
+	WaitForReady bool `protobuf:"varint,10,opt,name=waitForReady,proto3" json:"waitForReady,omitempty"`
+	// wait until workloads of the release are ready, release which is not ready is uninstalled
+	ReadyTimeout int32 `protobuf:"varint,11,opt,name=readyTimeout,proto3" json:"readyTimeout,omitempty"` // seconds to wait for workloads to be ready, default timeout is used when zero
 }
 
 func (x *InstantiateRequest) Reset() {
@@ -138,12 +146,41 @@ func (x *InstantiateRequest) GetSk() string {
 	return ""
 }
 
+func (x *InstantiateRequest) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+func (x *InstantiateRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *InstantiateRequest) GetWaitForReady() bool {
+	if x != nil {
+		return x.WaitForReady
+	}
+	return false
+}
+
+func (x *InstantiateRequest) GetReadyTimeout() int32 {
+	if x != nil {
+		return x.ReadyTimeout
+	}
+	return 0
+}
+
 type InstantiateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // reason of failure when the application is not instantiated, e.g. workloads are not ready in time
 }
 
 func (x *InstantiateResponse) Reset() {
@@ -185,6 +222,13 @@ func (x *InstantiateResponse) GetStatus() string {
 	return ""
 }
 
+func (x *InstantiateResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type TerminateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -295,18 +339,22 @@ func (x *TerminateResponse) GetStatus() string {
 	return ""
 }
 
-type QueryRequest struct {
+type UpgradeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
-	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	TenantId      string `protobuf:"bytes,2,opt,name=tenantId,proto3" json:"tenantId,omitempty"`
+	AppInstanceId string `protobuf:"bytes,3,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
+	AppPackageId  string `protobuf:"bytes,4,opt,name=appPackageId,proto3" json:"appPackageId,omitempty"`
+	HostIp        string `protobuf:"bytes,5,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	Ak            string `protobuf:"bytes,6,opt,name=ak,proto3" json:"ak,omitempty"`
+	Sk            string `protobuf:"bytes,7,opt,name=sk,proto3" json:"sk,omitempty"`
 }
 
-func (x *QueryRequest) Reset() {
-	*x = QueryRequest{}
+func (x *UpgradeRequest) Reset() {
+	*x = UpgradeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,13 +362,13 @@ func (x *QueryRequest) Reset() {
 	}
 }
 
-func (x *QueryRequest) String() string {
+func (x *UpgradeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequest) ProtoMessage() {}
+func (*UpgradeRequest) ProtoMessage() {}
 
-func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+func (x *UpgradeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -332,42 +380,70 @@ func (x *QueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpgradeRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeRequest) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *QueryRequest) GetAccessToken() string {
+func (x *UpgradeRequest) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *QueryRequest) GetAppInstanceId() string {
+func (x *UpgradeRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpgradeRequest) GetAppInstanceId() string {
 	if x != nil {
 		return x.AppInstanceId
 	}
 	return ""
 }
 
-func (x *QueryRequest) GetHostIp() string {
+func (x *UpgradeRequest) GetAppPackageId() string {
+	if x != nil {
+		return x.AppPackageId
+	}
+	return ""
+}
+
+func (x *UpgradeRequest) GetHostIp() string {
 	if x != nil {
 		return x.HostIp
 	}
 	return ""
 }
 
-type QueryResponse struct {
+func (x *UpgradeRequest) GetAk() string {
+	if x != nil {
+		return x.Ak
+	}
+	return ""
+}
+
+func (x *UpgradeRequest) GetSk() string {
+	if x != nil {
+		return x.Sk
+	}
+	return ""
+}
+
+type UpgradeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *QueryResponse) Reset() {
-	*x = QueryResponse{}
+func (x *UpgradeResponse) Reset() {
+	*x = UpgradeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -375,13 +451,13 @@ func (x *QueryResponse) Reset() {
 	}
 }
 
-func (x *QueryResponse) String() string {
+func (x *UpgradeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryResponse) ProtoMessage() {}
+func (*UpgradeResponse) ProtoMessage() {}
 
-func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+func (x *UpgradeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -393,32 +469,31 @@ func (x *QueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
-func (*QueryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpgradeResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeResponse) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *QueryResponse) GetResponse() string {
+func (x *UpgradeResponse) GetStatus() string {
 	if x != nil {
-		return x.Response
+		return x.Status
 	}
 	return ""
 }
 
-type UploadCfgRequest struct {
+type RollbackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*UploadCfgRequest_AccessToken
-	//	*UploadCfgRequest_HostIp
-	//	*UploadCfgRequest_ConfigFile
-	Data isUploadCfgRequest_Data `protobuf_oneof:"data"`
+	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
+	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	Revision      int32  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *UploadCfgRequest) Reset() {
-	*x = UploadCfgRequest{}
+func (x *RollbackRequest) Reset() {
+	*x = RollbackRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -426,13 +501,13 @@ func (x *UploadCfgRequest) Reset() {
 	}
 }
 
-func (x *UploadCfgRequest) String() string {
+func (x *RollbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadCfgRequest) ProtoMessage() {}
+func (*RollbackRequest) ProtoMessage() {}
 
-func (x *UploadCfgRequest) ProtoReflect() protoreflect.Message {
+func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -444,71 +519,53 @@ func (x *UploadCfgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadCfgRequest.ProtoReflect.Descriptor instead.
-func (*UploadCfgRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{6}
 }
 
-func (m *UploadCfgRequest) GetData() isUploadCfgRequest_Data {
-	if m != nil {
-		return m.Data
+func (x *RollbackRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadCfgRequest) GetAccessToken() string {
-	if x, ok := x.GetData().(*UploadCfgRequest_AccessToken); ok {
-		return x.AccessToken
+func (x *RollbackRequest) GetAppInstanceId() string {
+	if x != nil {
+		return x.AppInstanceId
 	}
 	return ""
 }
 
-func (x *UploadCfgRequest) GetHostIp() string {
-	if x, ok := x.GetData().(*UploadCfgRequest_HostIp); ok {
+func (x *RollbackRequest) GetHostIp() string {
+	if x != nil {
 		return x.HostIp
 	}
 	return ""
 }
 
-func (x *UploadCfgRequest) GetConfigFile() []byte {
-	if x, ok := x.GetData().(*UploadCfgRequest_ConfigFile); ok {
-		return x.ConfigFile
+func (x *RollbackRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
 	}
-	return nil
-}
-
-type isUploadCfgRequest_Data interface {
-	isUploadCfgRequest_Data()
-}
-
-type UploadCfgRequest_AccessToken struct {
-	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3,oneof"`
-}
-
-type UploadCfgRequest_HostIp struct {
-	HostIp string `protobuf:"bytes,2,opt,name=hostIp,proto3,oneof"`
-}
-
-type UploadCfgRequest_ConfigFile struct {
-	ConfigFile []byte `protobuf:"bytes,3,opt,name=configFile,proto3,oneof"`
+	return 0
 }
 
-func (*UploadCfgRequest_AccessToken) isUploadCfgRequest_Data() {}
-
-func (*UploadCfgRequest_HostIp) isUploadCfgRequest_Data() {}
-
-func (*UploadCfgRequest_ConfigFile) isUploadCfgRequest_Data() {}
-
-type UploadCfgResponse struct {
+// Package and ak sk values of the restored revision, empty when not known
+type RollbackResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PackageId string `protobuf:"bytes,2,opt,name=packageId,proto3" json:"packageId,omitempty"`
+	Ak        string `protobuf:"bytes,3,opt,name=ak,proto3" json:"ak,omitempty"`
+	Sk        string `protobuf:"bytes,4,opt,name=sk,proto3" json:"sk,omitempty"`
 }
 
-func (x *UploadCfgResponse) Reset() {
-	*x = UploadCfgResponse{}
+func (x *RollbackResponse) Reset() {
+	*x = RollbackResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -516,13 +573,13 @@ func (x *UploadCfgResponse) Reset() {
 	}
 }
 
-func (x *UploadCfgResponse) String() string {
+func (x *RollbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadCfgResponse) ProtoMessage() {}
+func (*RollbackResponse) ProtoMessage() {}
 
-func (x *UploadCfgResponse) ProtoReflect() protoreflect.Message {
+func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,29 +591,52 @@ func (x *UploadCfgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadCfgResponse.ProtoReflect.Descriptor instead.
-func (*UploadCfgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackResponse.ProtoReflect.Descriptor instead.
+func (*RollbackResponse) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UploadCfgResponse) GetStatus() string {
+func (x *RollbackResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type RemoveCfgRequest struct {
+func (x *RollbackResponse) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *RollbackResponse) GetAk() string {
+	if x != nil {
+		return x.Ak
+	}
+	return ""
+}
+
+func (x *RollbackResponse) GetSk() string {
+	if x != nil {
+		return x.Sk
+	}
+	return ""
+}
+
+type ScaleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	HostIp      string `protobuf:"bytes,2,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
+	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	Replicas      int32  `protobuf:"varint,4,opt,name=replicas,proto3" json:"replicas,omitempty"`
 }
 
-func (x *RemoveCfgRequest) Reset() {
-	*x = RemoveCfgRequest{}
+func (x *ScaleRequest) Reset() {
+	*x = ScaleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -564,13 +644,13 @@ func (x *RemoveCfgRequest) Reset() {
 	}
 }
 
-func (x *RemoveCfgRequest) String() string {
+func (x *ScaleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCfgRequest) ProtoMessage() {}
+func (*ScaleRequest) ProtoMessage() {}
 
-func (x *RemoveCfgRequest) ProtoReflect() protoreflect.Message {
+func (x *ScaleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -582,26 +662,40 @@ func (x *RemoveCfgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCfgRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCfgRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScaleRequest.ProtoReflect.Descriptor instead.
+func (*ScaleRequest) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RemoveCfgRequest) GetAccessToken() string {
+func (x *ScaleRequest) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *RemoveCfgRequest) GetHostIp() string {
+func (x *ScaleRequest) GetAppInstanceId() string {
+	if x != nil {
+		return x.AppInstanceId
+	}
+	return ""
+}
+
+func (x *ScaleRequest) GetHostIp() string {
 	if x != nil {
 		return x.HostIp
 	}
 	return ""
 }
 
-type RemoveCfgResponse struct {
+func (x *ScaleRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+type ScaleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -609,8 +703,8 @@ type RemoveCfgResponse struct {
 	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *RemoveCfgResponse) Reset() {
-	*x = RemoveCfgResponse{}
+func (x *ScaleResponse) Reset() {
+	*x = ScaleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -618,13 +712,13 @@ func (x *RemoveCfgResponse) Reset() {
 	}
 }
 
-func (x *RemoveCfgResponse) String() string {
+func (x *ScaleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCfgResponse) ProtoMessage() {}
+func (*ScaleResponse) ProtoMessage() {}
 
-func (x *RemoveCfgResponse) ProtoReflect() protoreflect.Message {
+func (x *ScaleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -636,19 +730,19 @@ func (x *RemoveCfgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCfgResponse.ProtoReflect.Descriptor instead.
-func (*RemoveCfgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScaleResponse.ProtoReflect.Descriptor instead.
+func (*ScaleResponse) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RemoveCfgResponse) GetStatus() string {
+func (x *ScaleResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type WorkloadEventsRequest struct {
+type SetAutoscalingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -656,10 +750,11 @@ type WorkloadEventsRequest struct {
 	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
 	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	Policy        string `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
-func (x *WorkloadEventsRequest) Reset() {
-	*x = WorkloadEventsRequest{}
+func (x *SetAutoscalingRequest) Reset() {
+	*x = SetAutoscalingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -667,13 +762,13 @@ func (x *WorkloadEventsRequest) Reset() {
 	}
 }
 
-func (x *WorkloadEventsRequest) String() string {
+func (x *SetAutoscalingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkloadEventsRequest) ProtoMessage() {}
+func (*SetAutoscalingRequest) ProtoMessage() {}
 
-func (x *WorkloadEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *SetAutoscalingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -685,42 +780,49 @@ func (x *WorkloadEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkloadEventsRequest.ProtoReflect.Descriptor instead.
-func (*WorkloadEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAutoscalingRequest.ProtoReflect.Descriptor instead.
+func (*SetAutoscalingRequest) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *WorkloadEventsRequest) GetAccessToken() string {
+func (x *SetAutoscalingRequest) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *WorkloadEventsRequest) GetAppInstanceId() string {
+func (x *SetAutoscalingRequest) GetAppInstanceId() string {
 	if x != nil {
 		return x.AppInstanceId
 	}
 	return ""
 }
 
-func (x *WorkloadEventsRequest) GetHostIp() string {
+func (x *SetAutoscalingRequest) GetHostIp() string {
 	if x != nil {
 		return x.HostIp
 	}
 	return ""
 }
 
-type WorkloadEventsResponse struct {
+func (x *SetAutoscalingRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type SetAutoscalingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *WorkloadEventsResponse) Reset() {
-	*x = WorkloadEventsResponse{}
+func (x *SetAutoscalingResponse) Reset() {
+	*x = SetAutoscalingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -728,13 +830,13 @@ func (x *WorkloadEventsResponse) Reset() {
 	}
 }
 
-func (x *WorkloadEventsResponse) String() string {
+func (x *SetAutoscalingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkloadEventsResponse) ProtoMessage() {}
+func (*SetAutoscalingResponse) ProtoMessage() {}
 
-func (x *WorkloadEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *SetAutoscalingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,19 +848,19 @@ func (x *WorkloadEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkloadEventsResponse.ProtoReflect.Descriptor instead.
-func (*WorkloadEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAutoscalingResponse.ProtoReflect.Descriptor instead.
+func (*SetAutoscalingResponse) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *WorkloadEventsResponse) GetResponse() string {
+func (x *SetAutoscalingResponse) GetStatus() string {
 	if x != nil {
-		return x.Response
+		return x.Status
 	}
 	return ""
 }
 
-type CreateVmImageRequest struct {
+type DeleteAutoscalingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -766,11 +868,10 @@ type CreateVmImageRequest struct {
 	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
 	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
-	VmId          string `protobuf:"bytes,4,opt,name=vmId,proto3" json:"vmId,omitempty"`
 }
 
-func (x *CreateVmImageRequest) Reset() {
-	*x = CreateVmImageRequest{}
+func (x *DeleteAutoscalingRequest) Reset() {
+	*x = DeleteAutoscalingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -778,13 +879,13 @@ func (x *CreateVmImageRequest) Reset() {
 	}
 }
 
-func (x *CreateVmImageRequest) String() string {
+func (x *DeleteAutoscalingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVmImageRequest) ProtoMessage() {}
+func (*DeleteAutoscalingRequest) ProtoMessage() {}
 
-func (x *CreateVmImageRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteAutoscalingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,49 +897,42 @@ func (x *CreateVmImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVmImageRequest.ProtoReflect.Descriptor instead.
-func (*CreateVmImageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAutoscalingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAutoscalingRequest) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateVmImageRequest) GetAccessToken() string {
+func (x *DeleteAutoscalingRequest) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *CreateVmImageRequest) GetAppInstanceId() string {
+func (x *DeleteAutoscalingRequest) GetAppInstanceId() string {
 	if x != nil {
 		return x.AppInstanceId
 	}
 	return ""
 }
 
-func (x *CreateVmImageRequest) GetHostIp() string {
+func (x *DeleteAutoscalingRequest) GetHostIp() string {
 	if x != nil {
 		return x.HostIp
 	}
 	return ""
 }
 
-func (x *CreateVmImageRequest) GetVmId() string {
-	if x != nil {
-		return x.VmId
-	}
-	return ""
-}
-
-type CreateVmImageResponse struct {
+type DeleteAutoscalingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"` // {'imageId': 'xxxaaabbccssdd'}
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *CreateVmImageResponse) Reset() {
-	*x = CreateVmImageResponse{}
+func (x *DeleteAutoscalingResponse) Reset() {
+	*x = DeleteAutoscalingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -846,13 +940,13 @@ func (x *CreateVmImageResponse) Reset() {
 	}
 }
 
-func (x *CreateVmImageResponse) String() string {
+func (x *DeleteAutoscalingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVmImageResponse) ProtoMessage() {}
+func (*DeleteAutoscalingResponse) ProtoMessage() {}
 
-func (x *CreateVmImageResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteAutoscalingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -864,19 +958,19 @@ func (x *CreateVmImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVmImageResponse.ProtoReflect.Descriptor instead.
-func (*CreateVmImageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAutoscalingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAutoscalingResponse) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateVmImageResponse) GetResponse() string {
+func (x *DeleteAutoscalingResponse) GetStatus() string {
 	if x != nil {
-		return x.Response
+		return x.Status
 	}
 	return ""
 }
 
-type QueryVmImageRequest struct {
+type WorkloadLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -884,11 +978,16 @@ type QueryVmImageRequest struct {
 	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
 	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
-	ImageId       string `protobuf:"bytes,4,opt,name=imageId,proto3" json:"imageId,omitempty"`
+	PodName       string `protobuf:"bytes,4,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName string `protobuf:"bytes,5,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	TailLines     int64  `protobuf:"varint,6,opt,name=tailLines,proto3" json:"tailLines,omitempty"`
+	// RFC3339 time, only logs after it are returned
+	SinceTime string `protobuf:"bytes,7,opt,name=sinceTime,proto3" json:"sinceTime,omitempty"`
+	Follow    bool   `protobuf:"varint,8,opt,name=follow,proto3" json:"follow,omitempty"`
 }
 
-func (x *QueryVmImageRequest) Reset() {
-	*x = QueryVmImageRequest{}
+func (x *WorkloadLogsRequest) Reset() {
+	*x = WorkloadLogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -896,13 +995,13 @@ func (x *QueryVmImageRequest) Reset() {
 	}
 }
 
-func (x *QueryVmImageRequest) String() string {
+func (x *WorkloadLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVmImageRequest) ProtoMessage() {}
+func (*WorkloadLogsRequest) ProtoMessage() {}
 
-func (x *QueryVmImageRequest) ProtoReflect() protoreflect.Message {
+func (x *WorkloadLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,49 +1013,77 @@ func (x *QueryVmImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryVmImageRequest.ProtoReflect.Descriptor instead.
-func (*QueryVmImageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkloadLogsRequest.ProtoReflect.Descriptor instead.
+func (*WorkloadLogsRequest) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryVmImageRequest) GetAccessToken() string {
+func (x *WorkloadLogsRequest) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *QueryVmImageRequest) GetAppInstanceId() string {
+func (x *WorkloadLogsRequest) GetAppInstanceId() string {
 	if x != nil {
 		return x.AppInstanceId
 	}
 	return ""
 }
 
-func (x *QueryVmImageRequest) GetHostIp() string {
+func (x *WorkloadLogsRequest) GetHostIp() string {
 	if x != nil {
 		return x.HostIp
 	}
 	return ""
 }
 
-func (x *QueryVmImageRequest) GetImageId() string {
+func (x *WorkloadLogsRequest) GetPodName() string {
 	if x != nil {
-		return x.ImageId
+		return x.PodName
 	}
 	return ""
 }
 
-type QueryVmImageResponse struct {
+func (x *WorkloadLogsRequest) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *WorkloadLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *WorkloadLogsRequest) GetSinceTime() string {
+	if x != nil {
+		return x.SinceTime
+	}
+	return ""
+}
+
+func (x *WorkloadLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type WorkloadLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"` // {'status': 'Pending', 'sumChunkNum': 100, 'chunkSize': 2048}
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *QueryVmImageResponse) Reset() {
-	*x = QueryVmImageResponse{}
+func (x *WorkloadLogsResponse) Reset() {
+	*x = WorkloadLogsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -964,13 +1091,13 @@ func (x *QueryVmImageResponse) Reset() {
 	}
 }
 
-func (x *QueryVmImageResponse) String() string {
+func (x *WorkloadLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVmImageResponse) ProtoMessage() {}
+func (*WorkloadLogsResponse) ProtoMessage() {}
 
-func (x *QueryVmImageResponse) ProtoReflect() protoreflect.Message {
+func (x *WorkloadLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -982,19 +1109,19 @@ func (x *QueryVmImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryVmImageResponse.ProtoReflect.Descriptor instead.
-func (*QueryVmImageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkloadLogsResponse.ProtoReflect.Descriptor instead.
+func (*WorkloadLogsResponse) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryVmImageResponse) GetResponse() string {
+func (x *WorkloadLogsResponse) GetContent() []byte {
 	if x != nil {
-		return x.Response
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-type DeleteVmImageRequest struct {
+type HistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1002,11 +1129,10 @@ type DeleteVmImageRequest struct {
 	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
 	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
-	ImageId       string `protobuf:"bytes,4,opt,name=imageId,proto3" json:"imageId,omitempty"`
 }
 
-func (x *DeleteVmImageRequest) Reset() {
-	*x = DeleteVmImageRequest{}
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1014,13 +1140,13 @@ func (x *DeleteVmImageRequest) Reset() {
 	}
 }
 
-func (x *DeleteVmImageRequest) String() string {
+func (x *HistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVmImageRequest) ProtoMessage() {}
+func (*HistoryRequest) ProtoMessage() {}
 
-func (x *DeleteVmImageRequest) ProtoReflect() protoreflect.Message {
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1032,49 +1158,42 @@ func (x *DeleteVmImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVmImageRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVmImageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteVmImageRequest) GetAccessToken() string {
+func (x *HistoryRequest) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *DeleteVmImageRequest) GetAppInstanceId() string {
+func (x *HistoryRequest) GetAppInstanceId() string {
 	if x != nil {
 		return x.AppInstanceId
 	}
 	return ""
 }
 
-func (x *DeleteVmImageRequest) GetHostIp() string {
+func (x *HistoryRequest) GetHostIp() string {
 	if x != nil {
 		return x.HostIp
 	}
 	return ""
 }
 
-func (x *DeleteVmImageRequest) GetImageId() string {
-	if x != nil {
-		return x.ImageId
-	}
-	return ""
-}
-
-type DeleteVmImageResponse struct {
+type HistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"` // {'code': 200, 'msg': 'Ok'}
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *DeleteVmImageResponse) Reset() {
-	*x = DeleteVmImageResponse{}
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1082,13 +1201,13 @@ func (x *DeleteVmImageResponse) Reset() {
 	}
 }
 
-func (x *DeleteVmImageResponse) String() string {
+func (x *HistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVmImageResponse) ProtoMessage() {}
+func (*HistoryResponse) ProtoMessage() {}
 
-func (x *DeleteVmImageResponse) ProtoReflect() protoreflect.Message {
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1100,19 +1219,19 @@ func (x *DeleteVmImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVmImageResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVmImageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteVmImageResponse) GetResponse() string {
+func (x *HistoryResponse) GetResponse() string {
 	if x != nil {
 		return x.Response
 	}
 	return ""
 }
 
-type DownloadVmImageRequest struct {
+type QueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1120,12 +1239,11 @@ type DownloadVmImageRequest struct {
 	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
 	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
-	ImageId       string `protobuf:"bytes,4,opt,name=imageId,proto3" json:"imageId,omitempty"`
-	ChunkNum      int32  `protobuf:"varint,5,opt,name=chunkNum,proto3" json:"chunkNum,omitempty"`
+	HealthOnly    bool   `protobuf:"varint,4,opt,name=healthOnly,proto3" json:"healthOnly,omitempty"` // only health summary is returned, response is empty
 }
 
-func (x *DownloadVmImageRequest) Reset() {
-	*x = DownloadVmImageRequest{}
+func (x *QueryRequest) Reset() {
+	*x = QueryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1133,13 +1251,13 @@ func (x *DownloadVmImageRequest) Reset() {
 	}
 }
 
-func (x *DownloadVmImageRequest) String() string {
+func (x *QueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadVmImageRequest) ProtoMessage() {}
+func (*QueryRequest) ProtoMessage() {}
 
-func (x *DownloadVmImageRequest) ProtoReflect() protoreflect.Message {
+func (x *QueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1151,56 +1269,51 @@ func (x *DownloadVmImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadVmImageRequest.ProtoReflect.Descriptor instead.
-func (*DownloadVmImageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequest) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DownloadVmImageRequest) GetAccessToken() string {
+func (x *QueryRequest) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *DownloadVmImageRequest) GetAppInstanceId() string {
+func (x *QueryRequest) GetAppInstanceId() string {
 	if x != nil {
 		return x.AppInstanceId
 	}
 	return ""
 }
 
-func (x *DownloadVmImageRequest) GetHostIp() string {
+func (x *QueryRequest) GetHostIp() string {
 	if x != nil {
 		return x.HostIp
 	}
 	return ""
 }
 
-func (x *DownloadVmImageRequest) GetImageId() string {
-	if x != nil {
-		return x.ImageId
-	}
-	return ""
-}
-
-func (x *DownloadVmImageRequest) GetChunkNum() int32 {
+func (x *QueryRequest) GetHealthOnly() bool {
 	if x != nil {
-		return x.ChunkNum
+		return x.HealthOnly
 	}
-	return 0
+	return false
 }
 
-type DownloadVmImageResponse struct {
+type HealthSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Healthy, Progressing, Degraded or Failed
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
 }
 
-func (x *DownloadVmImageResponse) Reset() {
-	*x = DownloadVmImageResponse{}
+func (x *HealthSummary) Reset() {
+	*x = HealthSummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1208,13 +1321,13 @@ func (x *DownloadVmImageResponse) Reset() {
 	}
 }
 
-func (x *DownloadVmImageResponse) String() string {
+func (x *HealthSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadVmImageResponse) ProtoMessage() {}
+func (*HealthSummary) ProtoMessage() {}
 
-func (x *DownloadVmImageResponse) ProtoReflect() protoreflect.Message {
+func (x *HealthSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1226,34 +1339,53 @@ func (x *DownloadVmImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadVmImageResponse.ProtoReflect.Descriptor instead.
-func (*DownloadVmImageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthSummary.ProtoReflect.Descriptor instead.
+func (*HealthSummary) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DownloadVmImageResponse) GetContent() []byte {
+func (x *HealthSummary) GetStatus() string {
 	if x != nil {
-		return x.Content
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthSummary) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
 	}
 	return nil
 }
 
-type UploadPackageRequest struct {
+type QueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*UploadPackageRequest_AccessToken
-	//	*UploadPackageRequest_AppPackageId
-	//	*UploadPackageRequest_HostIp
-	//	*UploadPackageRequest_TenantId
-	//	*UploadPackageRequest_Package
-	Data isUploadPackageRequest_Data `protobuf_oneof:"data"`
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// Container applications respond with pods, workloads and resources of the release, status of resource is
+	// Ready, NotReady, Complete, Failed, Exists, Missing, Unknown or the phase of the resource
+	// {
+	//   "pods": [],
+	//   "workloads": [],
+	//   "resources": [
+	//     {
+	//       "group": "string",
+	//       "version": "string",
+	//       "kind": "string",
+	//       "namespace": "string",
+	//       "name": "string",
+	//       "status": "string",
+	//       "message": "string"
+	//     }
+	//   ]
+	// }
+	Health *HealthSummary `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
 }
 
-func (x *UploadPackageRequest) Reset() {
-	*x = UploadPackageRequest{}
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1261,13 +1393,13 @@ func (x *UploadPackageRequest) Reset() {
 	}
 }
 
-func (x *UploadPackageRequest) String() string {
+func (x *QueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadPackageRequest) ProtoMessage() {}
+func (*QueryResponse) ProtoMessage() {}
 
-func (x *UploadPackageRequest) ProtoReflect() protoreflect.Message {
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1279,97 +1411,39 @@ func (x *UploadPackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadPackageRequest.ProtoReflect.Descriptor instead.
-func (*UploadPackageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{20}
 }
 
-func (m *UploadPackageRequest) GetData() isUploadPackageRequest_Data {
-	if m != nil {
-		return m.Data
-	}
-	return nil
-}
-
-func (x *UploadPackageRequest) GetAccessToken() string {
-	if x, ok := x.GetData().(*UploadPackageRequest_AccessToken); ok {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *UploadPackageRequest) GetAppPackageId() string {
-	if x, ok := x.GetData().(*UploadPackageRequest_AppPackageId); ok {
-		return x.AppPackageId
-	}
-	return ""
-}
-
-func (x *UploadPackageRequest) GetHostIp() string {
-	if x, ok := x.GetData().(*UploadPackageRequest_HostIp); ok {
-		return x.HostIp
-	}
-	return ""
-}
-
-func (x *UploadPackageRequest) GetTenantId() string {
-	if x, ok := x.GetData().(*UploadPackageRequest_TenantId); ok {
-		return x.TenantId
+func (x *QueryResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
 	}
 	return ""
 }
 
-func (x *UploadPackageRequest) GetPackage() []byte {
-	if x, ok := x.GetData().(*UploadPackageRequest_Package); ok {
-		return x.Package
+func (x *QueryResponse) GetHealth() *HealthSummary {
+	if x != nil {
+		return x.Health
 	}
 	return nil
 }
 
-type isUploadPackageRequest_Data interface {
-	isUploadPackageRequest_Data()
-}
-
-type UploadPackageRequest_AccessToken struct {
-	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3,oneof"`
-}
-
-type UploadPackageRequest_AppPackageId struct {
-	AppPackageId string `protobuf:"bytes,2,opt,name=appPackageId,proto3,oneof"`
-}
-
-type UploadPackageRequest_HostIp struct {
-	HostIp string `protobuf:"bytes,3,opt,name=hostIp,proto3,oneof"`
-}
-
-type UploadPackageRequest_TenantId struct {
-	TenantId string `protobuf:"bytes,4,opt,name=tenantId,proto3,oneof"`
-}
-
-type UploadPackageRequest_Package struct {
-	Package []byte `protobuf:"bytes,5,opt,name=package,proto3,oneof"`
-}
-
-func (*UploadPackageRequest_AccessToken) isUploadPackageRequest_Data() {}
-
-func (*UploadPackageRequest_AppPackageId) isUploadPackageRequest_Data() {}
-
-func (*UploadPackageRequest_HostIp) isUploadPackageRequest_Data() {}
-
-func (*UploadPackageRequest_TenantId) isUploadPackageRequest_Data() {}
-
-func (*UploadPackageRequest_Package) isUploadPackageRequest_Data() {}
-
-type UploadPackageResponse struct {
+type UploadCfgRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Types that are assignable to Data:
+	//	*UploadCfgRequest_AccessToken
+	//	*UploadCfgRequest_HostIp
+	//	*UploadCfgRequest_ConfigFile
+	Data isUploadCfgRequest_Data `protobuf_oneof:"data"`
 }
 
-func (x *UploadPackageResponse) Reset() {
-	*x = UploadPackageResponse{}
+func (x *UploadCfgRequest) Reset() {
+	*x = UploadCfgRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lcmservice_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1377,13 +1451,13 @@ func (x *UploadPackageResponse) Reset() {
 	}
 }
 
-func (x *UploadPackageResponse) String() string {
+func (x *UploadCfgRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadPackageResponse) ProtoMessage() {}
+func (*UploadCfgRequest) ProtoMessage() {}
 
-func (x *UploadPackageResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadCfgRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lcmservice_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1395,13 +1469,1092 @@ func (x *UploadPackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadPackageResponse.ProtoReflect.Descriptor instead.
-func (*UploadPackageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadCfgRequest.ProtoReflect.Descriptor instead.
+func (*UploadCfgRequest) Descriptor() ([]byte, []int) {
 	return file_lcmservice_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UploadPackageResponse) GetStatus() string {
-	if x != nil {
+func (m *UploadCfgRequest) GetData() isUploadCfgRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadCfgRequest) GetAccessToken() string {
+	if x, ok := x.GetData().(*UploadCfgRequest_AccessToken); ok {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *UploadCfgRequest) GetHostIp() string {
+	if x, ok := x.GetData().(*UploadCfgRequest_HostIp); ok {
+		return x.HostIp
+	}
+	return ""
+}
+
+func (x *UploadCfgRequest) GetConfigFile() []byte {
+	if x, ok := x.GetData().(*UploadCfgRequest_ConfigFile); ok {
+		return x.ConfigFile
+	}
+	return nil
+}
+
+type isUploadCfgRequest_Data interface {
+	isUploadCfgRequest_Data()
+}
+
+type UploadCfgRequest_AccessToken struct {
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3,oneof"`
+}
+
+type UploadCfgRequest_HostIp struct {
+	HostIp string `protobuf:"bytes,2,opt,name=hostIp,proto3,oneof"`
+}
+
+type UploadCfgRequest_ConfigFile struct {
+	ConfigFile []byte `protobuf:"bytes,3,opt,name=configFile,proto3,oneof"`
+}
+
+func (*UploadCfgRequest_AccessToken) isUploadCfgRequest_Data() {}
+
+func (*UploadCfgRequest_HostIp) isUploadCfgRequest_Data() {}
+
+func (*UploadCfgRequest_ConfigFile) isUploadCfgRequest_Data() {}
+
+type UploadCfgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UploadCfgResponse) Reset() {
+	*x = UploadCfgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadCfgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCfgResponse) ProtoMessage() {}
+
+func (x *UploadCfgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCfgResponse.ProtoReflect.Descriptor instead.
+func (*UploadCfgResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadCfgResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RemoveCfgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	HostIp      string `protobuf:"bytes,2,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+}
+
+func (x *RemoveCfgRequest) Reset() {
+	*x = RemoveCfgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCfgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCfgRequest) ProtoMessage() {}
+
+func (x *RemoveCfgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCfgRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCfgRequest) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveCfgRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RemoveCfgRequest) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+type RemoveCfgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RemoveCfgResponse) Reset() {
+	*x = RemoveCfgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCfgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCfgResponse) ProtoMessage() {}
+
+func (x *RemoveCfgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCfgResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCfgResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveCfgResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type WorkloadEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
+	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	// RFC3339 time, only events seen after it are returned
+	SinceTime string `protobuf:"bytes,4,opt,name=sinceTime,proto3" json:"sinceTime,omitempty"`
+	// Event type, Normal or Warning, all types when empty
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *WorkloadEventsRequest) Reset() {
+	*x = WorkloadEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadEventsRequest) ProtoMessage() {}
+
+func (x *WorkloadEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadEventsRequest.ProtoReflect.Descriptor instead.
+func (*WorkloadEventsRequest) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WorkloadEventsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *WorkloadEventsRequest) GetAppInstanceId() string {
+	if x != nil {
+		return x.AppInstanceId
+	}
+	return ""
+}
+
+func (x *WorkloadEventsRequest) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+func (x *WorkloadEventsRequest) GetSinceTime() string {
+	if x != nil {
+		return x.SinceTime
+	}
+	return ""
+}
+
+func (x *WorkloadEventsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type WorkloadEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *WorkloadEventsResponse) Reset() {
+	*x = WorkloadEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadEventsResponse) ProtoMessage() {}
+
+func (x *WorkloadEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadEventsResponse.ProtoReflect.Descriptor instead.
+func (*WorkloadEventsResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WorkloadEventsResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type EndpointsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
+	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+}
+
+func (x *EndpointsRequest) Reset() {
+	*x = EndpointsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointsRequest) ProtoMessage() {}
+
+func (x *EndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointsRequest.ProtoReflect.Descriptor instead.
+func (*EndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EndpointsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *EndpointsRequest) GetAppInstanceId() string {
+	if x != nil {
+		return x.AppInstanceId
+	}
+	return ""
+}
+
+func (x *EndpointsRequest) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+type EndpointsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *EndpointsResponse) Reset() {
+	*x = EndpointsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointsResponse) ProtoMessage() {}
+
+func (x *EndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointsResponse.ProtoReflect.Descriptor instead.
+func (*EndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EndpointsResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type CreateVmImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
+	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	VmId          string `protobuf:"bytes,4,opt,name=vmId,proto3" json:"vmId,omitempty"`
+}
+
+func (x *CreateVmImageRequest) Reset() {
+	*x = CreateVmImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVmImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVmImageRequest) ProtoMessage() {}
+
+func (x *CreateVmImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVmImageRequest.ProtoReflect.Descriptor instead.
+func (*CreateVmImageRequest) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateVmImageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *CreateVmImageRequest) GetAppInstanceId() string {
+	if x != nil {
+		return x.AppInstanceId
+	}
+	return ""
+}
+
+func (x *CreateVmImageRequest) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+func (x *CreateVmImageRequest) GetVmId() string {
+	if x != nil {
+		return x.VmId
+	}
+	return ""
+}
+
+type CreateVmImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"` // {'imageId': 'xxxaaabbccssdd'}
+}
+
+func (x *CreateVmImageResponse) Reset() {
+	*x = CreateVmImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVmImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVmImageResponse) ProtoMessage() {}
+
+func (x *CreateVmImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVmImageResponse.ProtoReflect.Descriptor instead.
+func (*CreateVmImageResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateVmImageResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type QueryVmImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
+	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	ImageId       string `protobuf:"bytes,4,opt,name=imageId,proto3" json:"imageId,omitempty"`
+}
+
+func (x *QueryVmImageRequest) Reset() {
+	*x = QueryVmImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVmImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVmImageRequest) ProtoMessage() {}
+
+func (x *QueryVmImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryVmImageRequest.ProtoReflect.Descriptor instead.
+func (*QueryVmImageRequest) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QueryVmImageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *QueryVmImageRequest) GetAppInstanceId() string {
+	if x != nil {
+		return x.AppInstanceId
+	}
+	return ""
+}
+
+func (x *QueryVmImageRequest) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+func (x *QueryVmImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type QueryVmImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"` // {'status': 'Pending', 'sumChunkNum': 100, 'chunkSize': 2048}
+}
+
+func (x *QueryVmImageResponse) Reset() {
+	*x = QueryVmImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVmImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVmImageResponse) ProtoMessage() {}
+
+func (x *QueryVmImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryVmImageResponse.ProtoReflect.Descriptor instead.
+func (*QueryVmImageResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueryVmImageResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type DeleteVmImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
+	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	ImageId       string `protobuf:"bytes,4,opt,name=imageId,proto3" json:"imageId,omitempty"`
+}
+
+func (x *DeleteVmImageRequest) Reset() {
+	*x = DeleteVmImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVmImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVmImageRequest) ProtoMessage() {}
+
+func (x *DeleteVmImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVmImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVmImageRequest) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteVmImageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *DeleteVmImageRequest) GetAppInstanceId() string {
+	if x != nil {
+		return x.AppInstanceId
+	}
+	return ""
+}
+
+func (x *DeleteVmImageRequest) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+func (x *DeleteVmImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type DeleteVmImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"` // {'code': 200, 'msg': 'Ok'}
+}
+
+func (x *DeleteVmImageResponse) Reset() {
+	*x = DeleteVmImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVmImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVmImageResponse) ProtoMessage() {}
+
+func (x *DeleteVmImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVmImageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVmImageResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteVmImageResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type DownloadVmImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken   string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	AppInstanceId string `protobuf:"bytes,2,opt,name=appInstanceId,proto3" json:"appInstanceId,omitempty"`
+	HostIp        string `protobuf:"bytes,3,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+	ImageId       string `protobuf:"bytes,4,opt,name=imageId,proto3" json:"imageId,omitempty"`
+	ChunkNum      int32  `protobuf:"varint,5,opt,name=chunkNum,proto3" json:"chunkNum,omitempty"`
+}
+
+func (x *DownloadVmImageRequest) Reset() {
+	*x = DownloadVmImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadVmImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadVmImageRequest) ProtoMessage() {}
+
+func (x *DownloadVmImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadVmImageRequest.ProtoReflect.Descriptor instead.
+func (*DownloadVmImageRequest) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DownloadVmImageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *DownloadVmImageRequest) GetAppInstanceId() string {
+	if x != nil {
+		return x.AppInstanceId
+	}
+	return ""
+}
+
+func (x *DownloadVmImageRequest) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+func (x *DownloadVmImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *DownloadVmImageRequest) GetChunkNum() int32 {
+	if x != nil {
+		return x.ChunkNum
+	}
+	return 0
+}
+
+type DownloadVmImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *DownloadVmImageResponse) Reset() {
+	*x = DownloadVmImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadVmImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadVmImageResponse) ProtoMessage() {}
+
+func (x *DownloadVmImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadVmImageResponse.ProtoReflect.Descriptor instead.
+func (*DownloadVmImageResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DownloadVmImageResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UploadPackageRequest_AccessToken
+	//	*UploadPackageRequest_AppPackageId
+	//	*UploadPackageRequest_HostIp
+	//	*UploadPackageRequest_TenantId
+	//	*UploadPackageRequest_Package
+	Data isUploadPackageRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *UploadPackageRequest) Reset() {
+	*x = UploadPackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPackageRequest) ProtoMessage() {}
+
+func (x *UploadPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPackageRequest.ProtoReflect.Descriptor instead.
+func (*UploadPackageRequest) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (m *UploadPackageRequest) GetData() isUploadPackageRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadPackageRequest) GetAccessToken() string {
+	if x, ok := x.GetData().(*UploadPackageRequest_AccessToken); ok {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *UploadPackageRequest) GetAppPackageId() string {
+	if x, ok := x.GetData().(*UploadPackageRequest_AppPackageId); ok {
+		return x.AppPackageId
+	}
+	return ""
+}
+
+func (x *UploadPackageRequest) GetHostIp() string {
+	if x, ok := x.GetData().(*UploadPackageRequest_HostIp); ok {
+		return x.HostIp
+	}
+	return ""
+}
+
+func (x *UploadPackageRequest) GetTenantId() string {
+	if x, ok := x.GetData().(*UploadPackageRequest_TenantId); ok {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UploadPackageRequest) GetPackage() []byte {
+	if x, ok := x.GetData().(*UploadPackageRequest_Package); ok {
+		return x.Package
+	}
+	return nil
+}
+
+type isUploadPackageRequest_Data interface {
+	isUploadPackageRequest_Data()
+}
+
+type UploadPackageRequest_AccessToken struct {
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3,oneof"`
+}
+
+type UploadPackageRequest_AppPackageId struct {
+	AppPackageId string `protobuf:"bytes,2,opt,name=appPackageId,proto3,oneof"`
+}
+
+type UploadPackageRequest_HostIp struct {
+	HostIp string `protobuf:"bytes,3,opt,name=hostIp,proto3,oneof"`
+}
+
+type UploadPackageRequest_TenantId struct {
+	TenantId string `protobuf:"bytes,4,opt,name=tenantId,proto3,oneof"`
+}
+
+type UploadPackageRequest_Package struct {
+	Package []byte `protobuf:"bytes,5,opt,name=package,proto3,oneof"`
+}
+
+func (*UploadPackageRequest_AccessToken) isUploadPackageRequest_Data() {}
+
+func (*UploadPackageRequest_AppPackageId) isUploadPackageRequest_Data() {}
+
+func (*UploadPackageRequest_HostIp) isUploadPackageRequest_Data() {}
+
+func (*UploadPackageRequest_TenantId) isUploadPackageRequest_Data() {}
+
+func (*UploadPackageRequest_Package) isUploadPackageRequest_Data() {}
+
+type UploadPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UploadPackageResponse) Reset() {
+	*x = UploadPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPackageResponse) ProtoMessage() {}
+
+func (x *UploadPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPackageResponse.ProtoReflect.Descriptor instead.
+func (*UploadPackageResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UploadPackageResponse) GetStatus() string {
+	if x != nil {
 		return x.Status
 	}
 	return ""
@@ -1421,7 +2574,7 @@ type DeletePackageRequest struct {
 func (x *DeletePackageRequest) Reset() {
 	*x = DeletePackageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lcmservice_proto_msgTypes[22]
+		mi := &file_lcmservice_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1434,7 +2587,7 @@ func (x *DeletePackageRequest) String() string {
 func (*DeletePackageRequest) ProtoMessage() {}
 
 func (x *DeletePackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lcmservice_proto_msgTypes[22]
+	mi := &file_lcmservice_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,7 +2600,7 @@ func (x *DeletePackageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePackageRequest.ProtoReflect.Descriptor instead.
 func (*DeletePackageRequest) Descriptor() ([]byte, []int) {
-	return file_lcmservice_proto_rawDescGZIP(), []int{22}
+	return file_lcmservice_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DeletePackageRequest) GetAccessToken() string {
@@ -1489,7 +2642,7 @@ type DeletePackageResponse struct {
 func (x *DeletePackageResponse) Reset() {
 	*x = DeletePackageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lcmservice_proto_msgTypes[23]
+		mi := &file_lcmservice_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1502,7 +2655,7 @@ func (x *DeletePackageResponse) String() string {
 func (*DeletePackageResponse) ProtoMessage() {}
 
 func (x *DeletePackageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lcmservice_proto_msgTypes[23]
+	mi := &file_lcmservice_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,22 +2668,143 @@ func (x *DeletePackageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePackageResponse.ProtoReflect.Descriptor instead.
 func (*DeletePackageResponse) Descriptor() ([]byte, []int) {
-	return file_lcmservice_proto_rawDescGZIP(), []int{23}
+	return file_lcmservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeletePackageResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetCapabilitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+}
+
+func (x *GetCapabilitiesRequest) Reset() {
+	*x = GetCapabilitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCapabilitiesRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type GetCapabilitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []string `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	// rpc names supported by the plugin, e.g. ["instantiate", "terminate", "query", "createVmImage"]
+	DeploymentTypes []string `protobuf:"bytes,2,rep,name=deploymentTypes,proto3" json:"deploymentTypes,omitempty"`
+	// e.g. ["container"] or ["vm"]
+	MaxPackageSize int64 `protobuf:"varint,3,opt,name=maxPackageSize,proto3" json:"maxPackageSize,omitempty"`
+	// maximum size of app package in bytes, 0 when not limited
+	MaxConfigFileSize int64 `protobuf:"varint,4,opt,name=maxConfigFileSize,proto3" json:"maxConfigFileSize,omitempty"` // maximum size of host config file in bytes, 0 when not limited
+}
+
+func (x *GetCapabilitiesResponse) Reset() {
+	*x = GetCapabilitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lcmservice_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lcmservice_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_lcmservice_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetCapabilitiesResponse) GetOperations() []string {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetDeploymentTypes() []string {
+	if x != nil {
+		return x.DeploymentTypes
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetMaxPackageSize() int64 {
+	if x != nil {
+		return x.MaxPackageSize
+	}
+	return 0
 }
 
-func (x *DeletePackageResponse) GetStatus() string {
+func (x *GetCapabilitiesResponse) GetMaxConfigFileSize() int64 {
 	if x != nil {
-		return x.Status
+		return x.MaxConfigFileSize
 	}
-	return ""
+	return 0
 }
 
 var File_lcmservice_proto protoreflect.FileDescriptor
 
 var file_lcmservice_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0a, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xd4,
-	0x01, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x74, 0x6f, 0x12, 0x0a, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xda,
+	0x02, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
@@ -1543,72 +2817,226 @@ var file_lcmservice_proto_rawDesc = []byte{
 	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
 	0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x02, 0x61, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x73, 0x6b, 0x22, 0x2d, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x72, 0x0a, 0x10, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70,
-	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x22, 0x2b, 0x0a, 0x11, 0x54, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6e, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x09, 0x52, 0x02, 0x73, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x46,
+	0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x45, 0x0a, 0x13, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x10, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x22, 0x2b, 0x0a, 0x11, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70,
+	0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x61, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x73, 0x6b, 0x22, 0x29, 0x0a, 0x0f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
 	0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a,
 	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
-	0x6f, 0x73, 0x74, 0x49, 0x70, 0x22, 0x2b, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x7a, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x66, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x6f,
-	0x73, 0x74, 0x49, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x68, 0x6f,
-	0x73, 0x74, 0x49, 0x70, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
-	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2b,
-	0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x66, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4c, 0x0a, 0x10, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x66, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x22, 0x2b, 0x0a, 0x11, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x43, 0x66, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x68, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x61,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x73,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x73, 0x6b, 0x22, 0x8a, 0x01, 0x0a, 0x0c,
+	0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x63, 0x61, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x8f, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a,
+	0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0x30, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73,
+	0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49,
+	0x70, 0x22, 0x33, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x77, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
-	0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x22,
-	0x34, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x22, 0x30, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x22, 0x70, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x22, 0x2d, 0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x41, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x5e, 0x0a, 0x0d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x7a, 0x0a, 0x10, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x43, 0x66, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x20, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2b, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x66, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x4c, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x66, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74,
+	0x49, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70,
+	0x22, 0x2b, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x66, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa9, 0x01,
+	0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x34, 0x0a, 0x16, 0x57, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x72, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70,
+	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73,
+	0x74, 0x49, 0x70, 0x22, 0x2f, 0x0a, 0x11, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x76, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x6d, 0x49,
+	0x64, 0x22, 0x33, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
 	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
 	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x12,
-	0x0a, 0x04, 0x76, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x6d,
-	0x49, 0x64, 0x22, 0x33, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22,
+	0x33, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
 	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
@@ -1616,129 +3044,165 @@ var file_lcmservice_proto_rawDesc = []byte{
 	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49,
 	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12,
 	0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x14, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01,
-	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64,
-	0x22, 0x33, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74,
-	0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70,
-	0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
-	0x75, 0x6e, 0x6b, 0x4e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68,
-	0x75, 0x6e, 0x6b, 0x4e, 0x75, 0x6d, 0x22, 0x33, 0x0a, 0x17, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x14,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x0c, 0x61, 0x70, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x1c, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2f, 0x0a, 0x15, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x14,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70,
-	0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x61, 0x70, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x2f,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32,
-	0x93, 0x05, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x4c, 0x43, 0x4d, 0x12, 0x50, 0x0a, 0x0b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6c, 0x63, 0x6d, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x63, 0x6d, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x09,
-	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6c, 0x63, 0x6d, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x18, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x63,
-	0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x66, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x66, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4d, 0x0a, 0x0c, 0x72, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x6c, 0x63, 0x6d, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x66, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x66, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b,
-	0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6c, 0x63, 0x6d,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
-	0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x56, 0x0a,
-	0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x20,
-	0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xee, 0x02, 0x0a, 0x07, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x56, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0c, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x6c, 0x63, 0x6d, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6d, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x63, 0x6d,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6d, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56,
-	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x20, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x6c, 0x63, 0x6d, 0x73,
+	0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x4e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x4e, 0x75, 0x6d, 0x22, 0x33, 0x0a, 0x17, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x14, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0c, 0x61, 0x70, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x1c, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2f, 0x0a, 0x15, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x70, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x2f, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb9, 0x01, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x32, 0xe8, 0x0a, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x4c, 0x43,
+	0x4d, 0x12, 0x50, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x12, 0x1e, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x09, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x6c, 0x63, 0x6d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x1b, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44,
+	0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x6c, 0x63, 0x6d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x66, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x66, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4d, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x66, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x66, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x63, 0x6d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x12, 0x20, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x56, 0x0a, 0x0d, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x63, 0x6d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c,
+	0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5c, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e,
+	0x67, 0x12, 0x21, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x11, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x24, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55,
+	0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1f,
+	0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x32, 0xee, 0x02, 0x0a, 0x07, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x56, 0x0a,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x20,
+	0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x63,
+	0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x6d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x6d, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x63, 0x6d, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x56,
-	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x6c, 0x63, 0x6d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x56, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1753,63 +3217,101 @@ func file_lcmservice_proto_rawDescGZIP() []byte {
 	return file_lcmservice_proto_rawDescData
 }
 
-var file_lcmservice_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_lcmservice_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_lcmservice_proto_goTypes = []interface{}{
-	(*InstantiateRequest)(nil),      // 0: lcmservice.InstantiateRequest
-	(*InstantiateResponse)(nil),     // 1: lcmservice.InstantiateResponse
-	(*TerminateRequest)(nil),        // 2: lcmservice.TerminateRequest
-	(*TerminateResponse)(nil),       // 3: lcmservice.TerminateResponse
-	(*QueryRequest)(nil),            // 4: lcmservice.QueryRequest
-	(*QueryResponse)(nil),           // 5: lcmservice.QueryResponse
-	(*UploadCfgRequest)(nil),        // 6: lcmservice.UploadCfgRequest
-	(*UploadCfgResponse)(nil),       // 7: lcmservice.UploadCfgResponse
-	(*RemoveCfgRequest)(nil),        // 8: lcmservice.RemoveCfgRequest
-	(*RemoveCfgResponse)(nil),       // 9: lcmservice.RemoveCfgResponse
-	(*WorkloadEventsRequest)(nil),   // 10: lcmservice.WorkloadEventsRequest
-	(*WorkloadEventsResponse)(nil),  // 11: lcmservice.WorkloadEventsResponse
-	(*CreateVmImageRequest)(nil),    // 12: lcmservice.CreateVmImageRequest
-	(*CreateVmImageResponse)(nil),   // 13: lcmservice.CreateVmImageResponse
-	(*QueryVmImageRequest)(nil),     // 14: lcmservice.QueryVmImageRequest
-	(*QueryVmImageResponse)(nil),    // 15: lcmservice.QueryVmImageResponse
-	(*DeleteVmImageRequest)(nil),    // 16: lcmservice.DeleteVmImageRequest
-	(*DeleteVmImageResponse)(nil),   // 17: lcmservice.DeleteVmImageResponse
-	(*DownloadVmImageRequest)(nil),  // 18: lcmservice.DownloadVmImageRequest
-	(*DownloadVmImageResponse)(nil), // 19: lcmservice.DownloadVmImageResponse
-	(*UploadPackageRequest)(nil),    // 20: lcmservice.UploadPackageRequest
-	(*UploadPackageResponse)(nil),   // 21: lcmservice.UploadPackageResponse
-	(*DeletePackageRequest)(nil),    // 22: lcmservice.DeletePackageRequest
-	(*DeletePackageResponse)(nil),   // 23: lcmservice.DeletePackageResponse
+	(*InstantiateRequest)(nil),        // 0: lcmservice.InstantiateRequest
+	(*InstantiateResponse)(nil),       // 1: lcmservice.InstantiateResponse
+	(*TerminateRequest)(nil),          // 2: lcmservice.TerminateRequest
+	(*TerminateResponse)(nil),         // 3: lcmservice.TerminateResponse
+	(*UpgradeRequest)(nil),            // 4: lcmservice.UpgradeRequest
+	(*UpgradeResponse)(nil),           // 5: lcmservice.UpgradeResponse
+	(*RollbackRequest)(nil),           // 6: lcmservice.RollbackRequest
+	(*RollbackResponse)(nil),          // 7: lcmservice.RollbackResponse
+	(*ScaleRequest)(nil),              // 8: lcmservice.ScaleRequest
+	(*ScaleResponse)(nil),             // 9: lcmservice.ScaleResponse
+	(*SetAutoscalingRequest)(nil),     // 10: lcmservice.SetAutoscalingRequest
+	(*SetAutoscalingResponse)(nil),    // 11: lcmservice.SetAutoscalingResponse
+	(*DeleteAutoscalingRequest)(nil),  // 12: lcmservice.DeleteAutoscalingRequest
+	(*DeleteAutoscalingResponse)(nil), // 13: lcmservice.DeleteAutoscalingResponse
+	(*WorkloadLogsRequest)(nil),       // 14: lcmservice.WorkloadLogsRequest
+	(*WorkloadLogsResponse)(nil),      // 15: lcmservice.WorkloadLogsResponse
+	(*HistoryRequest)(nil),            // 16: lcmservice.HistoryRequest
+	(*HistoryResponse)(nil),           // 17: lcmservice.HistoryResponse
+	(*QueryRequest)(nil),              // 18: lcmservice.QueryRequest
+	(*HealthSummary)(nil),             // 19: lcmservice.HealthSummary
+	(*QueryResponse)(nil),             // 20: lcmservice.QueryResponse
+	(*UploadCfgRequest)(nil),          // 21: lcmservice.UploadCfgRequest
+	(*UploadCfgResponse)(nil),         // 22: lcmservice.UploadCfgResponse
+	(*RemoveCfgRequest)(nil),          // 23: lcmservice.RemoveCfgRequest
+	(*RemoveCfgResponse)(nil),         // 24: lcmservice.RemoveCfgResponse
+	(*WorkloadEventsRequest)(nil),     // 25: lcmservice.WorkloadEventsRequest
+	(*WorkloadEventsResponse)(nil),    // 26: lcmservice.WorkloadEventsResponse
+	(*EndpointsRequest)(nil),          // 27: lcmservice.EndpointsRequest
+	(*EndpointsResponse)(nil),         // 28: lcmservice.EndpointsResponse
+	(*CreateVmImageRequest)(nil),      // 29: lcmservice.CreateVmImageRequest
+	(*CreateVmImageResponse)(nil),     // 30: lcmservice.CreateVmImageResponse
+	(*QueryVmImageRequest)(nil),       // 31: lcmservice.QueryVmImageRequest
+	(*QueryVmImageResponse)(nil),      // 32: lcmservice.QueryVmImageResponse
+	(*DeleteVmImageRequest)(nil),      // 33: lcmservice.DeleteVmImageRequest
+	(*DeleteVmImageResponse)(nil),     // 34: lcmservice.DeleteVmImageResponse
+	(*DownloadVmImageRequest)(nil),    // 35: lcmservice.DownloadVmImageRequest
+	(*DownloadVmImageResponse)(nil),   // 36: lcmservice.DownloadVmImageResponse
+	(*UploadPackageRequest)(nil),      // 37: lcmservice.UploadPackageRequest
+	(*UploadPackageResponse)(nil),     // 38: lcmservice.UploadPackageResponse
+	(*DeletePackageRequest)(nil),      // 39: lcmservice.DeletePackageRequest
+	(*DeletePackageResponse)(nil),     // 40: lcmservice.DeletePackageResponse
+	(*GetCapabilitiesRequest)(nil),    // 41: lcmservice.GetCapabilitiesRequest
+	(*GetCapabilitiesResponse)(nil),   // 42: lcmservice.GetCapabilitiesResponse
 }
 var file_lcmservice_proto_depIdxs = []int32{
-	0,  // 0: lcmservice.AppLCM.instantiate:input_type -> lcmservice.InstantiateRequest
-	2,  // 1: lcmservice.AppLCM.terminate:input_type -> lcmservice.TerminateRequest
-	4,  // 2: lcmservice.AppLCM.query:input_type -> lcmservice.QueryRequest
-	6,  // 3: lcmservice.AppLCM.uploadConfig:input_type -> lcmservice.UploadCfgRequest
-	8,  // 4: lcmservice.AppLCM.removeConfig:input_type -> lcmservice.RemoveCfgRequest
-	10, // 5: lcmservice.AppLCM.workloadEvents:input_type -> lcmservice.WorkloadEventsRequest
-	20, // 6: lcmservice.AppLCM.uploadPackage:input_type -> lcmservice.UploadPackageRequest
-	22, // 7: lcmservice.AppLCM.deletePackage:input_type -> lcmservice.DeletePackageRequest
-	12, // 8: lcmservice.VmImage.createVmImage:input_type -> lcmservice.CreateVmImageRequest
-	14, // 9: lcmservice.VmImage.queryVmImage:input_type -> lcmservice.QueryVmImageRequest
-	16, // 10: lcmservice.VmImage.deleteVmImage:input_type -> lcmservice.DeleteVmImageRequest
-	18, // 11: lcmservice.VmImage.downloadVmImage:input_type -> lcmservice.DownloadVmImageRequest
-	1,  // 12: lcmservice.AppLCM.instantiate:output_type -> lcmservice.InstantiateResponse
-	3,  // 13: lcmservice.AppLCM.terminate:output_type -> lcmservice.TerminateResponse
-	5,  // 14: lcmservice.AppLCM.query:output_type -> lcmservice.QueryResponse
-	7,  // 15: lcmservice.AppLCM.uploadConfig:output_type -> lcmservice.UploadCfgResponse
-	9,  // 16: lcmservice.AppLCM.removeConfig:output_type -> lcmservice.RemoveCfgResponse
-	11, // 17: lcmservice.AppLCM.workloadEvents:output_type -> lcmservice.WorkloadEventsResponse
-	21, // 18: lcmservice.AppLCM.uploadPackage:output_type -> lcmservice.UploadPackageResponse
-	23, // 19: lcmservice.AppLCM.deletePackage:output_type -> lcmservice.DeletePackageResponse
-	13, // 20: lcmservice.VmImage.createVmImage:output_type -> lcmservice.CreateVmImageResponse
-	15, // 21: lcmservice.VmImage.queryVmImage:output_type -> lcmservice.QueryVmImageResponse
-	17, // 22: lcmservice.VmImage.deleteVmImage:output_type -> lcmservice.DeleteVmImageResponse
-	19, // 23: lcmservice.VmImage.downloadVmImage:output_type -> lcmservice.DownloadVmImageResponse
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	19, // 0: lcmservice.QueryResponse.health:type_name -> lcmservice.HealthSummary
+	0,  // 1: lcmservice.AppLCM.instantiate:input_type -> lcmservice.InstantiateRequest
+	2,  // 2: lcmservice.AppLCM.terminate:input_type -> lcmservice.TerminateRequest
+	18, // 3: lcmservice.AppLCM.query:input_type -> lcmservice.QueryRequest
+	4,  // 4: lcmservice.AppLCM.upgrade:input_type -> lcmservice.UpgradeRequest
+	6,  // 5: lcmservice.AppLCM.rollback:input_type -> lcmservice.RollbackRequest
+	16, // 6: lcmservice.AppLCM.history:input_type -> lcmservice.HistoryRequest
+	21, // 7: lcmservice.AppLCM.uploadConfig:input_type -> lcmservice.UploadCfgRequest
+	23, // 8: lcmservice.AppLCM.removeConfig:input_type -> lcmservice.RemoveCfgRequest
+	25, // 9: lcmservice.AppLCM.workloadEvents:input_type -> lcmservice.WorkloadEventsRequest
+	37, // 10: lcmservice.AppLCM.uploadPackage:input_type -> lcmservice.UploadPackageRequest
+	39, // 11: lcmservice.AppLCM.deletePackage:input_type -> lcmservice.DeletePackageRequest
+	41, // 12: lcmservice.AppLCM.getCapabilities:input_type -> lcmservice.GetCapabilitiesRequest
+	8,  // 13: lcmservice.AppLCM.scale:input_type -> lcmservice.ScaleRequest
+	10, // 14: lcmservice.AppLCM.setAutoscaling:input_type -> lcmservice.SetAutoscalingRequest
+	12, // 15: lcmservice.AppLCM.deleteAutoscaling:input_type -> lcmservice.DeleteAutoscalingRequest
+	14, // 16: lcmservice.AppLCM.workloadLogs:input_type -> lcmservice.WorkloadLogsRequest
+	27, // 17: lcmservice.AppLCM.endpoints:input_type -> lcmservice.EndpointsRequest
+	29, // 18: lcmservice.VmImage.createVmImage:input_type -> lcmservice.CreateVmImageRequest
+	31, // 19: lcmservice.VmImage.queryVmImage:input_type -> lcmservice.QueryVmImageRequest
+	33, // 20: lcmservice.VmImage.deleteVmImage:input_type -> lcmservice.DeleteVmImageRequest
+	35, // 21: lcmservice.VmImage.downloadVmImage:input_type -> lcmservice.DownloadVmImageRequest
+	1,  // 22: lcmservice.AppLCM.instantiate:output_type -> lcmservice.InstantiateResponse
+	3,  // 23: lcmservice.AppLCM.terminate:output_type -> lcmservice.TerminateResponse
+	20, // 24: lcmservice.AppLCM.query:output_type -> lcmservice.QueryResponse
+	5,  // 25: lcmservice.AppLCM.upgrade:output_type -> lcmservice.UpgradeResponse
+	7,  // 26: lcmservice.AppLCM.rollback:output_type -> lcmservice.RollbackResponse
+	17, // 27: lcmservice.AppLCM.history:output_type -> lcmservice.HistoryResponse
+	22, // 28: lcmservice.AppLCM.uploadConfig:output_type -> lcmservice.UploadCfgResponse
+	24, // 29: lcmservice.AppLCM.removeConfig:output_type -> lcmservice.RemoveCfgResponse
+	26, // 30: lcmservice.AppLCM.workloadEvents:output_type -> lcmservice.WorkloadEventsResponse
+	38, // 31: lcmservice.AppLCM.uploadPackage:output_type -> lcmservice.UploadPackageResponse
+	40, // 32: lcmservice.AppLCM.deletePackage:output_type -> lcmservice.DeletePackageResponse
+	42, // 33: lcmservice.AppLCM.getCapabilities:output_type -> lcmservice.GetCapabilitiesResponse
+	9,  // 34: lcmservice.AppLCM.scale:output_type -> lcmservice.ScaleResponse
+	11, // 35: lcmservice.AppLCM.setAutoscaling:output_type -> lcmservice.SetAutoscalingResponse
+	13, // 36: lcmservice.AppLCM.deleteAutoscaling:output_type -> lcmservice.DeleteAutoscalingResponse
+	15, // 37: lcmservice.AppLCM.workloadLogs:output_type -> lcmservice.WorkloadLogsResponse
+	28, // 38: lcmservice.AppLCM.endpoints:output_type -> lcmservice.EndpointsResponse
+	30, // 39: lcmservice.VmImage.createVmImage:output_type -> lcmservice.CreateVmImageResponse
+	32, // 40: lcmservice.VmImage.queryVmImage:output_type -> lcmservice.QueryVmImageResponse
+	34, // 41: lcmservice.VmImage.deleteVmImage:output_type -> lcmservice.DeleteVmImageResponse
+	36, // 42: lcmservice.VmImage.downloadVmImage:output_type -> lcmservice.DownloadVmImageResponse
+	22, // [22:43] is the sub-list for method output_type
+	1,  // [1:22] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_lcmservice_proto_init() }
@@ -1867,7 +3369,7 @@ func file_lcmservice_proto_init() {
 			}
 		}
 		file_lcmservice_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequest); i {
+			switch v := v.(*UpgradeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1879,7 +3381,7 @@ func file_lcmservice_proto_init() {
 			}
 		}
 		file_lcmservice_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryResponse); i {
+			switch v := v.(*UpgradeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1891,7 +3393,7 @@ func file_lcmservice_proto_init() {
 			}
 		}
 		file_lcmservice_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadCfgRequest); i {
+			switch v := v.(*RollbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1903,7 +3405,7 @@ func file_lcmservice_proto_init() {
 			}
 		}
 		file_lcmservice_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadCfgResponse); i {
+			switch v := v.(*RollbackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1915,7 +3417,7 @@ func file_lcmservice_proto_init() {
 			}
 		}
 		file_lcmservice_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCfgRequest); i {
+			switch v := v.(*ScaleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1927,6 +3429,186 @@ func file_lcmservice_proto_init() {
 			}
 		}
 		file_lcmservice_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScaleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoscalingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoscalingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAutoscalingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAutoscalingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCfgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCfgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCfgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveCfgResponse); i {
 			case 0:
 				return &v.state
@@ -1938,8 +3620,32 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkloadEventsRequest); i {
+		file_lcmservice_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndpointsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1950,8 +3656,8 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkloadEventsResponse); i {
+		file_lcmservice_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndpointsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1962,7 +3668,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateVmImageRequest); i {
 			case 0:
 				return &v.state
@@ -1974,7 +3680,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateVmImageResponse); i {
 			case 0:
 				return &v.state
@@ -1986,7 +3692,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryVmImageRequest); i {
 			case 0:
 				return &v.state
@@ -1998,7 +3704,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryVmImageResponse); i {
 			case 0:
 				return &v.state
@@ -2010,7 +3716,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteVmImageRequest); i {
 			case 0:
 				return &v.state
@@ -2022,7 +3728,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteVmImageResponse); i {
 			case 0:
 				return &v.state
@@ -2034,7 +3740,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadVmImageRequest); i {
 			case 0:
 				return &v.state
@@ -2046,7 +3752,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadVmImageResponse); i {
 			case 0:
 				return &v.state
@@ -2058,7 +3764,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadPackageRequest); i {
 			case 0:
 				return &v.state
@@ -2070,7 +3776,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadPackageResponse); i {
 			case 0:
 				return &v.state
@@ -2082,7 +3788,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeletePackageRequest); i {
 			case 0:
 				return &v.state
@@ -2094,7 +3800,7 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
-		file_lcmservice_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_lcmservice_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeletePackageResponse); i {
 			case 0:
 				return &v.state
@@ -2106,13 +3812,37 @@ func file_lcmservice_proto_init() {
 				return nil
 			}
 		}
+		file_lcmservice_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCapabilitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lcmservice_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCapabilitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_lcmservice_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_lcmservice_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*UploadCfgRequest_AccessToken)(nil),
 		(*UploadCfgRequest_HostIp)(nil),
 		(*UploadCfgRequest_ConfigFile)(nil),
 	}
-	file_lcmservice_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_lcmservice_proto_msgTypes[37].OneofWrappers = []interface{}{
 		(*UploadPackageRequest_AccessToken)(nil),
 		(*UploadPackageRequest_AppPackageId)(nil),
 		(*UploadPackageRequest_HostIp)(nil),
@@ -2125,7 +3855,7 @@ func file_lcmservice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lcmservice_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -2154,11 +3884,20 @@ type AppLCMClient interface {
 	Instantiate(ctx context.Context, in *InstantiateRequest, opts ...grpc.CallOption) (*InstantiateResponse, error)
 	Terminate(ctx context.Context, in *TerminateRequest, opts ...grpc.CallOption) (*TerminateResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	Upgrade(ctx context.Context, in *UpgradeRequest, opts ...grpc.CallOption) (*UpgradeResponse, error)
+	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
 	UploadConfig(ctx context.Context, opts ...grpc.CallOption) (AppLCM_UploadConfigClient, error)
 	RemoveConfig(ctx context.Context, in *RemoveCfgRequest, opts ...grpc.CallOption) (*RemoveCfgResponse, error)
 	WorkloadEvents(ctx context.Context, in *WorkloadEventsRequest, opts ...grpc.CallOption) (*WorkloadEventsResponse, error)
 	UploadPackage(ctx context.Context, opts ...grpc.CallOption) (AppLCM_UploadPackageClient, error)
 	DeletePackage(ctx context.Context, in *DeletePackageRequest, opts ...grpc.CallOption) (*DeletePackageResponse, error)
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
+	Scale(ctx context.Context, in *ScaleRequest, opts ...grpc.CallOption) (*ScaleResponse, error)
+	SetAutoscaling(ctx context.Context, in *SetAutoscalingRequest, opts ...grpc.CallOption) (*SetAutoscalingResponse, error)
+	DeleteAutoscaling(ctx context.Context, in *DeleteAutoscalingRequest, opts ...grpc.CallOption) (*DeleteAutoscalingResponse, error)
+	WorkloadLogs(ctx context.Context, in *WorkloadLogsRequest, opts ...grpc.CallOption) (AppLCM_WorkloadLogsClient, error)
+	Endpoints(ctx context.Context, in *EndpointsRequest, opts ...grpc.CallOption) (*EndpointsResponse, error)
 }
 
 type appLCMClient struct {
@@ -2196,6 +3935,33 @@ func (c *appLCMClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *appLCMClient) Upgrade(ctx context.Context, in *UpgradeRequest, opts ...grpc.CallOption) (*UpgradeResponse, error) {
+	out := new(UpgradeResponse)
+	err := c.cc.Invoke(ctx, "/lcmservice.AppLCM/upgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appLCMClient) Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error) {
+	out := new(RollbackResponse)
+	err := c.cc.Invoke(ctx, "/lcmservice.AppLCM/rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appLCMClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, "/lcmservice.AppLCM/history", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appLCMClient) UploadConfig(ctx context.Context, opts ...grpc.CallOption) (AppLCM_UploadConfigClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_AppLCM_serviceDesc.Streams[0], "/lcmservice.AppLCM/uploadConfig", opts...)
 	if err != nil {
@@ -2291,16 +4057,102 @@ func (c *appLCMClient) DeletePackage(ctx context.Context, in *DeletePackageReque
 	return out, nil
 }
 
+func (c *appLCMClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/lcmservice.AppLCM/getCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appLCMClient) Scale(ctx context.Context, in *ScaleRequest, opts ...grpc.CallOption) (*ScaleResponse, error) {
+	out := new(ScaleResponse)
+	err := c.cc.Invoke(ctx, "/lcmservice.AppLCM/scale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appLCMClient) SetAutoscaling(ctx context.Context, in *SetAutoscalingRequest, opts ...grpc.CallOption) (*SetAutoscalingResponse, error) {
+	out := new(SetAutoscalingResponse)
+	err := c.cc.Invoke(ctx, "/lcmservice.AppLCM/setAutoscaling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appLCMClient) DeleteAutoscaling(ctx context.Context, in *DeleteAutoscalingRequest, opts ...grpc.CallOption) (*DeleteAutoscalingResponse, error) {
+	out := new(DeleteAutoscalingResponse)
+	err := c.cc.Invoke(ctx, "/lcmservice.AppLCM/deleteAutoscaling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appLCMClient) WorkloadLogs(ctx context.Context, in *WorkloadLogsRequest, opts ...grpc.CallOption) (AppLCM_WorkloadLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppLCM_serviceDesc.Streams[2], "/lcmservice.AppLCM/workloadLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appLCMWorkloadLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppLCM_WorkloadLogsClient interface {
+	Recv() (*WorkloadLogsResponse, error)
+	grpc.ClientStream
+}
+
+type appLCMWorkloadLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *appLCMWorkloadLogsClient) Recv() (*WorkloadLogsResponse, error) {
+	m := new(WorkloadLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *appLCMClient) Endpoints(ctx context.Context, in *EndpointsRequest, opts ...grpc.CallOption) (*EndpointsResponse, error) {
+	out := new(EndpointsResponse)
+	err := c.cc.Invoke(ctx, "/lcmservice.AppLCM/endpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppLCMServer is the server API for AppLCM service.
 type AppLCMServer interface {
 	Instantiate(context.Context, *InstantiateRequest) (*InstantiateResponse, error)
 	Terminate(context.Context, *TerminateRequest) (*TerminateResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error)
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
 	UploadConfig(AppLCM_UploadConfigServer) error
 	RemoveConfig(context.Context, *RemoveCfgRequest) (*RemoveCfgResponse, error)
 	WorkloadEvents(context.Context, *WorkloadEventsRequest) (*WorkloadEventsResponse, error)
 	UploadPackage(AppLCM_UploadPackageServer) error
 	DeletePackage(context.Context, *DeletePackageRequest) (*DeletePackageResponse, error)
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
+	Scale(context.Context, *ScaleRequest) (*ScaleResponse, error)
+	SetAutoscaling(context.Context, *SetAutoscalingRequest) (*SetAutoscalingResponse, error)
+	DeleteAutoscaling(context.Context, *DeleteAutoscalingRequest) (*DeleteAutoscalingResponse, error)
+	WorkloadLogs(*WorkloadLogsRequest, AppLCM_WorkloadLogsServer) error
+	Endpoints(context.Context, *EndpointsRequest) (*EndpointsResponse, error)
 }
 
 // UnimplementedAppLCMServer can be embedded to have forward compatible implementations.
@@ -2316,6 +4168,15 @@ func (*UnimplementedAppLCMServer) Terminate(context.Context, *TerminateRequest)
 func (*UnimplementedAppLCMServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (*UnimplementedAppLCMServer) Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upgrade not implemented")
+}
+func (*UnimplementedAppLCMServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (*UnimplementedAppLCMServer) History(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
 func (*UnimplementedAppLCMServer) UploadConfig(AppLCM_UploadConfigServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadConfig not implemented")
 }
@@ -2331,6 +4192,24 @@ func (*UnimplementedAppLCMServer) UploadPackage(AppLCM_UploadPackageServer) erro
 func (*UnimplementedAppLCMServer) DeletePackage(context.Context, *DeletePackageRequest) (*DeletePackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePackage not implemented")
 }
+func (*UnimplementedAppLCMServer) GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (*UnimplementedAppLCMServer) Scale(context.Context, *ScaleRequest) (*ScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scale not implemented")
+}
+func (*UnimplementedAppLCMServer) SetAutoscaling(context.Context, *SetAutoscalingRequest) (*SetAutoscalingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoscaling not implemented")
+}
+func (*UnimplementedAppLCMServer) DeleteAutoscaling(context.Context, *DeleteAutoscalingRequest) (*DeleteAutoscalingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAutoscaling not implemented")
+}
+func (*UnimplementedAppLCMServer) WorkloadLogs(*WorkloadLogsRequest, AppLCM_WorkloadLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WorkloadLogs not implemented")
+}
+func (*UnimplementedAppLCMServer) Endpoints(context.Context, *EndpointsRequest) (*EndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Endpoints not implemented")
+}
 
 func RegisterAppLCMServer(s *grpc.Server, srv AppLCMServer) {
 	s.RegisterService(&_AppLCM_serviceDesc, srv)
@@ -2390,6 +4269,60 @@ func _AppLCM_Query_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppLCM_Upgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppLCMServer).Upgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lcmservice.AppLCM/Upgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppLCMServer).Upgrade(ctx, req.(*UpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppLCM_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppLCMServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lcmservice.AppLCM/Rollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppLCMServer).Rollback(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppLCM_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppLCMServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lcmservice.AppLCM/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppLCMServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppLCM_UploadConfig_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(AppLCMServer).UploadConfig(&appLCMUploadConfigServer{stream})
 }
@@ -2496,6 +4429,117 @@ func _AppLCM_DeletePackage_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppLCM_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppLCMServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lcmservice.AppLCM/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppLCMServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppLCM_Scale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppLCMServer).Scale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lcmservice.AppLCM/Scale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppLCMServer).Scale(ctx, req.(*ScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppLCM_SetAutoscaling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoscalingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppLCMServer).SetAutoscaling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lcmservice.AppLCM/SetAutoscaling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppLCMServer).SetAutoscaling(ctx, req.(*SetAutoscalingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppLCM_DeleteAutoscaling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAutoscalingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppLCMServer).DeleteAutoscaling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lcmservice.AppLCM/DeleteAutoscaling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppLCMServer).DeleteAutoscaling(ctx, req.(*DeleteAutoscalingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppLCM_WorkloadLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WorkloadLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppLCMServer).WorkloadLogs(m, &appLCMWorkloadLogsServer{stream})
+}
+
+type AppLCM_WorkloadLogsServer interface {
+	Send(*WorkloadLogsResponse) error
+	grpc.ServerStream
+}
+
+type appLCMWorkloadLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *appLCMWorkloadLogsServer) Send(m *WorkloadLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AppLCM_Endpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppLCMServer).Endpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lcmservice.AppLCM/Endpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppLCMServer).Endpoints(ctx, req.(*EndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AppLCM_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lcmservice.AppLCM",
 	HandlerType: (*AppLCMServer)(nil),
@@ -2512,6 +4556,18 @@ var _AppLCM_serviceDesc = grpc.ServiceDesc{
 			MethodName: "query",
 			Handler:    _AppLCM_Query_Handler,
 		},
+		{
+			MethodName: "upgrade",
+			Handler:    _AppLCM_Upgrade_Handler,
+		},
+		{
+			MethodName: "rollback",
+			Handler:    _AppLCM_Rollback_Handler,
+		},
+		{
+			MethodName: "history",
+			Handler:    _AppLCM_History_Handler,
+		},
 		{
 			MethodName: "removeConfig",
 			Handler:    _AppLCM_RemoveConfig_Handler,
@@ -2524,6 +4580,26 @@ var _AppLCM_serviceDesc = grpc.ServiceDesc{
 			MethodName: "deletePackage",
 			Handler:    _AppLCM_DeletePackage_Handler,
 		},
+		{
+			MethodName: "getCapabilities",
+			Handler:    _AppLCM_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "scale",
+			Handler:    _AppLCM_Scale_Handler,
+		},
+		{
+			MethodName: "setAutoscaling",
+			Handler:    _AppLCM_SetAutoscaling_Handler,
+		},
+		{
+			MethodName: "deleteAutoscaling",
+			Handler:    _AppLCM_DeleteAutoscaling_Handler,
+		},
+		{
+			MethodName: "endpoints",
+			Handler:    _AppLCM_Endpoints_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2536,6 +4612,11 @@ var _AppLCM_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AppLCM_UploadPackage_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "workloadLogs",
+			Handler:       _AppLCM_WorkloadLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "lcmservice.proto",
 }
@@ -18,6 +18,7 @@ package models
 
 import (
 	"github.com/astaxie/beego/orm"
+	"lcmcontroller/pkg/csar"
 	"time"
 )
 
@@ -33,6 +34,9 @@ func init() {
 	orm.RegisterModel(new(AppPackageHostRecord))
 	orm.RegisterModel(new(AppPackageStaleRec))
 	orm.RegisterModel(new(AppPackageHostStaleRec))
+	orm.RegisterModel(new(LcmOperation))
+	orm.RegisterModel(new(PackageUploadSession))
+	orm.RegisterModel(new(PluginRecord))
 }
 
 // MEC host record
@@ -67,16 +71,18 @@ type MecHwCapability struct {
 
 // Application info record
 type AppInfoRecord struct {
-	AppInstanceId string    `orm:"pk"`
-	CreateTime    time.Time `orm:"auto_now_add;type(datetime)"`
-	MecHost       string
-	DeployType    string
-	TenantId      string
-	AppPackageId  string
-	AppName       string
-	Origin        string
-	SyncStatus    bool
-	MecHostRec    *MecHost `orm:"rel(fk)"` // RelForeignKey relation
+	AppInstanceId     string    `orm:"pk"`
+	CreateTime        time.Time `orm:"auto_now_add;type(datetime)"`
+	MecHost           string
+	DeployType        string
+	TenantId          string
+	AppPackageId      string
+	AppName           string
+	Origin            string
+	SyncStatus        bool
+	InstanceState     string
+	AutoscalingPolicy string   `orm:"type(text)"`
+	MecHostRec        *MecHost `orm:"rel(fk)"` // RelForeignKey relation
 }
 
 
@@ -91,6 +97,27 @@ type AppInfoRec struct {
 	AppName       string	`json:"appName"`
 	Origin        string	`json:"origin"`
 	SyncStatus    bool      `json:"syncStatus"`
+	InstanceState string    `json:"instanceState"`
+	Health        *HealthSummary `orm:"-" json:"health,omitempty"`
+}
+
+// Revision of release history reported by the plugin
+type ReleaseRevision struct {
+	Revision int32  `json:"revision"`
+	Status   string `json:"status"`
+}
+
+// Package and ak sk of the revision restored by rollback, empty when not known by the plugin
+type RestoredRevision struct {
+	PackageId string
+	Ak        string
+	Sk        string
+}
+
+// Health summary of app instance, status is Healthy, Progressing, Degraded or Failed
+type HealthSummary struct {
+	Status  string   `json:"status"`
+	Reasons []string `json:"reasons,omitempty"`
 }
 
 // App instance updated records
@@ -244,6 +271,15 @@ type CreateVimRequest struct {
 	VmId string `json:"vmId"`
 }
 
+// VM image info as returned by plugin, image size is only reported by some plugins
+type VmImageInfo struct {
+	ImageId     string `json:"imageId"`
+	Status      string `json:"status"`
+	SumChunkNum int64  `json:"sumChunkNum"`
+	ChunkSize   int64  `json:"chunkSize"`
+	ImageSize   int64  `json:"imageSize"`
+}
+
 // Mec host updated records
 type MecHostUpdatedRecords struct {
 	MecHostUpdatedRecs []MecHostInfo `json:"mecHostUpdatedRecs"`
@@ -305,6 +341,33 @@ type InstantiateRequest struct {
 	PackageId string `json:"packageId"`
 	AppName string `json:"appName"`
 	Origin string `json:"origin"`
+	Parameters map[string]interface{} `json:"parameters"`
+	Namespace string `json:"namespace"`
+	WaitForReady bool `json:"waitForReady"`
+	ReadyTimeout int32 `json:"readyTimeout"`
+}
+
+// Application instance upgrade request
+type UpgradeRequest struct {
+	PackageId string `json:"packageId"`
+}
+
+// Application instance rollback request
+type RollbackRequest struct {
+	Revision int32 `json:"revision"`
+}
+
+// Application instance scale request
+type ScaleRequest struct {
+	Replicas *int32 `json:"replicas"`
+}
+
+// Application instance autoscaling policy, utilization targets are percentage of resource requests
+type AutoscalingPolicy struct {
+	MinReplicas             int32 `json:"minReplicas"`
+	MaxReplicas             int32 `json:"maxReplicas"`
+	TargetCpuUtilization    int32 `json:"targetCpuUtilization,omitempty"`
+	TargetMemoryUtilization int32 `json:"targetMemoryUtilization,omitempty"`
 }
 
 // Mec hardware capabilities
@@ -317,8 +380,100 @@ type AppPkgDetails struct {
 	App_package_description  string `json:"app_package_description"`
 }
 
+// App package validation failure response
+type PackageValidationResponse struct {
+	Message          string               `json:"message"`
+	ValidationErrors []csar.ValidationError `json:"validationErrors"`
+}
+
 // App package response info
 type AppPackageResponse struct {
 	AppId     string `json:"appId"`
 	PackageId string `json:"packageId"`
-}
\ No newline at end of file
+}
+
+// LCM operation record
+type LcmOperation struct {
+	OperationId   string    `orm:"pk" json:"operationId"`
+	OperationType string    `json:"operationType"`
+	TenantId      string    `json:"tenantId"`
+	AppInstanceId string    `json:"appInstanceId"`
+	AppPackageId  string    `json:"appPackageId"`
+	HostIp        string    `json:"hostIp"`
+	State         string    `json:"state"`
+	StartTime     time.Time `orm:"type(datetime)" json:"startTime"`
+	EndTime       time.Time `orm:"null;type(datetime)" json:"endTime"`
+	Error         string    `orm:"type(text)" json:"error"`
+}
+
+// LCM operation response info
+type LcmOperationResponse struct {
+	OperationId string `json:"operationId"`
+}
+
+// Resumable application package upload session
+type PackageUploadSession struct {
+	UploadId   string    `orm:"pk" json:"uploadId"`
+	TenantId   string    `json:"tenantId"`
+	AppId      string    `json:"appId"`
+	PackageId  string    `json:"packageId"`
+	Origin     string    `json:"origin"`
+	TotalSize  int64     `json:"totalSize"`
+	ChunkSize  int64     `json:"chunkSize"`
+	Checksum   string    `json:"checksum"`
+	CreateTime time.Time `orm:"type(datetime)" json:"createTime"`
+}
+
+// Package upload session creation request
+type PackageUploadRequest struct {
+	AppId     string `json:"appId"`
+	PackageId string `json:"packageId"`
+	Origin    string `json:"origin"`
+	FileName  string `json:"fileName"`
+	TotalSize int64  `json:"totalSize"`
+	ChunkSize int64  `json:"chunkSize"`
+	Checksum  string `json:"checksum"`
+}
+
+// Received chunk of package upload
+type UploadedChunk struct {
+	ChunkNumber int   `json:"chunkNumber"`
+	Offset      int64 `json:"offset"`
+	Size        int64 `json:"size"`
+}
+
+// Package upload session status
+type PackageUploadStatus struct {
+	UploadId       string          `json:"uploadId"`
+	AppId          string          `json:"appId"`
+	PackageId      string          `json:"packageId"`
+	TotalSize      int64           `json:"totalSize"`
+	ChunkSize      int64           `json:"chunkSize"`
+	ReceivedSize   int64           `json:"receivedSize"`
+	ReceivedChunks []UploadedChunk `json:"receivedChunks"`
+	MissingChunks  []int           `json:"missingChunks"`
+}
+
+// Plugin registry record, vim types and capabilities are stored comma separated
+type PluginRecord struct {
+	PluginId     string    `orm:"pk"`
+	PluginName   string    `orm:"unique"`
+	Address      string
+	SslEnabled   bool
+	ServerName   string
+	VimTypes     string
+	Capabilities string    `orm:"type(text)"`
+	CreateTime   time.Time `orm:"auto_now_add;type(datetime)"`
+	UpdateTime   time.Time `orm:"auto_now;type(datetime)"`
+}
+
+// Plugin registration info
+type PluginInfo struct {
+	PluginId     string   `json:"pluginId"`
+	PluginName   string   `json:"pluginName"`
+	Address      string   `json:"address"`
+	SslEnabled   bool     `json:"sslEnabled"`
+	ServerName   string   `json:"serverName"`
+	VimTypes     []string `json:"vimTypes"`
+	Capabilities []string `json:"capabilities"`
+}
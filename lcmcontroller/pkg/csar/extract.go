@@ -0,0 +1,187 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package csar
+
+import (
+	"archive/zip"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	extractDirPerm  = 0750
+	extractFilePerm = 0640
+)
+
+// Limits of package archive extraction
+type ExtractLimits struct {
+	MaxFiles     int
+	MaxFileSize  int64
+	MaxTotalSize int64
+}
+
+// Extract package archive to target directory, limits are enforced on the extracted sizes and
+// entries which are links or escape the target directory are rejected. On failure everything extracted is
+// removed, the target directory itself when it is created by extraction
+func Extract(archivePath string, targetDir string, limits ExtractLimits) error {
+	zipReader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return errors.New("failed to open package archive")
+	}
+	defer zipReader.Close()
+
+	if len(zipReader.File) > limits.MaxFiles {
+		return errors.New("too many files in package archive")
+	}
+
+	existing, err := getExistingEntries(targetDir)
+	if err != nil {
+		return errors.New("failed to read package directory")
+	}
+	err = os.MkdirAll(targetDir, extractDirPerm)
+	if err != nil {
+		return errors.New("failed to create package directory")
+	}
+
+	var totalSize int64
+	for _, file := range zipReader.File {
+		wrote, err := extractFile(file, targetDir, limits.MaxFileSize, limits.MaxTotalSize-totalSize)
+		if err != nil {
+			removeExtracted(targetDir, existing)
+			return err
+		}
+		totalSize += wrote
+	}
+	return nil
+}
+
+// Get entries of target directory before extraction, nil when the directory does not exist
+func getExistingEntries(targetDir string) (map[string]bool, error) {
+	entries, err := ioutil.ReadDir(targetDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		existing[entry.Name()] = true
+	}
+	return existing, nil
+}
+
+// Remove extracted entries of target directory, the whole directory when it did not exist before extraction
+func removeExtracted(targetDir string, existing map[string]bool) {
+	if existing == nil {
+		if err := os.RemoveAll(targetDir); err != nil {
+			log.Error("failed to remove package directory")
+		}
+		return
+	}
+
+	entries, _ := ioutil.ReadDir(targetDir)
+	for _, entry := range entries {
+		if existing[entry.Name()] {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(targetDir, entry.Name())); err != nil {
+			log.Error("failed to remove extracted file " + entry.Name())
+		}
+	}
+}
+
+// Get extraction path of archive entry, entries escaping the target directory are rejected
+func getExtractPath(targetDir string, name string) (string, error) {
+	if name == "" || strings.Contains(name, "\\") || filepath.IsAbs(name) {
+		return "", errors.New("illegal file path " + name + " in package archive")
+	}
+
+	baseDir := filepath.Clean(targetDir)
+	extractPath := filepath.Join(baseDir, name)
+	if extractPath != baseDir && !strings.HasPrefix(extractPath, baseDir+string(os.PathSeparator)) {
+		return "", errors.New("illegal file path " + name + " in package archive")
+	}
+	return extractPath, nil
+}
+
+// Extract archive entry and return the number of extracted bytes
+func extractFile(file *zip.File, targetDir string, maxFileSize int64, remainingSize int64) (int64, error) {
+	extractPath, err := getExtractPath(targetDir, file.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	mode := file.Mode()
+	if mode&os.ModeSymlink != 0 {
+		return 0, errors.New("link " + file.Name + " is not allowed in package archive")
+	}
+	if mode.IsDir() {
+		err = os.MkdirAll(extractPath, extractDirPerm)
+		if err != nil {
+			return 0, errors.New("failed to create directory " + file.Name)
+		}
+		return 0, nil
+	}
+	if !mode.IsRegular() {
+		return 0, errors.New("file type of " + file.Name + " is not allowed in package archive")
+	}
+
+	// Declared sizes are checked first, the copy below is limited as they can not be trusted
+	limit := maxFileSize
+	if remainingSize < limit {
+		limit = remainingSize
+	}
+	if file.UncompressedSize64 > uint64(maxFileSize) {
+		return 0, errors.New("file " + file.Name + " size limit is exceeded")
+	}
+	if file.UncompressedSize64 > uint64(limit) {
+		return 0, errors.New("package archive size limit is exceeded")
+	}
+
+	err = os.MkdirAll(filepath.Dir(extractPath), extractDirPerm)
+	if err != nil {
+		return 0, errors.New("failed to create directory for " + file.Name)
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return 0, errors.New("failed to open " + file.Name + " in package archive")
+	}
+	defer reader.Close()
+
+	outputFile, err := os.OpenFile(extractPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, extractFilePerm)
+	if err != nil {
+		return 0, errors.New("failed to create file " + file.Name)
+	}
+	defer outputFile.Close()
+
+	wrote, err := io.Copy(outputFile, io.LimitReader(reader, limit+1))
+	if err != nil {
+		return wrote, errors.New("failed to extract " + file.Name)
+	}
+	if wrote > limit {
+		return wrote, errors.New("file " + file.Name + " size limit is exceeded")
+	}
+	return wrote, nil
+}
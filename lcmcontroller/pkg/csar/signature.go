@@ -0,0 +1,61 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package csar
+
+import (
+	"bytes"
+	"encoding/pem"
+	"errors"
+	"go.mozilla.org/pkcs7"
+	"io/ioutil"
+	"strings"
+)
+
+// Verify CMS signature of manifest, signature is embedded in manifest or stored next to it in .cms file
+func (v *Validator) verifySignature(manifestPath string, content []byte, embedded []byte) error {
+	signature := embedded
+	if len(signature) == 0 {
+		detached, err := ioutil.ReadFile(strings.TrimSuffix(manifestPath, ".mf") + ".cms")
+		if err != nil {
+			return errors.New("manifest signature is missing")
+		}
+		signature = detached
+	}
+
+	if strings.Contains(string(signature), cmsBegin) {
+		block, _ := pem.Decode(signature)
+		if block == nil {
+			return errors.New("manifest signature is invalid")
+		}
+		signature = block.Bytes
+	}
+
+	p7, err := pkcs7.Parse(signature)
+	if err != nil {
+		return errors.New("manifest signature is invalid")
+	}
+	if len(p7.Content) == 0 {
+		p7.Content = content
+	} else if !bytes.Equal(p7.Content, content) {
+		return errors.New("signed content does not match manifest")
+	}
+	err = p7.VerifyWithChain(v.trustStore)
+	if err != nil {
+		return errors.New("manifest signature verification failed")
+	}
+	return nil
+}
@@ -16,10 +16,12 @@
 package pluginAdapter
 
 import (
-	"bytes"
 	"context"
-	beegoCtx "github.com/astaxie/beego/context"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"io"
 	"lcmcontroller/config"
+	"lcmcontroller/models"
 	"lcmcontroller/util"
 	"mime/multipart"
 	"time"
@@ -40,12 +42,23 @@ func NewPluginAdapter(pluginInfo string, client ClientIntf) *PluginAdapter {
 
 // Instantiate application
 func (c *PluginAdapter) Instantiate(tenantId string, host string, packageId string,
-	accessToken string, akSkAppInfo config.AppAuthConfig) (error error, status string) {
+	accessToken string, akSkAppInfo config.AppAuthConfig, namespace, parameters string,
+	readyOptions ReadyOptions) (error error, status string) {
 	log.Info("Instantiation started")
-	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
+	// Plugin waits for the workloads to be ready before it responds
+	timeout := time.Duration(util.Timeout) * time.Second
+	if readyOptions.WaitForReady {
+		readyTimeout := readyOptions.ReadyTimeout
+		if readyTimeout == 0 {
+			readyTimeout = util.DefaultReadyTimeout
+		}
+		timeout += time.Duration(readyTimeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	status, err := c.client.Instantiate(ctx, tenantId, host, packageId, accessToken, akSkAppInfo)
+	status, err := c.client.Instantiate(ctx, tenantId, host, packageId, accessToken, akSkAppInfo, namespace,
+		parameters, readyOptions)
 	if err != nil {
 		log.Error("failed to instantiate application")
 		return err, util.Failure
@@ -54,6 +67,131 @@ func (c *PluginAdapter) Instantiate(tenantId string, host string, packageId stri
 	return nil, status
 }
 
+// Upgrade application
+func (c *PluginAdapter) Upgrade(tenantId string, host string, packageId string,
+	accessToken string, akSkAppInfo config.AppAuthConfig) (error error, status string) {
+	log.Info("Upgrade started")
+	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
+	defer cancel()
+
+	status, err := c.client.Upgrade(ctx, tenantId, host, packageId, accessToken, akSkAppInfo)
+	if err != nil {
+		log.Error("failed to upgrade application")
+		return err, util.Failure
+	}
+	log.Info("upgrade completed with status: ", status)
+	return nil, status
+}
+
+// Rollback application
+func (c *PluginAdapter) Rollback(host string, accessToken string, appInsId string,
+	revision int32) (restored *models.RestoredRevision, error error) {
+	log.Info("Rollback started")
+
+	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
+	defer cancel()
+
+	restored, err := c.client.Rollback(ctx, accessToken, appInsId, host, revision)
+	if err != nil {
+		log.Error("failed to rollback application")
+		return nil, err
+	}
+
+	log.Info("rollback completed")
+	return restored, nil
+}
+
+// Scale application
+func (c *PluginAdapter) Scale(host string, accessToken string, appInsId string,
+	replicas int32) (status string, error error) {
+	log.Info("Scale started")
+
+	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
+	defer cancel()
+
+	status, err := c.client.Scale(ctx, accessToken, appInsId, host, replicas)
+	if err != nil {
+		log.Error("failed to scale application")
+		return util.Failure, err
+	}
+
+	log.Info("scale completed with status: ", status)
+	return status, nil
+}
+
+// Set application autoscaling policy
+func (c *PluginAdapter) SetAutoscaling(host string, accessToken string, appInsId string,
+	policy string) (status string, error error) {
+	log.Info("Set autoscaling started")
+
+	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
+	defer cancel()
+
+	status, err := c.client.SetAutoscaling(ctx, accessToken, appInsId, host, policy)
+	if err != nil {
+		log.Error("failed to set autoscaling of application")
+		return util.Failure, err
+	}
+
+	log.Info("set autoscaling completed with status: ", status)
+	return status, nil
+}
+
+// Delete application autoscaling policy
+func (c *PluginAdapter) DeleteAutoscaling(host string, accessToken string,
+	appInsId string) (status string, error error) {
+	log.Info("Delete autoscaling started")
+
+	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
+	defer cancel()
+
+	status, err := c.client.DeleteAutoscaling(ctx, accessToken, appInsId, host)
+	if err != nil {
+		log.Error("failed to delete autoscaling of application")
+		return util.Failure, err
+	}
+
+	log.Info("delete autoscaling completed with status: ", status)
+	return status, nil
+}
+
+// Get application pod logs, followed logs are streamed until the context is done
+func (c *PluginAdapter) WorkloadLogs(ctx context.Context, writer io.Writer, host string, accessToken string,
+	appInsId string, options LogOptions) error {
+	log.Info("Workload logs started")
+
+	if !options.Follow {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, util.Timeout*time.Second)
+		defer cancel()
+	}
+
+	err := c.client.WorkloadLogs(ctx, accessToken, appInsId, host, options, writer)
+	if err != nil {
+		log.Error("failed to get workload logs")
+		return err
+	}
+
+	log.Info("workload logs completed")
+	return nil
+}
+
+// Get application revision history
+func (c *PluginAdapter) History(accessToken, appInsId, host string) (response string, error error) {
+	log.Info("History started")
+
+	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
+	defer cancel()
+
+	response, err := c.client.History(ctx, accessToken, appInsId, host)
+	if err != nil {
+		log.Errorf("failed to get revision history")
+		return "", err
+	}
+	log.Info("History completed with status: Success")
+	return response, nil
+}
+
 // Query application
 func (c *PluginAdapter) Query(accessToken, appInsId, host string) (response string, error error) {
 	log.Info("Query started")
@@ -70,6 +208,19 @@ func (c *PluginAdapter) Query(accessToken, appInsId, host string) (response stri
 	return response, nil
 }
 
+// Query health summary of application
+func (c *PluginAdapter) QueryHealth(accessToken, appInsId, host string) (*models.HealthSummary, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), util.HealthQueryTimeout*time.Second)
+	defer cancel()
+
+	health, err := c.client.QueryHealth(ctx, accessToken, appInsId, host)
+	if err != nil {
+		log.Errorf("failed to query health of application")
+		return nil, err
+	}
+	return health, nil
+}
+
 // Terminate application
 func (c *PluginAdapter) Terminate(host string, accessToken string, appInsId string) (status string, error error) {
 	log.Info("Terminate started")
@@ -122,13 +273,14 @@ func (c *PluginAdapter) RemoveConfig(host string, accessToken string) (status st
 }
 
 // Get workload description
-func (c *PluginAdapter) GetWorkloadDescription(accessToken, host, appInsId string) (response string, error error) {
+func (c *PluginAdapter) GetWorkloadDescription(accessToken, host, appInsId string,
+	filter EventFilter) (response string, error error) {
 	log.Info("Get workload description started")
 
 	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
 	defer cancel()
 
-	response, err := c.client.WorkloadDescription(ctx, accessToken, appInsId, host)
+	response, err := c.client.WorkloadDescription(ctx, accessToken, appInsId, host, filter)
 	if err != nil {
 		log.Errorf("failed to get workload description")
 		return "", err
@@ -137,6 +289,22 @@ func (c *PluginAdapter) GetWorkloadDescription(accessToken, host, appInsId strin
 	return response, nil
 }
 
+// Get endpoints of application
+func (c *PluginAdapter) GetEndpoints(accessToken, host, appInsId string) (response string, error error) {
+	log.Info("Get endpoints started")
+
+	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
+	defer cancel()
+
+	response, err := c.client.Endpoints(ctx, accessToken, appInsId, host)
+	if err != nil {
+		log.Errorf("failed to get endpoints")
+		return "", err
+	}
+	log.Info("Get endpoints completed with status: Success")
+	return response, nil
+}
+
 // Create VM Image
 func (c *PluginAdapter) CreateVmImage(host string, accessToken string, appInsId string, vmId string) (response string, error error) {
 	log.Info("Create VM Image started")
@@ -190,30 +358,30 @@ func (c *PluginAdapter) QueryVmImage(host string, accessToken string, appInsId s
 	return response, nil
 }
 
-// Query VM Image
-func (c *PluginAdapter) DownloadVmImage(imgCtrlr *beegoCtx.Response, host string, accessToken string, appInsId string, imageId string,
-	chunkNum int32) (buf *bytes.Buffer, error error) {
-	log.Info("Download VM Image chunk started")
+// Download VM Image, image is streamed to the writer starting from the chunk
+func (c *PluginAdapter) DownloadVmImage(writer io.Writer, host string, accessToken string, appInsId string,
+	imageId string, chunkNum int32) (written int64, error error) {
+	log.Info("Download VM Image started")
 
 	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Hour)
 	defer cancel()
 
-	response, err := c.client.DownloadVmImage(ctx, accessToken, appInsId, host, imageId, chunkNum, imgCtrlr)
+	written, err := c.client.DownloadVmImage(ctx, accessToken, appInsId, host, imageId, chunkNum, writer)
 	if err != nil {
-		log.Error("failed to download VM image chunk")
-		return response, err
+		log.Error("failed to download VM image")
+		return written, err
 	}
 
-	log.Info("VM image chunk download completed successfully")
-	return response, nil
+	log.Info("VM image download completed successfully")
+	return written, nil
 }
 
-// Upload configuration
+// Upload package, timeout is the limit of the whole package transfer
 func (c *PluginAdapter) UploadPackage(tenantId string, appPkg string, host string, packageId string,
-	accessToken string) (status string, error error) {
+	accessToken string, timeout time.Duration) (status string, error error) {
 	log.Info("Distribute package started")
 
-	ctx, cancel := context.WithTimeout(context.Background(), util.Timeout*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	status, err := c.client.UploadPackage(ctx, tenantId, appPkg, host, packageId, accessToken)
@@ -240,4 +408,51 @@ func (c *PluginAdapter) DeletePackage(tenantId string, host string, packageId st
 
 	log.Info("remove configuration is success with status: ", status)
 	return status, nil
-}
\ No newline at end of file
+}
+
+// Get capabilities of plugin, capabilities are cached per plugin. Plugins which do not implement capability
+// negotiation have nil capabilities
+func (c *PluginAdapter) GetCapabilities(accessToken string) (*Capabilities, error) {
+	return c.getCapabilities(accessToken, util.Timeout*time.Second)
+}
+
+// Get capabilities of plugin, timeout is the limit of the capability request when they are not cached
+func (c *PluginAdapter) getCapabilities(accessToken string, timeout time.Duration) (*Capabilities, error) {
+	if capabilities, ok := getCachedCapabilities(c.pluginInfo); ok {
+		return capabilities, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	capabilities, err := c.client.GetCapabilities(ctx, accessToken)
+	if err != nil {
+		if grpcStatus.Code(err) != codes.Unimplemented {
+			log.Error("failed to get plugin capabilities")
+			return nil, err
+		}
+		log.Info("plugin " + c.pluginInfo + " does not support capability negotiation")
+		capabilities = nil
+	}
+	cacheCapabilities(c.pluginInfo, capabilities)
+	return capabilities, nil
+}
+
+// Check whether operation is supported by plugin
+func (c *PluginAdapter) IsOperationSupported(operation string, accessToken string) (bool, error) {
+	capabilities, err := c.GetCapabilities(accessToken)
+	if err != nil {
+		return false, err
+	}
+	return capabilities.Supports(operation), nil
+}
+
+// Check whether operation is supported by plugin, timeout is the limit of the capability request
+func (c *PluginAdapter) IsOperationSupportedWithTimeout(operation string, accessToken string,
+	timeout time.Duration) (bool, error) {
+	capabilities, err := c.getCapabilities(accessToken, timeout)
+	if err != nil {
+		return false, err
+	}
+	return capabilities.Supports(operation), nil
+}
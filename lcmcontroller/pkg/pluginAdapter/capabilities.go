@@ -0,0 +1,104 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pluginAdapter
+
+import (
+	"sync"
+	"time"
+)
+
+// Rpc names of plugin operations
+const (
+	OperationUpgrade         = "upgrade"
+	OperationRollback        = "rollback"
+	OperationHistory         = "history"
+	OperationScale           = "scale"
+	OperationSetAutoscaling  = "setAutoscaling"
+	OperationDelAutoscaling  = "deleteAutoscaling"
+	OperationWorkloadLogs    = "workloadLogs"
+	OperationQueryHealth     = "queryHealth"
+	OperationEndpoints       = "endpoints"
+	OperationCreateVmImage   = "createVmImage"
+	OperationQueryVmImage    = "queryVmImage"
+	OperationDeleteVmImage   = "deleteVmImage"
+	OperationDownloadVmImage = "downloadVmImage"
+
+	capabilityCacheTTL = 10 * time.Minute
+)
+
+// Capabilities of plugin, nil capabilities mean the plugin does not support capability negotiation
+// and all operations are assumed to be supported
+type Capabilities struct {
+	Operations        []string `json:"operations"`
+	DeploymentTypes   []string `json:"deploymentTypes"`
+	MaxPackageSize    int64    `json:"maxPackageSize"`
+	MaxConfigFileSize int64    `json:"maxConfigFileSize"`
+}
+
+// Check whether operation is supported
+func (c *Capabilities) Supports(operation string) bool {
+	if c == nil {
+		return true
+	}
+	for _, supported := range c.Operations {
+		if supported == operation {
+			return true
+		}
+	}
+	return false
+}
+
+// Cached capabilities of plugin
+type cachedCapabilities struct {
+	capabilities *Capabilities
+	expiry       time.Time
+}
+
+// Capabilities of plugins keyed by plugin address
+var capabilityCache = struct {
+	sync.Mutex
+	entries map[string]cachedCapabilities
+}{entries: make(map[string]cachedCapabilities)}
+
+// Get cached capabilities of plugin
+func getCachedCapabilities(pluginInfo string) (*Capabilities, bool) {
+	capabilityCache.Lock()
+	defer capabilityCache.Unlock()
+
+	cached, ok := capabilityCache.entries[pluginInfo]
+	if !ok || time.Now().After(cached.expiry) {
+		return nil, false
+	}
+	return cached.capabilities, true
+}
+
+// Cache capabilities of plugin
+func cacheCapabilities(pluginInfo string, capabilities *Capabilities) {
+	capabilityCache.Lock()
+	defer capabilityCache.Unlock()
+
+	capabilityCache.entries[pluginInfo] = cachedCapabilities{capabilities: capabilities,
+		expiry: time.Now().Add(capabilityCacheTTL)}
+}
+
+// Remove cached capabilities of plugin
+func removeCachedCapabilities(pluginInfo string) {
+	capabilityCache.Lock()
+	defer capabilityCache.Unlock()
+
+	delete(capabilityCache.entries, pluginInfo)
+}
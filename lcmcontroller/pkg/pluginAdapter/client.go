@@ -16,24 +16,64 @@
 package pluginAdapter
 
 import (
-	"bytes"
-	beegoCtx "github.com/astaxie/beego/context"
+	"io"
 	"lcmcontroller/config"
+	"lcmcontroller/models"
 	"mime/multipart"
 
 	"golang.org/x/net/context"
 )
 
+// Log options of application pod, since time is RFC3339 time and empty when all logs are requested
+type LogOptions struct {
+	PodName       string
+	ContainerName string
+	TailLines     int64
+	SinceTime     string
+	Follow        bool
+}
+
+// Filter of workload events, since time is RFC3339 time and type is Normal or Warning, empty filters are not applied
+type EventFilter struct {
+	SinceTime string
+	Type      string
+}
+
+// Options to wait for workloads of instantiated application to be ready, timeout is in seconds and default
+// timeout is used when it is zero
+type ReadyOptions struct {
+	WaitForReady bool
+	ReadyTimeout int32
+}
+
 // GRPC client APIs
 type ClientIntf interface {
 	Instantiate(ctx context.Context, tenantId string, host string, packageId string,
+		accessToken string, akSkAppInfo config.AppAuthConfig, namespace string, parameters string,
+		readyOptions ReadyOptions) (status string, error error)
+	Upgrade(ctx context.Context, tenantId string, host string, packageId string,
 		accessToken string, akSkAppInfo config.AppAuthConfig) (status string, error error)
+	Rollback(ctx context.Context, accessToken string, appInsId string, hostIP string,
+		revision int32) (restored *models.RestoredRevision, error error)
+	Scale(ctx context.Context, accessToken string, appInsId string, hostIP string,
+		replicas int32) (status string, error error)
+	SetAutoscaling(ctx context.Context, accessToken string, appInsId string, hostIP string,
+		policy string) (status string, error error)
+	DeleteAutoscaling(ctx context.Context, accessToken string, appInsId string,
+		hostIP string) (status string, error error)
+	WorkloadLogs(ctx context.Context, accessToken string, appInsId string, hostIP string, options LogOptions,
+		writer io.Writer) error
+	History(ctx context.Context, accessToken string, appInsId string, hostIP string) (response string, error error)
 	Terminate(ctx context.Context, hostIP string, accessToken string, appInsId string) (status string, error error)
 	Query(ctx context.Context, accessToken string, appInsId string, hostIP string) (response string, error error)
+	QueryHealth(ctx context.Context, accessToken string, appInsId string,
+		hostIP string) (health *models.HealthSummary, error error)
 	UploadConfig(ctx context.Context, multipartFile multipart.File,
 		hostIP string, accessToken string) (status string, error error)
 	RemoveConfig(ctx context.Context, hostIP string, accessToken string) (status string, error error)
-	WorkloadDescription(ctx context.Context, accessToken string, appInsId string, hostIP string) (response string,
+	WorkloadDescription(ctx context.Context, accessToken string, appInsId string, hostIP string,
+		filter EventFilter) (response string, error error)
+	Endpoints(ctx context.Context, accessToken string, appInsId string, hostIP string) (response string,
 		error error)
 
 	// App package API
@@ -41,6 +81,9 @@ type ClientIntf interface {
 		packageId string, accessToken string) (status string, error error)
 	DeletePackage(ctx context.Context, tenantId string, hostIP string, accessToken string,  packageId string) (status string, error error)
 
+	// Capability API
+	GetCapabilities(ctx context.Context, accessToken string) (capabilities *Capabilities, error error)
+
 
 	// Image related API
 	CreateVmImage(ctx context.Context, accessToken string, appInsId string, hostIP string, vmId string) (response string,
@@ -50,5 +93,5 @@ type ClientIntf interface {
 	DeleteVmImage(ctx context.Context, accessToken string, appInsId string, hostIP string,
 		imageId string) (status string, error error)
 	DownloadVmImage(ctx context.Context, accessToken string, appInsId string, hostIP string,
-		imageId string, chunkNum int32, imgCtrlr *beegoCtx.Response) (buf *bytes.Buffer, error error)
+		imageId string, chunkNum int32, writer io.Writer) (written int64, error error)
 }
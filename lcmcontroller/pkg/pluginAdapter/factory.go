@@ -20,6 +20,7 @@ import (
 	"errors"
 	log "github.com/sirupsen/logrus"
 	"lcmcontroller/util"
+	"sync"
 )
 
 const (
@@ -27,14 +28,26 @@ const (
 	clientProtocol = "grpc"
 )
 
-// Get client based on client protocol type
+// Connection pool shared by all plugin clients
+var connectionPool = NewConnectionPool()
+
+// Connection settings of registered plugins keyed by plugin address
+var pluginConfigs sync.Map
+
+// Plugin connection settings
+type PluginConfig struct {
+	Address    string
+	SslEnabled bool
+	ServerName string
+}
+
+// Get client based on client protocol type, grpc clients are taken from the connection pool and
+// must not be closed by the caller
 func GetClient(pluginInfo string) (client ClientIntf, err error) {
 	// To support testability requirement client protocol is not taken from config currently.
 	switch clientProtocol {
 	case "grpc":
-		clientConfig := ClientGRPCConfig{Address: pluginInfo, ChunkSize: chunkSize,
-			RootCertificate: "HTTPSClientCA"}
-		var client, err = NewClientGRPC(clientConfig)
+		var client, err = connectionPool.GetClient(getClientConfig(pluginInfo))
 		if err != nil {
 			log.Errorf(util.FailedToCreateClient, err)
 			return nil, err
@@ -44,3 +57,32 @@ func GetClient(pluginInfo string) (client ClientIntf, err error) {
 		return nil, errors.New("no client is found")
 	}
 }
+
+// Get status of pooled plugin connections
+func GetPoolStatus() []ConnectionStatus {
+	return connectionPool.Status()
+}
+
+// Set connection settings of plugin, plugins without settings use the client configuration of lcmcontroller
+func SetPluginConfig(cfg PluginConfig) {
+	pluginConfigs.Store(cfg.Address, cfg)
+}
+
+// Remove connection settings and cached capabilities of plugin and close its pooled connection
+func RemovePlugin(address string) {
+	pluginConfigs.Delete(address)
+	connectionPool.Remove(address)
+	removeCachedCapabilities(address)
+}
+
+// Get client configuration of plugin
+func getClientConfig(pluginInfo string) ClientGRPCConfig {
+	cfg := ClientGRPCConfig{Address: pluginInfo, ChunkSize: chunkSize, RootCertificate: "HTTPSClientCA",
+		SslEnabled: util.GetAppConfig("client_ssl_enable") == "true"}
+	if value, ok := pluginConfigs.Load(pluginInfo); ok {
+		pluginCfg := value.(PluginConfig)
+		cfg.SslEnabled = pluginCfg.SslEnabled
+		cfg.ServerName = pluginCfg.ServerName
+	}
+	return cfg
+}
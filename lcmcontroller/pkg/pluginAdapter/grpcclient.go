@@ -17,24 +17,31 @@
 package pluginAdapter
 
 import (
-	"bytes"
-	beegoCtx "github.com/astaxie/beego/context"
+	"errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"io"
 	"lcmcontroller/config"
+	"lcmcontroller/models"
 	"lcmcontroller/internal/lcmservice"
 	"lcmcontroller/util"
 	"mime/multipart"
 	"os"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 	_ "google.golang.org/grpc/encoding/gzip"
 )
 
+const (
+	keepaliveTime    = 30 * time.Second
+	keepaliveTimeout = 10 * time.Second
+)
+
 // GRPC client to different GRPC supported plugins
 type ClientGRPC struct {
 	conn        *grpc.ClientConn
@@ -48,6 +55,8 @@ type ClientGRPCConfig struct {
 	Address         string
 	ChunkSize       int
 	RootCertificate string
+	SslEnabled      bool
+	ServerName      string
 }
 
 // Create a GRPC client
@@ -58,13 +67,20 @@ func NewClientGRPC(cfg ClientGRPCConfig) (c *ClientGRPC, err error) {
 		conn     *grpc.ClientConn
 	)
 
-	if util.GetAppConfig("client_ssl_enable") == "true" {
+	// Keepalive pings detect dead plugins on idle connections
+	grpcOpts = append(grpcOpts, grpc.WithKeepaliveParams(keepalive.ClientParameters{Time: keepaliveTime,
+		Timeout: keepaliveTimeout, PermitWithoutStream: true}))
+
+	if cfg.SslEnabled {
 
 		tlsConfig, err := util.TLSConfig(cfg.RootCertificate)
 		if err != nil {
 			log.Error("failed to get TLS configuration with error")
 			return nil, err
 		}
+		if cfg.ServerName != "" {
+			tlsConfig.ServerName = cfg.ServerName
+		}
 		creds := credentials.NewTLS(tlsConfig)
 		size := 1024 * 1024 * 24
 		grpcOpts = append(grpcOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(size)))
@@ -88,7 +104,8 @@ func NewClientGRPC(cfg ClientGRPCConfig) (c *ClientGRPC, err error) {
 
 // Instantiate application
 func (c *ClientGRPC) Instantiate(ctx context.Context, tenantId string, host string, packageId string,
-	accessToken string, akSkAppInfo config.AppAuthConfig) (status string, error error) {
+	accessToken string, akSkAppInfo config.AppAuthConfig, namespace string, parameters string,
+	readyOptions ReadyOptions) (status string, error error) {
 	req := &lcmservice.InstantiateRequest{
 		HostIp:        host,
 		TenantId:      tenantId,
@@ -97,14 +114,153 @@ func (c *ClientGRPC) Instantiate(ctx context.Context, tenantId string, host stri
 		AppInstanceId: akSkAppInfo.AppInsId,
 		Ak: akSkAppInfo.Ak,
 		Sk: akSkAppInfo.Sk,
+		Parameters: parameters,
+		Namespace: namespace,
+		WaitForReady: readyOptions.WaitForReady,
+		ReadyTimeout: readyOptions.ReadyTimeout,
 	}
 	resp, err := c.client.Instantiate(ctx, req)
 	if err != nil {
 		return "", err
 	}
+	if resp.Status == util.Failure && resp.Reason != "" {
+		return resp.Status, errors.New(resp.Reason)
+	}
+	return resp.Status, err
+}
+
+// Upgrade application
+func (c *ClientGRPC) Upgrade(ctx context.Context, tenantId string, host string, packageId string,
+	accessToken string, akSkAppInfo config.AppAuthConfig) (status string, error error) {
+	req := &lcmservice.UpgradeRequest{
+		HostIp:        host,
+		TenantId:      tenantId,
+		AppPackageId:  packageId,
+		AccessToken:   accessToken,
+		AppInstanceId: akSkAppInfo.AppInsId,
+		Ak:            akSkAppInfo.Ak,
+		Sk:            akSkAppInfo.Sk,
+	}
+	resp, err := c.client.Upgrade(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Status, err
+}
+
+// Rollback application
+func (c *ClientGRPC) Rollback(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	revision int32) (restored *models.RestoredRevision, error error) {
+	req := &lcmservice.RollbackRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		Revision:      revision,
+	}
+	resp, err := c.client.Rollback(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &models.RestoredRevision{PackageId: resp.PackageId, Ak: resp.Ak, Sk: resp.Sk}, nil
+}
+
+// Scale application
+func (c *ClientGRPC) Scale(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	replicas int32) (status string, error error) {
+	req := &lcmservice.ScaleRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		Replicas:      replicas,
+	}
+	resp, err := c.client.Scale(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Status, err
+}
+
+// Set application autoscaling policy
+func (c *ClientGRPC) SetAutoscaling(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	policy string) (status string, error error) {
+	req := &lcmservice.SetAutoscalingRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		Policy:        policy,
+	}
+	resp, err := c.client.SetAutoscaling(ctx, req)
+	if err != nil {
+		return "", err
+	}
 	return resp.Status, err
 }
 
+// Delete application autoscaling policy
+func (c *ClientGRPC) DeleteAutoscaling(ctx context.Context, accessToken string, appInsId string,
+	hostIP string) (status string, error error) {
+	req := &lcmservice.DeleteAutoscalingRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+	}
+	resp, err := c.client.DeleteAutoscaling(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Status, err
+}
+
+// Get application pod logs, logs are written to the writer as they are received
+func (c *ClientGRPC) WorkloadLogs(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	options LogOptions, writer io.Writer) error {
+	req := &lcmservice.WorkloadLogsRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		PodName:       options.PodName,
+		ContainerName: options.ContainerName,
+		TailLines:     options.TailLines,
+		SinceTime:     options.SinceTime,
+		Follow:        options.Follow,
+	}
+
+	stream, err := c.client.WorkloadLogs(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			// Status of plugin is kept, so that caller can tell invalid request from failure
+			return err
+		}
+
+		_, err = writer.Write(res.GetContent())
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Get application revision history
+func (c *ClientGRPC) History(ctx context.Context, accessToken string, appInsId string,
+	hostIP string) (response string, error error) {
+	req := &lcmservice.HistoryRequest{
+		HostIp:        hostIP,
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+	}
+	resp, err := c.client.History(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Response, err
+}
+
 // Query application
 func (c *ClientGRPC) Query(ctx context.Context, accessToken string,
 	appInsId string, hostIP string) (response string, error error) {
@@ -121,6 +277,26 @@ func (c *ClientGRPC) Query(ctx context.Context, accessToken string,
 	return resp.Response, err
 }
 
+// Query health summary of application
+func (c *ClientGRPC) QueryHealth(ctx context.Context, accessToken string,
+	appInsId string, hostIP string) (health *models.HealthSummary, error error) {
+
+	req := &lcmservice.QueryRequest{
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		HostIp:        hostIP,
+		HealthOnly:    true,
+	}
+	resp, err := c.client.Query(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Health == nil {
+		return nil, errors.New("health summary is not responded by plugin")
+	}
+	return &models.HealthSummary{Status: resp.Health.Status, Reasons: resp.Health.Reasons}, nil
+}
+
 // Terminate application
 func (c *ClientGRPC) Terminate(ctx context.Context, hostIP string, accessToken string,
 	appInsId string) (status string, error error) {
@@ -237,12 +413,14 @@ func (c *ClientGRPC) UploadConfig(ctx context.Context, multipartFile multipart.F
 
 // Get workload description
 func (c *ClientGRPC) WorkloadDescription(ctx context.Context, accessToken string,
-	appInsId string, hostIP string) (response string, error error) {
+	appInsId string, hostIP string, filter EventFilter) (response string, error error) {
 
 	req := &lcmservice.WorkloadEventsRequest{
 		AccessToken:   accessToken,
 		AppInstanceId: appInsId,
 		HostIp:        hostIP,
+		SinceTime:     filter.SinceTime,
+		Type:          filter.Type,
 	}
 	resp, err := c.client.WorkloadEvents(ctx, req)
 	if err != nil {
@@ -251,6 +429,22 @@ func (c *ClientGRPC) WorkloadDescription(ctx context.Context, accessToken string
 	return resp.Response, err
 }
 
+// Get endpoints of application
+func (c *ClientGRPC) Endpoints(ctx context.Context, accessToken string,
+	appInsId string, hostIP string) (response string, error error) {
+
+	req := &lcmservice.EndpointsRequest{
+		AccessToken:   accessToken,
+		AppInstanceId: appInsId,
+		HostIp:        hostIP,
+	}
+	resp, err := c.client.Endpoints(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Response, err
+}
+
 // Create VM Image
 func (c *ClientGRPC) CreateVmImage(ctx context.Context, accessToken string, appInsId string,
 	hostIP string, vmId string) (response string, error error) {
@@ -299,9 +493,10 @@ func (c *ClientGRPC) DeleteVmImage(ctx context.Context, accessToken string, appI
 	return resp.Response, err
 }
 
-// Download VM Image
+// Download VM Image, received chunks are written to the writer as they arrive. Writer errors stop the
+// download and are returned as is
 func (c *ClientGRPC) DownloadVmImage(ctx context.Context, accessToken string, appInsId string,
-	hostIP string, imageId string, chunkNum int32, _ *beegoCtx.Response) (buf *bytes.Buffer, error error) {
+	hostIP string, imageId string, chunkNum int32, writer io.Writer) (written int64, error error) {
 	req := &lcmservice.DownloadVmImageRequest{
 		HostIp:        hostIP,
 		AccessToken:   accessToken,
@@ -310,16 +505,14 @@ func (c *ClientGRPC) DownloadVmImage(ctx context.Context, accessToken string, ap
 		ChunkNum:      chunkNum,
 	}
 
-
 	stream, err := c.imageClient.DownloadVmImage(ctx, req)
 	if err != nil {
-		return buf, err
+		return written, err
 	}
-	var count int32 = 0
 	for {
 		err := c.contextError(stream.Context())
 		if err != nil {
-			return buf, err
+			return written, err
 		}
 
 		log.Debug("Waiting to receive more data")
@@ -330,16 +523,17 @@ func (c *ClientGRPC) DownloadVmImage(ctx context.Context, accessToken string, ap
 			break
 		}
 		if err != nil {
-			return buf, c.logError(status.Error(codes.Unknown, "cannot receive chunk data"))
+			return written, c.logError(status.Error(codes.Unknown, "cannot receive chunk data"))
 		}
 
-		// Receive chunk and write to package
-		chunk := res.GetContent()
-		util.VmImageMap[count] = chunk
-		count++
+		n, err := writer.Write(res.GetContent())
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
 	}
 	_ = stream.CloseSend()
-	return buf, nil
+	return written, nil
 }
 
 // Close connection
@@ -512,3 +706,21 @@ func (c *ClientGRPC) DeletePackage(ctx context.Context, tenantId string, hostIP
 	}
 	return resp.Status, err
 }
+
+// Get capabilities
+func (c *ClientGRPC) GetCapabilities(ctx context.Context, accessToken string) (capabilities *Capabilities,
+	error error) {
+	req := &lcmservice.GetCapabilitiesRequest{
+		AccessToken: accessToken,
+	}
+	resp, err := c.client.GetCapabilities(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &Capabilities{
+		Operations:        resp.GetOperations(),
+		DeploymentTypes:   resp.GetDeploymentTypes(),
+		MaxPackageSize:    resp.GetMaxPackageSize(),
+		MaxConfigFileSize: resp.GetMaxConfigFileSize(),
+	}, nil
+}
@@ -0,0 +1,211 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pluginAdapter
+
+import (
+	"context"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	healthCheckInterval = 30 * time.Second
+	healthCheckTimeout  = 5 * time.Second
+	maxHealthFailures   = 3
+
+	HealthServing     = "SERVING"
+	HealthUnknown     = "UNKNOWN"
+	HealthUnreachable = "UNREACHABLE"
+)
+
+// Status of pooled plugin connection
+type ConnectionStatus struct {
+	Address         string    `json:"address"`
+	State           string    `json:"state"`
+	Health          string    `json:"health"`
+	HealthFailures  int       `json:"healthFailures"`
+	LastHealthCheck time.Time `json:"lastHealthCheck"`
+}
+
+// Pooled plugin connection
+type pooledConnection struct {
+	client          *ClientGRPC
+	config          ClientGRPCConfig
+	health          string
+	healthFailures  int
+	lastHealthCheck time.Time
+}
+
+// Pool of grpc connections keyed by plugin address. A single connection is kept for each plugin and
+// it is closed once the plugin fails consecutive health checks, so a dead plugin holds at most one
+// connection
+type ConnectionPool struct {
+	mutex       sync.Mutex
+	connections map[string]*pooledConnection
+	healthOnce  sync.Once
+}
+
+// Create plugin connection pool
+func NewConnectionPool() *ConnectionPool {
+	return &ConnectionPool{connections: make(map[string]*pooledConnection)}
+}
+
+// Get client of plugin, the connection is created on first use and reused afterwards. Connection is
+// created again when the plugin configuration is changed
+func (p *ConnectionPool) GetClient(cfg ClientGRPCConfig) (*ClientGRPC, error) {
+	p.healthOnce.Do(func() {
+		go p.runHealthChecks(healthCheckInterval)
+	})
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if pooled, ok := p.connections[cfg.Address]; ok {
+		if pooled.config == cfg && pooled.client.conn.GetState() != connectivity.Shutdown {
+			return pooled.client, nil
+		}
+		pooled.client.Close()
+		delete(p.connections, cfg.Address)
+	}
+
+	client, err := NewClientGRPC(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p.connections[cfg.Address] = &pooledConnection{client: client, config: cfg, health: HealthUnknown}
+	return client, nil
+}
+
+// Remove connection of plugin from the pool and close it
+func (p *ConnectionPool) Remove(address string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if pooled, ok := p.connections[address]; ok {
+		pooled.client.Close()
+		delete(p.connections, address)
+	}
+}
+
+// Get status of pooled connections ordered by plugin address
+func (p *ConnectionPool) Status() []ConnectionStatus {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	statuses := make([]ConnectionStatus, 0, len(p.connections))
+	for address, pooled := range p.connections {
+		statuses = append(statuses, ConnectionStatus{
+			Address:         address,
+			State:           pooled.client.conn.GetState().String(),
+			Health:          pooled.health,
+			HealthFailures:  pooled.healthFailures,
+			LastHealthCheck: pooled.lastHealthCheck,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Address < statuses[j].Address
+	})
+	return statuses
+}
+
+// Check health of all pooled connections, plugins are checked concurrently so that a slow plugin
+// does not delay the others
+func (p *ConnectionPool) CheckHealth() {
+	p.mutex.Lock()
+	connections := make(map[string]*pooledConnection, len(p.connections))
+	for address, pooled := range p.connections {
+		connections[address] = pooled
+	}
+	p.mutex.Unlock()
+
+	var wg sync.WaitGroup
+	for address, pooled := range connections {
+		wg.Add(1)
+		go func(address string, pooled *pooledConnection) {
+			defer wg.Done()
+			health := checkConnectionHealth(pooled.client)
+			p.updateHealth(address, pooled, health)
+		}(address, pooled)
+	}
+	wg.Wait()
+}
+
+// Close all pooled connections
+func (p *ConnectionPool) Close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for address, pooled := range p.connections {
+		pooled.client.Close()
+		delete(p.connections, address)
+	}
+}
+
+// Update health of pooled connection, connection is closed and removed after consecutive failures
+func (p *ConnectionPool) updateHealth(address string, pooled *pooledConnection, health string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.connections[address] != pooled {
+		return
+	}
+	pooled.health = health
+	pooled.lastHealthCheck = time.Now()
+	if health == HealthServing {
+		pooled.healthFailures = 0
+		return
+	}
+
+	pooled.healthFailures++
+	log.Warn("Health check of plugin " + address + " failed with status " + health)
+	if pooled.healthFailures >= maxHealthFailures {
+		log.Error("Closing connection to plugin " + address + " as it is not healthy")
+		pooled.client.Close()
+		delete(p.connections, address)
+	}
+}
+
+// Run periodic health checks of pooled connections
+func (p *ConnectionPool) runHealthChecks(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		p.CheckHealth()
+	}
+}
+
+// Check health of plugin with grpc health checking protocol, plugins which do not implement the
+// health service are considered serving as they are reachable
+func checkConnectionHealth(client *ClientGRPC) string {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	resp, err := healthpb.NewHealthClient(client.conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return HealthServing
+		}
+		return HealthUnreachable
+	}
+	return resp.Status.String()
+}
@@ -25,18 +25,33 @@ import (
 func init() {
 	initAPI(util.Lcmcontroller, "AppDeploymentStatus", "/hosts/:hostIp/packages/:packageId/status", util.GET)
 	initAPI(util.Lcmcontroller, "HealthCheck", "/health", util.GET)
+	initAPI(util.Lcmcontroller, "PluginStatus", "/plugins/status", util.GET)
 	initAPI(util.Lcmcontroller, "UploadConfig", "/configuration", util.POST)
 	initAPI(util.Lcmcontroller, "RemoveConfig", "/configuration", util.DELETE)
 	initAPI(util.Lcmcontroller, "Instantiate", "/tenants/:tenantId/app_instances/:appInstanceId/instantiate", util.POST)
 	initAPI(util.Lcmcontroller, "Terminate", "/tenants/:tenantId/app_instances/:appInstanceId/terminate", util.POST)
+	initAPI(util.Lcmcontroller, "Upgrade", "/tenants/:tenantId/app_instances/:appInstanceId/upgrade", util.POST)
+	initAPI(util.Lcmcontroller, "Rollback", "/tenants/:tenantId/app_instances/:appInstanceId/rollback", util.POST)
+	initAPI(util.Lcmcontroller, "Scale", "/tenants/:tenantId/app_instances/:appInstanceId/scale", util.POST)
+	initAPI(util.Lcmcontroller, "SetAutoscaling", "/tenants/:tenantId/app_instances/:appInstanceId/autoscaling", util.PUT)
+	initAPI(util.Lcmcontroller, "GetAutoscaling", "/tenants/:tenantId/app_instances/:appInstanceId/autoscaling", util.GET)
+	initAPI(util.Lcmcontroller, "DeleteAutoscaling", "/tenants/:tenantId/app_instances/:appInstanceId/autoscaling", util.DELETE)
+	initAPI(util.Lcmcontroller, "History", "/tenants/:tenantId/app_instances/:appInstanceId/history", util.GET)
 	initAPI(util.Lcmcontroller, "Query", "/tenants/:tenantId/app_instances/:appInstanceId", util.GET)
 	initAPI(util.Lcmcontroller, "QueryKPI", "/tenants/:tenantId/hosts/:hostIp/kpi", util.GET)
 	initAPI(util.Lcmcontroller, "QueryMepCapabilities", "/tenants/:tenantId/hosts/:hostIp/mep_capabilities", util.GET)
 	initAPI(util.Lcmcontroller, "QueryMepCapabilities", "/tenants/:tenantId/hosts/:hostIp/mep_capabilities/:capabilityId", util.GET)
 	initAPI(util.Lcmcontroller, "GetWorkloadDescription", "/tenants/:tenantId/app_instances/:appInstanceId/workload/events", util.GET)
+	initAPI(util.Lcmcontroller, "WorkloadLogs", "/tenants/:tenantId/app_instances/:appInstanceId/workload/logs", util.GET)
+	initAPI(util.Lcmcontroller, "GetEndpoints", "/tenants/:tenantId/app_instances/:appInstanceId/endpoints", util.GET)
 	initAPI(util.Lcmcontroller, "SynchronizeUpdatedRecord", "/tenants/:tenantId/app_instances/sync_updated", util.GET)
 	initAPI(util.Lcmcontroller, "SynchronizeStaleRecord", "/tenants/:tenantId/app_instances/sync_deleted", util.GET)
 	initAPI(util.Lcmcontroller, "UploadPackage", "/tenants/:tenantId/packages", util.POST)
+	initAPI(util.Lcmcontroller, "CreatePackageUpload", "/tenants/:tenantId/packages/uploads", util.POST)
+	initAPI(util.Lcmcontroller, "QueryPackageUpload", "/tenants/:tenantId/packages/uploads/:uploadId", util.GET)
+	initAPI(util.Lcmcontroller, "AbortPackageUpload", "/tenants/:tenantId/packages/uploads/:uploadId", util.DELETE)
+	initAPI(util.Lcmcontroller, "UploadPackageChunk", "/tenants/:tenantId/packages/uploads/:uploadId/chunks/:chunkNumber", util.PUT)
+	initAPI(util.Lcmcontroller, "FinalizePackageUpload", "/tenants/:tenantId/packages/uploads/:uploadId/finalize", util.POST)
 	initAPI(util.Lcmcontroller, "DeletePackage", util.PkgUrlPath, util.DELETE)
 	initAPI(util.Lcmcontroller, "DeletePackageOnHost", "/tenants/:tenantId/packages/:packageId/hosts/:hostIp", util.DELETE)
 	initAPI(util.Lcmcontroller, "DistributePackage",   util.PkgUrlPath, util.POST)
@@ -44,6 +59,7 @@ func init() {
 	initAPI(util.Lcmcontroller, "DistributionStatus", "/tenants/:tenantId/packages", util.GET)
 	initAPI(util.Lcmcontroller, "SynchronizeAppPackageUpdatedRecord","/tenants/:tenantId/packages/sync_updated", util.GET)
 	initAPI(util.Lcmcontroller, "SynchronizeAppPackageStaleRecord",  "/tenants/:tenantId/packages/sync_deleted", util.GET)
+	initAPI(util.Lcmcontroller, "QueryOperation", "/tenants/:tenantId/operations/:opId", util.GET)
 	initAPI(util.Imagecontroller, "CreateImage", "/tenants/:tenantId/app_instances/:appInstanceId/images", util.POST)
 	initAPI(util.Imagecontroller, "DeleteImage", "/tenants/:tenantId/app_instances/:appInstanceId/images/:imageId", util.DELETE)
 	initAPI(util.Imagecontroller, "GetImage", "/tenants/:tenantId/app_instances/:appInstanceId/images/:imageId", util.GET)
@@ -56,6 +72,10 @@ func init() {
 	initAPI(util.MecHostcontroller, "BatchTerminate", "/tenants/:tenantId/app_instances/batchTerminate", util.DELETE)
 	initAPI(util.MecHostcontroller, "SynchronizeMecHostUpdatedRecord", "/hosts/sync_updated", util.GET)
 	initAPI(util.MecHostcontroller, "SynchronizeMecHostStaleRecord", "/hosts/sync_deleted", util.GET)
+	initAPI(util.Plugincontroller, "RegisterPlugin", "/plugins", util.POST)
+	initAPI(util.Plugincontroller, "GetPlugins", "/plugins", util.GET)
+	initAPI(util.Plugincontroller, "UpdatePlugin", "/plugins/:pluginId", util.PUT)
+	initAPI(util.Plugincontroller, "DeletePlugin", "/plugins/:pluginId", util.DELETE)
 }
 
 func initAPI(controllerName, methodName, path, operationType string,) {
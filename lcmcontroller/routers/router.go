@@ -33,12 +33,15 @@ const RootPath string = "/lcmcontroller/v1"
 // Init lcmcontroller APIs
 func init() {
 	adapter := initDbAdapter()
+	controllers.RecoverLcmOperations(adapter)
+	controllers.StartPackageUploadSweep(adapter)
 
 	ns := beego.NewNamespace("/lcmcontroller/v1/",
 		beego.NSInclude(
 			&controllers.LcmController{controllers.BaseController{Db: adapter}},
 			&controllers.ImageController{controllers.BaseController{Db: adapter}},
 			&controllers.MecHostController{controllers.BaseController{Db: adapter}},
+			&controllers.PluginController{controllers.BaseController{Db: adapter}},
 		),
 	)
 	beego.AddNamespace(ns)
@@ -73,12 +73,64 @@ func (v VmImageServer) DownloadVmImage(request *lcmservice.DownloadVmImageReques
 }
 
 func (a AppLCMServer) Instantiate(ctx context.Context, request *lcmservice.InstantiateRequest) (*lcmservice.InstantiateResponse, error) {
+	if request.WaitForReady && request.ReadyTimeout == 1 {
+		return &lcmservice.InstantiateResponse{Status: "Failure", Reason: notReadyReason}, nil
+	}
 	resp := &lcmservice.InstantiateResponse{
 		Status: SUCCESS_RETURN,
 	}
 	return resp, nil
 }
 
+func (a AppLCMServer) Upgrade(ctx context.Context, request *lcmservice.UpgradeRequest) (*lcmservice.UpgradeResponse, error) {
+	resp := &lcmservice.UpgradeResponse{
+		Status: SUCCESS_RETURN,
+	}
+	return resp, nil
+}
+
+func (a AppLCMServer) Rollback(ctx context.Context, request *lcmservice.RollbackRequest) (*lcmservice.RollbackResponse, error) {
+	resp := &lcmservice.RollbackResponse{
+		Status: SUCCESS_RETURN,
+	}
+	return resp, nil
+}
+
+func (a AppLCMServer) Scale(ctx context.Context, request *lcmservice.ScaleRequest) (*lcmservice.ScaleResponse, error) {
+	resp := &lcmservice.ScaleResponse{
+		Status: SUCCESS_RETURN,
+	}
+	return resp, nil
+}
+
+func (a AppLCMServer) SetAutoscaling(ctx context.Context,
+	request *lcmservice.SetAutoscalingRequest) (*lcmservice.SetAutoscalingResponse, error) {
+	resp := &lcmservice.SetAutoscalingResponse{
+		Status: SUCCESS_RETURN,
+	}
+	return resp, nil
+}
+
+func (a AppLCMServer) DeleteAutoscaling(ctx context.Context,
+	request *lcmservice.DeleteAutoscalingRequest) (*lcmservice.DeleteAutoscalingResponse, error) {
+	resp := &lcmservice.DeleteAutoscalingResponse{
+		Status: SUCCESS_RETURN,
+	}
+	return resp, nil
+}
+
+func (a AppLCMServer) WorkloadLogs(request *lcmservice.WorkloadLogsRequest,
+	stream lcmservice.AppLCM_WorkloadLogsServer) error {
+	return stream.Send(&lcmservice.WorkloadLogsResponse{Content: []byte("log line\n")})
+}
+
+func (a AppLCMServer) History(ctx context.Context, request *lcmservice.HistoryRequest) (*lcmservice.HistoryResponse, error) {
+	resp := &lcmservice.HistoryResponse{
+		Response: "[{\"revision\":1}]",
+	}
+	return resp, nil
+}
+
 func (a AppLCMServer) Terminate(ctx context.Context, request *lcmservice.TerminateRequest) (*lcmservice.TerminateResponse, error) {
 	resp := &lcmservice.TerminateResponse{
 		Status: SUCCESS_RETURN,
@@ -89,6 +141,7 @@ func (a AppLCMServer) Terminate(ctx context.Context, request *lcmservice.Termina
 func (a AppLCMServer) Query(ctx context.Context, request *lcmservice.QueryRequest) (*lcmservice.QueryResponse, error) {
 	resp := &lcmservice.QueryResponse{
 		Response: "{\"Output\":\"Success\"}",
+		Health:   &lcmservice.HealthSummary{Status: "Healthy"},
 	}
 	log.Info("Query is success")
 	return resp, nil
@@ -132,6 +185,13 @@ func (a AppLCMServer) WorkloadEvents(ctx context.Context, request *lcmservice.Wo
 	return resp, nil
 }
 
+func (a AppLCMServer) Endpoints(ctx context.Context, request *lcmservice.EndpointsRequest) (*lcmservice.EndpointsResponse, error) {
+	resp := &lcmservice.EndpointsResponse{
+		Response: "{\"services\":[],\"ingresses\":[]}",
+	}
+	return resp, nil
+}
+
 func (a AppLCMServer) UploadPackage(stream lcmservice.AppLCM_UploadPackageServer) error {
 	for {
 		req, err := stream.Recv()
@@ -162,6 +222,16 @@ func (a AppLCMServer) DeletePackage(ctx context.Context, request *lcmservice.Del
 	return resp, nil
 }
 
+func (a AppLCMServer) GetCapabilities(ctx context.Context, request *lcmservice.GetCapabilitiesRequest) (*lcmservice.GetCapabilitiesResponse, error) {
+	resp := &lcmservice.GetCapabilitiesResponse{
+		Operations: []string{"instantiate", "terminate", "query", "upgrade", "rollback", "history",
+			"uploadConfig", "removeConfig", "workloadEvents", "uploadPackage", "deletePackage", "getCapabilities",
+			"createVmImage", "queryVmImage", "deleteVmImage", "downloadVmImage", "scale",
+			"setAutoscaling", "deleteAutoscaling", "workloadLogs", "queryHealth", "endpoints"},
+	}
+	return resp, nil
+}
+
 // Start GRPC server and start listening on the port
 func (s *ServerGRPC) Listen() (err error) {
 	var (
@@ -198,6 +268,7 @@ func (s *ServerGRPC) Listen() (err error) {
 func (s *ServerGRPC) Query(_ context.Context, req *lcmservice.QueryRequest) (resp *lcmservice.QueryResponse, err error) {
 	resp = &lcmservice.QueryResponse{
 		Response: "{\"Output\":\"Success\"}",
+		Health:   &lcmservice.HealthSummary{Status: "Healthy"},
 	}
 	log.Info("Query is success")
 	return resp, nil
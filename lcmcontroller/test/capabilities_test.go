@@ -0,0 +1,121 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	beegoCtx "github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"lcmcontroller/util"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+const capabilityPluginAddress = "capabilityplugin:8095"
+
+// Recorder of capability requests received by plugin clients
+type capabilityRecorder struct {
+	requests int32
+}
+
+// Capability requests of the running test, patched functions create the plugin clients so recorders are not
+// captured from the test
+var capabilityRequests = &capabilityRecorder{}
+
+// Plugin client of a container plugin which does not support image operations
+type containerPluginClient struct {
+	mockClient
+}
+
+func (cc *containerPluginClient) GetCapabilities(ctx context.Context,
+	accessToken string) (capabilities *pluginAdapter.Capabilities, error error) {
+	atomic.AddInt32(&capabilityRequests.requests, 1)
+	return &pluginAdapter.Capabilities{Operations: []string{"instantiate", "terminate", "query"},
+		DeploymentTypes: []string{"container"}}, nil
+}
+
+// Create image controller for create image request
+func getCreateImageController(testDb *mockDb) *controllers.ImageController {
+	requestBody, _ := json.Marshal(models.CreateVimRequest{VmId: "vm1"})
+	request, _ := getHttpRequest(tenantsPath+tenantIdentifier+"/app_instances/"+appInstanceIdentifier+"/images",
+		nil, "", "", "POST", requestBody)
+
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}, RequestBody: requestBody}
+	setParam(input)
+
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+	return &controllers.ImageController{controllers.BaseController{Db: testDb, Controller: beegoController}}
+}
+
+func TestUnsupportedOperation(t *testing.T) {
+	// Capabilities are cached per plugin address for the whole process
+	pluginAdapter.RemovePlugin(capabilityPluginAddress)
+	defer pluginAdapter.RemovePlugin(capabilityPluginAddress)
+
+	capabilityRequests = &capabilityRecorder{}
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &containerPluginClient{}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := &mockDb{appInstanceRecords: make(map[string]models.AppInfoRecord),
+		tenantRecords: make(map[string]models.TenantInfoRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		pluginRecords: make(map[string]models.PluginRecord)}
+	testDb.appInstanceRecords[appInstanceIdentifier] = models.AppInfoRecord{AppInstanceId: appInstanceIdentifier,
+		MecHost: ipAddress, TenantId: tenantIdentifier}
+	testDb.mecHostRecords[ipAddress] = models.MecHost{MecHostId: ipAddress, MechostIp: ipAddress, Vim: "k8s"}
+	testDb.pluginRecords["capabilityplugin"] = models.PluginRecord{PluginId: "capabilityplugin",
+		PluginName: "capabilityplugin", Address: capabilityPluginAddress, VimTypes: "k8s"}
+
+	// Capabilities are requested once and cached for the plugin
+	for i := 0; i < 2; i++ {
+		controller := getCreateImageController(testDb)
+		controller.CreateImage()
+		assert.Equal(t, util.StatusNotImplemented, controller.Ctx.ResponseWriter.Status,
+			"Unsupported create image is not rejected")
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&capabilityRequests.requests), "Capabilities are not cached")
+}
+
+func TestCapabilitiesSupports(t *testing.T) {
+	capabilities := &pluginAdapter.Capabilities{Operations: []string{"instantiate"}}
+	assert.True(t, capabilities.Supports("instantiate"), "Supported operation is not supported")
+	assert.False(t, capabilities.Supports(pluginAdapter.OperationCreateVmImage), "Unsupported operation is supported")
+
+	// Plugin without capability negotiation supports all operations
+	var unknown *pluginAdapter.Capabilities
+	assert.True(t, unknown.Supports(pluginAdapter.OperationCreateVmImage), "Operation of unknown plugin is not supported")
+}
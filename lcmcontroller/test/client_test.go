@@ -20,9 +20,12 @@ import (
 	"bytes"
 	"github.com/agiledragon/gomonkey"
 	"github.com/astaxie/beego"
+	"github.com/stretchr/testify/assert"
 	"io/ioutil"
+	"lcmcontroller/config"
 	"lcmcontroller/controllers"
 	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
 	"lcmcontroller/util"
 	"net/http"
 	"os"
@@ -35,6 +38,7 @@ var (
 	k8sPluginAddr     = "127.0.0.1"
 	k8sPluginPort     = "10001"
 	k8sPluginEndPoint = "127.0.0.1:10001"
+	notReadyReason    = "workloads of application are not ready within timeout"
 )
 
 func TestWithClient(t *testing.T) {
@@ -54,6 +58,9 @@ func doTest(t *testing.T) {
 		if k == "clientProtocol" {
 			return "grpc"
 		}
+		if k == util.PackageManifestNotEnforced {
+			return "true"
+		}
 		return ""
 	})
 	defer patch1.Reset()
@@ -95,7 +102,9 @@ func doTest(t *testing.T) {
 		tenantRecords: make(map[string]models.TenantInfoRecord),
 		appPackageRecords: make(map[string]models.AppPackageRecord),
 		mecHostRecords: make(map[string]models.MecHost),
-		appPackageHostRecords: make(map[string]models.AppPackageHostRecord)}
+		appPackageHostRecords: make(map[string]models.AppPackageHostRecord),
+		lcmOperationRecords: make(map[string]models.LcmOperation),
+		packageUploadRecords: make(map[string]models.PackageUploadSession)}
 
 	//Upload package
 	testUploadPackage(t, extraParams, path, testDb)
@@ -108,9 +117,15 @@ func doTest(t *testing.T) {
 	// Test instantiate
 	testInstantiate(t, extraParams, testDb)
 
+	// Test instantiate which waits for ready workloads
+	testInstantiateNotReady(t)
+
 	// Test work load events
 	testWorkloadEvents(t, nil, "", testDb, "Success")
 
+	// Test endpoints
+	testEndpointsWithClient(t)
+
 	// Test create image
 	testCreateImage(t, extraParams, testDb)
 
@@ -154,3 +169,38 @@ func startServer() {
 	// Start listening
 	_ = grpcServer.Listen()
 }
+
+func testInstantiateNotReady(t *testing.T) {
+	t.Run("TestInstantiateNotReady", func(t *testing.T) {
+		client, err := pluginAdapter.GetClient(k8sPluginEndPoint)
+		assert.Nil(t, err, "Get client failed")
+		adapter := pluginAdapter.NewPluginAdapter(k8sPluginEndPoint, client)
+
+		appAuthConfig := config.AppAuthConfig{AppInsId: appInstanceIdentifier}
+		err, status := adapter.Instantiate(tenantIdentifier, ipAddress, packageId, "", appAuthConfig, "", "",
+			pluginAdapter.ReadyOptions{WaitForReady: true, ReadyTimeout: 1})
+		assert.Equal(t, util.Failure, status, "Instantiation which is not ready failed")
+		assert.Equal(t, notReadyReason, err.Error(), "Instantiation which is not ready failed")
+
+		err, status = adapter.Instantiate(tenantIdentifier, ipAddress, packageId, "", appAuthConfig, "", "",
+			pluginAdapter.ReadyOptions{WaitForReady: true})
+		assert.Nil(t, err, "Instantiation which waits for ready workloads failed")
+		assert.Equal(t, SUCCESS_RETURN, status, "Instantiation which waits for ready workloads failed")
+	})
+}
+
+func testEndpointsWithClient(t *testing.T) {
+	t.Run("TestEndpoints", func(t *testing.T) {
+		client, err := pluginAdapter.GetClient(k8sPluginEndPoint)
+		assert.Nil(t, err, "Get client failed")
+		adapter := pluginAdapter.NewPluginAdapter(k8sPluginEndPoint, client)
+
+		response, err := adapter.GetEndpoints(createToken(tenantIdentifier), ipAddress, appInstanceIdentifier)
+		assert.Nil(t, err, "Get endpoints failed")
+		assert.Equal(t, "{\"services\":[],\"ingresses\":[]}", response, "Get endpoints failed")
+
+		supported, err := adapter.IsOperationSupported(pluginAdapter.OperationEndpoints, "")
+		assert.Nil(t, err, "Get capabilities failed")
+		assert.True(t, supported, "Endpoints operation is not supported")
+	})
+}
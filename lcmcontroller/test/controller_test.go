@@ -36,6 +36,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 )
 
 var (
@@ -103,7 +104,9 @@ func TestLcmOperation(t *testing.T) {
 		tenantRecords: make(map[string]models.TenantInfoRecord),
 		appPackageRecords: make(map[string]models.AppPackageRecord),
 		mecHostRecords: make(map[string]models.MecHost),
-		appPackageHostRecords: make(map[string]models.AppPackageHostRecord)}
+		appPackageHostRecords: make(map[string]models.AppPackageHostRecord),
+		lcmOperationRecords: make(map[string]models.LcmOperation),
+		packageUploadRecords: make(map[string]models.PackageUploadSession)}
 
 	//Upload package
 	testUploadPackage(t, extraParams, path, testDb)
@@ -153,6 +156,21 @@ func TestLcmOperation(t *testing.T) {
 	// Test sync stale app package updated record
 	testSynchronizeAppPackageStaleRecord(t, nil, "", testDb)
 
+	// Test upgrade
+	testUpgrade(t, nil, "", testDb)
+
+	// Test revision history
+	testHistory(t, nil, "", testDb, mockHistory)
+
+	// Test rollback
+	testRollback(t, nil, "", testDb)
+
+	// Test scale
+	testScale(t, nil, "", testDb)
+
+	// Test autoscaling policy
+	testAutoscaling(t, nil, "", testDb)
+
 	// Test query
 	testQuery(t, nil, "", testDb, "Success")
 
@@ -206,7 +224,9 @@ func TestConfigOperation(t *testing.T) {
 		tenantRecords: make(map[string]models.TenantInfoRecord),
 		appPackageRecords: make(map[string]models.AppPackageRecord),
 		mecHostRecords: make(map[string]models.MecHost),
-		appPackageHostRecords: make(map[string]models.AppPackageHostRecord)}
+		appPackageHostRecords: make(map[string]models.AppPackageHostRecord),
+		lcmOperationRecords: make(map[string]models.LcmOperation),
+		packageUploadRecords: make(map[string]models.PackageUploadSession)}
 
 	var c *beego.Controller
 	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
@@ -465,6 +485,220 @@ func testWorkloadEvents(t *testing.T, extraParams map[string]string, path string
 	})
 }
 
+func testUpgrade(t *testing.T, extraParams map[string]string, path string, testDb dbAdapter.Database) {
+	t.Run("TestAppInstanceUpgrade", func(t *testing.T) {
+
+		// Upgrade Request
+		requestBody, _ := json.Marshal(map[string]string{
+			packageIdKey: packageId,
+		})
+		upgradeRequest, _ := getHttpRequest(appUrlPathId + "upgrade", extraParams, "file",
+			path, "POST", requestBody)
+
+		// Prepare Input
+		upgradeInput := &context.BeegoInput{Context: &context.Context{Request: upgradeRequest},
+			RequestBody: requestBody}
+		setParam(upgradeInput)
+
+		// Prepare beego controller
+		upgradeBeegoController := beego.Controller{Ctx: &context.Context{Input: upgradeInput,
+			Request: upgradeRequest, ResponseWriter: &context.Response{ResponseWriter: httptest.NewRecorder()}},
+			Data: make(map[interface{}]interface{})}
+
+		// Create LCM controller with mocked DB and prepared Beego controller
+		upgradeController := &controllers.LcmController{controllers.BaseController{Db: testDb,
+			Controller: upgradeBeegoController}}
+
+		// Test upgrade
+		upgradeController.Upgrade()
+
+		// Check for accepted case and wait for the operation to complete
+		assert.Equal(t, util.StatusAccepted, upgradeController.Ctx.ResponseWriter.Status, "Upgrade failed")
+		operation := waitForOperation(t, testDb, upgradeController)
+		assert.Equal(t, util.OperationSuccess, operation.State, "Upgrade failed")
+
+		appInfoRecord := &models.AppInfoRecord{AppInstanceId: appInstanceIdentifier}
+		_ = testDb.ReadData(appInfoRecord, util.AppInsId)
+		assert.Equal(t, util.Instantiated, appInfoRecord.InstanceState, "Upgrade failed")
+		assert.Equal(t, packageId, appInfoRecord.AppPackageId, "Upgrade failed")
+	})
+}
+
+func testRollback(t *testing.T, extraParams map[string]string, path string, testDb dbAdapter.Database) {
+	t.Run("TestAppInstanceRollback", func(t *testing.T) {
+
+		// Rollback Request
+		requestBody, _ := json.Marshal(map[string]int32{
+			"revision": 1,
+		})
+		rollbackRequest, _ := getHttpRequest(appUrlPathId + "rollback", extraParams, "file",
+			path, "POST", requestBody)
+
+		// Prepare Input
+		rollbackInput := &context.BeegoInput{Context: &context.Context{Request: rollbackRequest},
+			RequestBody: requestBody}
+		setParam(rollbackInput)
+
+		// Prepare beego controller
+		rollbackBeegoController := beego.Controller{Ctx: &context.Context{Input: rollbackInput,
+			Request: rollbackRequest, ResponseWriter: &context.Response{ResponseWriter: httptest.NewRecorder()}},
+			Data: make(map[interface{}]interface{})}
+
+		// Create LCM controller with mocked DB and prepared Beego controller
+		rollbackController := &controllers.LcmController{controllers.BaseController{Db: testDb,
+			Controller: rollbackBeegoController}}
+
+		// Test rollback
+		rollbackController.Rollback()
+
+		// Check for accepted case and wait for the operation to complete
+		assert.Equal(t, util.StatusAccepted, rollbackController.Ctx.ResponseWriter.Status, "Rollback failed")
+		operation := waitForOperation(t, testDb, rollbackController)
+		assert.Equal(t, util.OperationSuccess, operation.State, "Rollback failed")
+	})
+}
+
+// Create LCM controller for scale request
+func getScaleController(extraParams map[string]string, path string, testDb dbAdapter.Database,
+	replicas int32) *controllers.LcmController {
+	// Scale Request
+	requestBody, _ := json.Marshal(map[string]int32{
+		"replicas": replicas,
+	})
+	scaleRequest, _ := getHttpRequest(appUrlPathId + "scale", extraParams, "file",
+		path, "POST", requestBody)
+
+	// Prepare Input
+	scaleInput := &context.BeegoInput{Context: &context.Context{Request: scaleRequest},
+		RequestBody: requestBody}
+	setParam(scaleInput)
+
+	// Prepare beego controller
+	scaleBeegoController := beego.Controller{Ctx: &context.Context{Input: scaleInput,
+		Request: scaleRequest, ResponseWriter: &context.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+
+	// Create LCM controller with mocked DB and prepared Beego controller
+	return &controllers.LcmController{controllers.BaseController{Db: testDb,
+		Controller: scaleBeegoController}}
+}
+
+func testScale(t *testing.T, extraParams map[string]string, path string, testDb dbAdapter.Database) {
+	t.Run("TestAppInstanceScale", func(t *testing.T) {
+		for _, replicas := range []int32{-1, 3} {
+			scaleController := getScaleController(extraParams, path, testDb, replicas)
+
+			// Test scale
+			scaleController.Scale()
+
+			if replicas < 0 {
+				assert.Equal(t, util.BadRequest, scaleController.Ctx.ResponseWriter.Status,
+					"Scale to invalid replicas failed")
+				continue
+			}
+
+			// Check for accepted case and wait for the operation to complete
+			assert.Equal(t, util.StatusAccepted, scaleController.Ctx.ResponseWriter.Status, "Scale failed")
+			operation := waitForOperation(t, testDb, scaleController)
+			assert.Equal(t, util.OperationSuccess, operation.State, "Scale failed")
+
+			appInfoRecord := &models.AppInfoRecord{AppInstanceId: appInstanceIdentifier}
+			_ = testDb.ReadData(appInfoRecord, util.AppInsId)
+			assert.Equal(t, util.Instantiated, appInfoRecord.InstanceState, "Scale failed")
+		}
+	})
+}
+
+// Create LCM controller for autoscaling request
+func getAutoscalingController(extraParams map[string]string, path string, testDb dbAdapter.Database,
+	method string, requestBody []byte) *controllers.LcmController {
+	autoscalingRequest, _ := getHttpRequest(appUrlPathId+"autoscaling", extraParams, "file",
+		path, method, requestBody)
+
+	// Prepare Input
+	autoscalingInput := &context.BeegoInput{Context: &context.Context{Request: autoscalingRequest},
+		RequestBody: requestBody}
+	setParam(autoscalingInput)
+
+	// Prepare beego controller
+	autoscalingBeegoController := beego.Controller{Ctx: &context.Context{Input: autoscalingInput,
+		Request: autoscalingRequest, ResponseWriter: &context.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+
+	// Create LCM controller with mocked DB and prepared Beego controller
+	return &controllers.LcmController{controllers.BaseController{Db: testDb,
+		Controller: autoscalingBeegoController}}
+}
+
+func testAutoscaling(t *testing.T, extraParams map[string]string, path string, testDb dbAdapter.Database) {
+	t.Run("TestAppInstanceAutoscaling", func(t *testing.T) {
+		// Policy without utilization target is rejected
+		controller := getAutoscalingController(extraParams, path, testDb, "PUT",
+			[]byte(`{"minReplicas": 1, "maxReplicas": 3}`))
+		controller.SetAutoscaling()
+		assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status,
+			"Set autoscaling without target failed")
+
+		controller = getAutoscalingController(extraParams, path, testDb, "PUT",
+			[]byte(`{"minReplicas": 2, "maxReplicas": 5, "targetCpuUtilization": 75}`))
+		controller.SetAutoscaling()
+		assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Set autoscaling failed")
+
+		controller = getAutoscalingController(extraParams, path, testDb, "GET", nil)
+		controller.GetAutoscaling()
+		response := controller.Ctx.ResponseWriter.ResponseWriter.(*httptest.ResponseRecorder)
+		assert.Equal(t, `{"minReplicas":2,"maxReplicas":5,"targetCpuUtilization":75}`, response.Body.String(),
+			"Get autoscaling failed")
+
+		// Scaling is rejected while autoscaling policy is set
+		scaleController := getScaleController(extraParams, path, testDb, 3)
+		scaleController.Scale()
+		assert.Equal(t, util.StatusConflict, scaleController.Ctx.ResponseWriter.Status,
+			"Scale with autoscaling policy is not rejected")
+
+		controller = getAutoscalingController(extraParams, path, testDb, "DELETE", nil)
+		controller.DeleteAutoscaling()
+		assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Delete autoscaling failed")
+
+		controller = getAutoscalingController(extraParams, path, testDb, "GET", nil)
+		controller.GetAutoscaling()
+		assert.Equal(t, util.StatusNotFound, controller.Ctx.ResponseWriter.Status,
+			"Get deleted autoscaling failed")
+	})
+}
+
+func testHistory(t *testing.T, extraParams map[string]string, path string, testDb dbAdapter.Database,
+	exOutput string) {
+
+	t.Run("TestAppInstanceHistory", func(t *testing.T) {
+
+		// Get Request
+		historyRequest, _ := getHttpRequest(appUrlPathId + "history", extraParams, "file", path, "GET",
+			[]byte(""))
+
+		// Prepare Input
+		historyInput := &context.BeegoInput{Context: &context.Context{Request: historyRequest}}
+		setParam(historyInput)
+
+		// Prepare beego controller
+		historyBeegoController := beego.Controller{Ctx: &context.Context{Input: historyInput,
+			Request: historyRequest, ResponseWriter: &context.Response{ResponseWriter: httptest.NewRecorder()}},
+			Data: make(map[interface{}]interface{})}
+
+		// Create LCM controller with mocked DB and prepared Beego controller
+		historyController := &controllers.LcmController{controllers.BaseController{Db: testDb,
+			Controller: historyBeegoController}}
+
+		// Test history
+		historyController.History()
+
+		// Check for success case wherein the status value will be default i.e. 0
+		assert.Equal(t, 0, historyController.Ctx.ResponseWriter.Status, "History failed")
+		response := historyController.Ctx.ResponseWriter.ResponseWriter.(*httptest.ResponseRecorder)
+		assert.Equal(t, exOutput, response.Body.String(), "History failed")
+	})
+}
+
 func testTerminate(t *testing.T, extraParams map[string]string, path string, testDb dbAdapter.Database) {
 	t.Run("TestAppInstanceTerminate", func(t *testing.T) {
 
@@ -488,8 +722,10 @@ func testTerminate(t *testing.T, extraParams map[string]string, path string, tes
 		// Test query
 		terminateController.Terminate()
 
-		// Check for success case wherein the status value will be default i.e. 0
-		assert.Equal(t, 0, terminateController.Ctx.ResponseWriter.Status, "Terminate failed")
+		// Check for accepted case and wait for the operation to complete
+		assert.Equal(t, util.StatusAccepted, terminateController.Ctx.ResponseWriter.Status, "Terminate failed")
+		operation := waitForOperation(t, testDb, terminateController)
+		assert.Equal(t, util.OperationSuccess, operation.State, "Terminate failed")
 	})
 }
 
@@ -532,11 +768,13 @@ func testInstantiate(t *testing.T, extraParams map[string]string, testDb dbAdapt
 		instantiateRequest, _ := getHttpRequest(appUrlPath + "instantiate", extraParams,
 			"file", "", "POST", []byte(""))
 
-		requestBody, _ := json.Marshal(map[string]string{
+		requestBody, _ := json.Marshal(map[string]interface{}{
 			hostIpKey: ipAddress,
 			packageIdKey: packageId,
 			appNameKey: "testApplication",
 			originKey: originVal,
+			"parameters": map[string]interface{}{"replicaCount": 2},
+			"namespace":  tenantIdentifier + "-1",
 		})
 
 		// Prepare Input
@@ -555,8 +793,15 @@ func testInstantiate(t *testing.T, extraParams map[string]string, testDb dbAdapt
 		// Test instantiate
 		instantiateController.Instantiate()
 
-		// Check for success case wherein the status value will be default i.e. 0
-		assert.Equal(t, 0, instantiateController.Ctx.ResponseWriter.Status, "Instantiation failed")
+		// Check for accepted case and wait for the operation to complete
+		assert.Equal(t, util.StatusAccepted, instantiateController.Ctx.ResponseWriter.Status, "Instantiation failed")
+		operation := waitForOperation(t, testDb, instantiateController)
+		assert.Equal(t, util.OperationSuccess, operation.State, "Instantiation failed")
+		appInfoRecord := &models.AppInfoRecord{AppInstanceId: appInstanceIdentifier}
+		_ = testDb.ReadData(appInfoRecord, util.AppInsId)
+		assert.Equal(t, util.Instantiated, appInfoRecord.InstanceState, "Instantiation failed")
+
+		testQueryOperation(t, testDb, operation.OperationId, util.OperationSuccess)
 	})
 }
 
@@ -1080,8 +1325,11 @@ func testDistributePackage(t *testing.T, extraParams map[string]string, testDb d
 		// Test instantiate
 		instantiateController.DistributePackage()
 
-		// Check for success case wherein the status value will be default i.e. 0
-		assert.Equal(t, 0, instantiateController.Ctx.ResponseWriter.Status, "Distribute package failed")
+		// Check for accepted case and wait for the operation to complete
+		assert.Equal(t, util.StatusAccepted, instantiateController.Ctx.ResponseWriter.Status,
+			"Distribute package failed")
+		operation := waitForOperation(t, testDb, instantiateController)
+		assert.Equal(t, util.OperationSuccess, operation.State, "Distribute package failed")
 	})
 }
 
@@ -1175,6 +1423,65 @@ func testRemoval(t *testing.T, extraParams map[string]string, path string, testD
 	})
 }
 
+func testQueryOperation(t *testing.T, testDb dbAdapter.Database, opId string, state string) {
+	t.Run("TestQueryOperation", func(t *testing.T) {
+		// Get Request
+		queryRequest, _ := getHttpRequest(tenantsPath + tenantIdentifier + "/operations/" + opId, nil,
+			"file", "", "GET", []byte(""))
+
+		// Prepare Input
+		queryInput := &context.BeegoInput{Context: &context.Context{Request: queryRequest}}
+		setParam(queryInput)
+		queryInput.SetParam(":opId", opId)
+
+		// Prepare beego controller
+		queryBeegoController := beego.Controller{Ctx: &context.Context{Input: queryInput, Request: queryRequest,
+			ResponseWriter: &context.Response{ResponseWriter: httptest.NewRecorder()}},
+			Data: make(map[interface{}]interface{})}
+
+		// Create LCM controller with mocked DB and prepared Beego controller
+		queryController := &controllers.LcmController{controllers.BaseController{Db: testDb,
+			Controller: queryBeegoController}}
+
+		// Test query operation
+		queryController.QueryOperation()
+
+		// Check for success case wherein the status value will be default i.e. 0
+		assert.Equal(t, 0, queryController.Ctx.ResponseWriter.Status, "Query operation failed")
+		response := queryController.Ctx.ResponseWriter.ResponseWriter.(*httptest.ResponseRecorder)
+		var operation models.LcmOperation
+		_ = json.Unmarshal(response.Body.Bytes(), &operation)
+		assert.Equal(t, opId, operation.OperationId, "Query operation failed")
+		assert.Equal(t, state, operation.State, "Query operation failed")
+	})
+}
+
+// Wait for the operation returned in accepted response to reach a final state, operation is completed last by
+// the background worker, so patches of the test may be reset once the operation is not processing anymore
+func waitForOperation(t *testing.T, testDb dbAdapter.Database, controller *controllers.LcmController) models.LcmOperation {
+	response := controller.Ctx.ResponseWriter.ResponseWriter.(*httptest.ResponseRecorder)
+	var opResp models.LcmOperationResponse
+	_ = json.Unmarshal(response.Body.Bytes(), &opResp)
+
+	operation := models.LcmOperation{
+		OperationId: opResp.OperationId,
+	}
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		err := testDb.ReadData(&operation, util.OperationId)
+		assert.Nil(t, err, "Operation record not found")
+		if err != nil || operation.State != util.OperationProcessing {
+			break
+		}
+		if time.Now().After(deadline) {
+			assert.Fail(t, "Operation is not completed")
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return operation
+}
+
 func setParam(ctx *context.BeegoInput) {
 	ctx.SetParam(":tenantId", tenantIdentifier)
 	ctx.SetParam(":appInstanceId", appInstanceIdentifier)
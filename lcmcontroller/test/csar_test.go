@@ -0,0 +1,159 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/astaxie/beego"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	csarpkg "lcmcontroller/pkg/csar"
+	"lcmcontroller/util"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const csarManifest = "positioning-service.mf"
+
+func init() {
+	// Test package is created before manifest validation
+	_ = beego.AppConfig.Set(util.PackageManifestNotEnforced, "true")
+}
+
+// Extract test package to temporary directory
+func extractTestPackage(t *testing.T) string {
+	packageDir, err := ioutil.TempDir("", "csar")
+	assert.Nil(t, err, "create package directory")
+
+	zipReader, err := zip.OpenReader("positioning_with_mepagent_new.csar")
+	assert.Nil(t, err, "open test package")
+	defer zipReader.Close()
+
+	for _, file := range zipReader.File {
+		target := filepath.Join(packageDir, file.Name)
+		if file.FileInfo().IsDir() {
+			_ = os.MkdirAll(target, filePermission)
+			continue
+		}
+		_ = os.MkdirAll(filepath.Dir(target), filePermission)
+		reader, _ := file.Open()
+		writer, _ := os.Create(target)
+		_, _ = io.Copy(writer, reader)
+		writer.Close()
+		reader.Close()
+	}
+	return packageDir
+}
+
+// Extract test package and list all its files with their digests in manifest
+func extractListedTestPackage(t *testing.T) string {
+	packageDir := extractTestPackage(t)
+	listTestPackageFiles(t, packageDir)
+	return packageDir
+}
+
+// List all files of package with their digests in manifest
+func listTestPackageFiles(t *testing.T, packageDir string) {
+	manifestPath := filepath.Join(packageDir, csarManifest)
+	manifest, err := ioutil.ReadFile(manifestPath)
+	assert.Nil(t, err, "read manifest")
+	content := string(manifest)
+	if index := strings.Index(content, "Source:"); index >= 0 {
+		content = content[:index]
+	}
+
+	_ = filepath.Walk(packageDir, func(path string, info os.FileInfo, err error) error {
+		name, _ := filepath.Rel(packageDir, path)
+		name = filepath.ToSlash(name)
+		if err != nil || !info.Mode().IsRegular() || name == csarManifest || name == csarpkg.ToscaMetaPath {
+			return nil
+		}
+		file, _ := ioutil.ReadFile(path)
+		digest := sha256.Sum256(file)
+		content += "Source: " + name + "\nAlgorithm: SHA-256\nHash: " + hex.EncodeToString(digest[:]) + "\n\n"
+		return nil
+	})
+	_ = ioutil.WriteFile(manifestPath, []byte(content), filePermission)
+}
+
+func TestValidatePackageSuccess(t *testing.T) {
+	packageDir := extractListedTestPackage(t)
+	defer os.RemoveAll(packageDir)
+
+	validator, _ := csarpkg.NewValidator("", csarpkg.ValidatorOptions{})
+	err := validator.Validate(packageDir)
+	assert.Nil(t, err, "TestValidatePackageSuccess execution result")
+}
+
+func TestValidatePackageFailure(t *testing.T) {
+	packageDir := extractListedTestPackage(t)
+	defer os.RemoveAll(packageDir)
+	_ = ioutil.WriteFile(filepath.Join(packageDir, "APPD/Definition/MainServiceTemplate.yaml"),
+		[]byte("tampered"), filePermission)
+	_ = ioutil.WriteFile(filepath.Join(packageDir, "Artifacts/Other/unlisted.sh"), []byte("unlisted"),
+		filePermission)
+	_ = os.RemoveAll(filepath.Join(packageDir, csarpkg.ChartsPath))
+
+	validator, _ := csarpkg.NewValidator("", csarpkg.ValidatorOptions{})
+	err := validator.Validate(packageDir)
+	assert.Equal(t, csarpkg.ValidationErrors{
+		{File: "APPD/Definition/MainServiceTemplate.yaml", Message: "digest does not match manifest"},
+		{File: "Artifacts/Deployment/Charts/positioning-service.tgz", Message: "file listed in manifest does not exist"},
+		{File: "Artifacts/Other/unlisted.sh", Message: "file is not listed in manifest"},
+		{File: csarpkg.ChartsPath, Message: "deployment charts are missing"},
+	}, err, "TestValidatePackageFailure execution result")
+}
+
+func TestValidatePackageManifestNotEnforced(t *testing.T) {
+	// Test package is created before manifest validation, digests are placeholders and files are not listed
+	packageDir := extractTestPackage(t)
+	defer os.RemoveAll(packageDir)
+
+	validator, _ := csarpkg.NewValidator("", csarpkg.ValidatorOptions{})
+	assert.Error(t, validator.Validate(packageDir), "TestValidatePackageManifestNotEnforced execution result")
+
+	validator, _ = csarpkg.NewValidator("", csarpkg.ValidatorOptions{ManifestNotEnforced: true})
+	assert.Nil(t, validator.Validate(packageDir), "TestValidatePackageManifestNotEnforced execution result")
+}
+
+func TestValidateVmPackage(t *testing.T) {
+	packageDir := extractTestPackage(t)
+	defer os.RemoveAll(packageDir)
+	_ = os.RemoveAll(filepath.Join(packageDir, csarpkg.ChartsPath))
+	listTestPackageFiles(t, packageDir)
+	manifestPath := filepath.Join(packageDir, csarManifest)
+	manifest, _ := ioutil.ReadFile(manifestPath)
+	_ = ioutil.WriteFile(manifestPath, []byte(strings.Replace(string(manifest), "metadata:",
+		"metadata:\napp_type: vm", 1)), filePermission)
+
+	validator, _ := csarpkg.NewValidator("", csarpkg.ValidatorOptions{VmPackagesAllowed: true})
+	assert.Nil(t, validator.Validate(packageDir), "TestValidateVmPackage execution result")
+
+	validator, _ = csarpkg.NewValidator("", csarpkg.ValidatorOptions{})
+	assert.Equal(t, csarpkg.ValidationErrors{{File: csarManifest, Message: "vm application packages are not supported"}},
+		validator.Validate(packageDir), "TestValidateVmPackage execution result")
+}
+
+func TestNewValidatorInvalidTrustStore(t *testing.T) {
+	_, err := csarpkg.NewValidator("/nonexistent/truststore.pem", csarpkg.ValidatorOptions{})
+	assert.Error(t, err, "TestNewValidatorInvalidTrustStore execution result")
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	beegoCtx "github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"lcmcontroller/util"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// Plugin client whose package upload fails on the given host
+type failingUploadClient struct {
+	mockClient
+	failHost string
+}
+
+func (fc *failingUploadClient) UploadPackage(ctx context.Context, tenantId string, appPkg string, hostIP string,
+	packageId string, accessToken string) (status string, error error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return util.Failure, errors.New("upload has no timeout")
+	}
+	if hostIP == fc.failHost {
+		return util.Failure, errors.New("connection refused")
+	}
+	return SUCCESS_RETURN, nil
+}
+
+// Create lcm controller for distribute request
+func getDistributeController(testDb *mockDb, hostIps []string) *controllers.LcmController {
+	requestBody, _ := json.Marshal(models.DistributeRequest{HostIp: hostIps, Origin: originVal})
+	request, _ := getHttpRequest(tenantsPath+tenantIdentifier+packages+packageId, nil, packageName, "",
+		"POST", requestBody)
+
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}, RequestBody: requestBody}
+	setParam(input)
+
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+	return &controllers.LcmController{controllers.BaseController{Db: testDb, Controller: beegoController}}
+}
+
+func TestDistributePackagePartialFailure(t *testing.T) {
+	hostIps := []string{"1.1.1.1", "1.1.1.2", "1.1.1.3"}
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &failingUploadClient{failHost: hostIps[1]}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := &mockDb{appInstanceRecords: make(map[string]models.AppInfoRecord),
+		tenantRecords: make(map[string]models.TenantInfoRecord),
+		appPackageRecords: make(map[string]models.AppPackageRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		appPackageHostRecords: make(map[string]models.AppPackageHostRecord),
+		lcmOperationRecords: make(map[string]models.LcmOperation),
+		packageUploadRecords: make(map[string]models.PackageUploadSession)}
+	testDb.appPackageRecords[packageId+tenantIdentifier] = models.AppPackageRecord{
+		AppPkgId: packageId + tenantIdentifier, PackageId: packageId, TenantId: tenantIdentifier}
+	for _, hostIp := range hostIps {
+		testDb.mecHostRecords[hostIp] = models.MecHost{MecHostId: hostIp, MechostIp: hostIp}
+	}
+
+	controller := getDistributeController(testDb, hostIps)
+	controller.DistributePackage()
+	assert.Equal(t, util.StatusAccepted, controller.Ctx.ResponseWriter.Status, "Distribute package failed")
+
+	// Failure of one host does not stop the distribution to the others
+	operation := waitForOperation(t, testDb, controller)
+	assert.Equal(t, util.OperationFailed, operation.State, "Distribute package failed")
+	assert.Equal(t, "distribution failed on 1 of 3 hosts: 1.1.1.2: connection refused", operation.Error,
+		"Distribute package failed")
+	for _, hostIp := range hostIps {
+		record := testDb.appPackageHostRecords[packageId+tenantIdentifier+hostIp]
+		if hostIp == hostIps[1] {
+			assert.Equal(t, util.DistributionError, record.Status, "Host distribution status is wrong")
+			assert.Equal(t, "connection refused", record.Error, "Host distribution error is wrong")
+			continue
+		}
+		assert.Equal(t, util.Distributed, record.Status, "Host distribution status is wrong")
+		assert.Empty(t, record.Error, "Host distribution error is wrong")
+	}
+}
+
+func TestDistributePackageDuplicateHost(t *testing.T) {
+	var c *beego.Controller
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch1.Reset()
+
+	testDb := &mockDb{lcmOperationRecords: make(map[string]models.LcmOperation)}
+	controller := getDistributeController(testDb, []string{"1.1.1.1", "1.1.1.1"})
+	controller.DistributePackage()
+	assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status, "Distribute duplicate host failed")
+	assert.Empty(t, testDb.lcmOperationRecords, "Distribute duplicate host failed")
+}
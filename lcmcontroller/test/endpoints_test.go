@@ -0,0 +1,119 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	beegoCtx "github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const endpointsResponse = "{\"services\":[{\"name\":\"etherpad-svc\",\"type\":\"NodePort\"}],\"ingresses\":[]}"
+
+// Plugin client which responds endpoints of app instance
+type endpointsClient struct {
+	mockClient
+}
+
+func (ec *endpointsClient) Endpoints(ctx context.Context, accessToken string, appInsId string,
+	hostIP string) (response string, error error) {
+	if appInsId != appInstanceIdentifier {
+		return "", status.Error(codes.NotFound, "app info record does not exist in database")
+	}
+	return endpointsResponse, nil
+}
+
+// Get endpoints of app instance
+func getEndpoints(testDb *mockDb, appInsId string) *httptest.ResponseRecorder {
+	request, _ := getHttpRequest(tenantsPath+tenantIdentifier+"/app_instances/"+appInsId+"/endpoints", nil, "",
+		"", "GET", nil)
+
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}}
+	setParam(input)
+	input.SetParam(":appInstanceId", appInsId)
+
+	recorder := httptest.NewRecorder()
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: recorder}},
+		Data: make(map[interface{}]interface{})}
+	beegoController.Ctx.Output = beegoCtx.NewOutput()
+	beegoController.Ctx.Output.Context = beegoController.Ctx
+	controller := &controllers.LcmController{BaseController: controllers.BaseController{Db: testDb,
+		Controller: beegoController}}
+	controller.GetEndpoints()
+	return recorder
+}
+
+// Create mock database with app instances of the plugin
+func getEndpointsDb(pluginAddress string, appInsIds ...string) *mockDb {
+	testDb := &mockDb{appInstanceRecords: make(map[string]models.AppInfoRecord),
+		tenantRecords:  make(map[string]models.TenantInfoRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		pluginRecords:  make(map[string]models.PluginRecord)}
+	for _, appInsId := range appInsIds {
+		testDb.appInstanceRecords[appInsId] = models.AppInfoRecord{AppInstanceId: appInsId, MecHost: ipAddress,
+			TenantId: tenantIdentifier}
+	}
+	testDb.mecHostRecords[ipAddress] = models.MecHost{MecHostId: ipAddress, MechostIp: ipAddress, Vim: "k8s"}
+	testDb.pluginRecords[pluginAddress] = models.PluginRecord{PluginId: pluginAddress, PluginName: pluginAddress,
+		Address: pluginAddress, VimTypes: "k8s"}
+	return testDb
+}
+
+func TestGetEndpoints(t *testing.T) {
+	// Plugin which does not report endpoints is a container plugin without the operation
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(pluginInfo string) (pluginAdapter.ClientIntf, error) {
+		if pluginInfo == "containerplugin:8095" {
+			return &containerPluginClient{}, nil
+		}
+		return &endpointsClient{}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	staleInstanceId := "e921ce54-82c8-4532-b5c6-8516cf75f7c1"
+	testDb := getEndpointsDb("endpointsplugin:8095", appInstanceIdentifier, staleInstanceId)
+	response := getEndpoints(testDb, appInstanceIdentifier)
+	assert.Equal(t, http.StatusOK, response.Code, "Get endpoints failed")
+	assert.Equal(t, endpointsResponse, response.Body.String(), "Get endpoints failed")
+	assert.Equal(t, "application/json", response.Header().Get("Content-Type"), "Get endpoints failed")
+
+	response = getEndpoints(testDb, staleInstanceId)
+	assert.Equal(t, http.StatusNotFound, response.Code, "Get endpoints of removed release failed")
+
+	// Plugin which does not report endpoints rejects the request
+	response = getEndpoints(getEndpointsDb("containerplugin:8095", appInstanceIdentifier), appInstanceIdentifier)
+	assert.Equal(t, http.StatusNotImplemented, response.Code, "Unsupported get endpoints is not rejected")
+}
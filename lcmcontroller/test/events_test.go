@@ -0,0 +1,109 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	beegoCtx "github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// Recorder of workload event filter received by plugin client
+type eventFilterRecorder struct {
+	filter pluginAdapter.EventFilter
+}
+
+func (r *eventFilterRecorder) record(filter pluginAdapter.EventFilter) {
+	r.filter = filter
+}
+
+// Event filters of the running test, patched functions create the plugin clients so recorders are not captured
+// from the test
+var eventFilters = &eventFilterRecorder{}
+
+// Plugin client which records the workload event filter
+type eventsClient struct {
+	mockClient
+}
+
+func (ec *eventsClient) WorkloadDescription(ctx context.Context, accessToken string, appInsId string,
+	hostIP string, filter pluginAdapter.EventFilter) (response string, error error) {
+	eventFilters.record(filter)
+	return "{\"events\":[],\"pods\":null}", nil
+}
+
+// Get workload events with the query
+func getWorkloadEvents(testDb *mockDb, query string) *httptest.ResponseRecorder {
+	request, _ := getHttpRequest(tenantsPath+tenantIdentifier+"/app_instances/"+appInstanceIdentifier+
+		"/workload/events?"+query, nil, "", "", "GET", nil)
+
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}}
+	setParam(input)
+
+	recorder := httptest.NewRecorder()
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: recorder}},
+		Data: make(map[interface{}]interface{})}
+	controller := &controllers.LcmController{BaseController: controllers.BaseController{Db: testDb,
+		Controller: beegoController}}
+	controller.GetWorkloadDescription()
+	return recorder
+}
+
+func TestWorkloadEventsFilter(t *testing.T) {
+	eventFilters = &eventFilterRecorder{}
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &eventsClient{}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := &mockDb{appInstanceRecords: make(map[string]models.AppInfoRecord),
+		tenantRecords:  make(map[string]models.TenantInfoRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		pluginRecords:  make(map[string]models.PluginRecord)}
+	testDb.appInstanceRecords[appInstanceIdentifier] = models.AppInfoRecord{AppInstanceId: appInstanceIdentifier,
+		MecHost: ipAddress, TenantId: tenantIdentifier}
+	testDb.mecHostRecords[ipAddress] = models.MecHost{MecHostId: ipAddress, MechostIp: ipAddress, Vim: "k8s"}
+
+	response := getWorkloadEvents(testDb, "since_time=2020-10-01T08:00:00Z&type=Warning")
+	assert.Equal(t, http.StatusOK, response.Code, "Workload events failed")
+	assert.Equal(t, "{\"events\":[],\"pods\":null}", response.Body.String(), "Workload events failed")
+	assert.Equal(t, pluginAdapter.EventFilter{SinceTime: "2020-10-01T08:00:00Z", Type: "Warning"}, eventFilters.filter,
+		"Workload event filter is wrong")
+
+	for _, query := range []string{"since_time=yesterday", "type=Error"} {
+		response = getWorkloadEvents(testDb, query)
+		assert.Equal(t, http.StatusBadRequest, response.Code, "Workload events with invalid query failed: "+query)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	beegoCtx "github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"lcmcontroller/util"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+const (
+	failingInstanceId       = "e921ce54-82c8-4532-b5c6-8516cf75f7b1"
+	instantiatingInstanceId = "e921ce54-82c8-4532-b5c6-8516cf75f7b2"
+)
+
+// Recorder of app instances whose health is queried
+type healthQueryRecorder struct {
+	mutex   sync.Mutex
+	queried []string
+}
+
+func (r *healthQueryRecorder) record(appInsId string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.queried = append(r.queried, appInsId)
+}
+
+func (r *healthQueryRecorder) getQueried() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]string(nil), r.queried...)
+}
+
+// Health queries of the running test, patched functions create the plugin clients so recorders are not captured
+// from the test
+var healthQueries = &healthQueryRecorder{}
+
+// Plugin client which responds health of app instances
+type healthClient struct {
+	mockClient
+}
+
+func (hc *healthClient) QueryHealth(ctx context.Context, accessToken string, appInsId string,
+	hostIP string) (health *models.HealthSummary, error error) {
+	healthQueries.record(appInsId)
+	if appInsId == failingInstanceId {
+		return nil, errors.New("release not found")
+	}
+	return &models.HealthSummary{Status: "Degraded", Reasons: []string{"pod etherpad-0 failed"}}, nil
+}
+
+// Mock database which lists app instance records of tenant
+type appInstanceListDb struct {
+	*mockDb
+}
+
+func (db *appInstanceListDb) QueryTable(tableName string, container interface{}, field string,
+	container1 ...interface{}) (int64, error) {
+	appInfoRecords, ok := container.(*[]*models.AppInfoRecord)
+	if tableName != "app_info_record" || !ok {
+		return db.mockDb.QueryTable(tableName, container, field, container1...)
+	}
+	for _, appInfoRec := range db.appInstanceRecords {
+		appInfo := appInfoRec
+		if appInfo.TenantId == container1[0] {
+			*appInfoRecords = append(*appInfoRecords, &appInfo)
+		}
+	}
+	return int64(len(*appInfoRecords)), nil
+}
+
+// Query app instances of tenant with the query
+func getAppInstances(testDb *appInstanceListDb, query string) *httptest.ResponseRecorder {
+	request, _ := getHttpRequest(tenantsPath+tenantIdentifier+"/app_instances?"+query, nil, "", "", "GET", nil)
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}}
+	setParam(input)
+	recorder := httptest.NewRecorder()
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: recorder}},
+		Data: make(map[interface{}]interface{})}
+	controller := &controllers.MecHostController{BaseController: controllers.BaseController{Db: testDb,
+		Controller: beegoController}}
+	controller.GetAppInstance()
+	return recorder
+}
+
+func TestGetAppInstanceHealth(t *testing.T) {
+	healthQueries = &healthQueryRecorder{}
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &healthClient{}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := &appInstanceListDb{&mockDb{appInstanceRecords: make(map[string]models.AppInfoRecord),
+		tenantRecords:  make(map[string]models.TenantInfoRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		pluginRecords:  make(map[string]models.PluginRecord)}}
+	testDb.appInstanceRecords[appInstanceIdentifier] = models.AppInfoRecord{AppInstanceId: appInstanceIdentifier,
+		MecHost: ipAddress, TenantId: tenantIdentifier, InstanceState: util.Instantiated}
+	testDb.appInstanceRecords[failingInstanceId] = models.AppInfoRecord{AppInstanceId: failingInstanceId,
+		MecHost: ipAddress, TenantId: tenantIdentifier}
+	testDb.appInstanceRecords[instantiatingInstanceId] = models.AppInfoRecord{AppInstanceId: instantiatingInstanceId,
+		MecHost: ipAddress, TenantId: tenantIdentifier, InstanceState: util.Instantiating}
+	testDb.mecHostRecords[ipAddress] = models.MecHost{MecHostId: ipAddress, MechostIp: ipAddress, Vim: "k8s"}
+
+	// Health is not queried unless requested
+	recorder := getAppInstances(testDb, "")
+	assert.Equal(t, http.StatusOK, recorder.Code, "Query app instances failed")
+	assert.Empty(t, healthQueries.getQueried(), "Health of app instances is queried without request")
+
+	recorder = getAppInstances(testDb, "health=sometimes")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code, "Query app instances with invalid health failed")
+
+	recorder = getAppInstances(testDb, "health=true")
+	assert.Equal(t, http.StatusOK, recorder.Code, "Query app instances failed")
+	var appInfoRec []models.AppInfoRec
+	_ = json.Unmarshal(recorder.Body.Bytes(), &appInfoRec)
+	assert.Equal(t, 3, len(appInfoRec), "Query app instances failed")
+	for _, appInfo := range appInfoRec {
+		if appInfo.AppInstanceId == appInstanceIdentifier {
+			assert.Equal(t, &models.HealthSummary{Status: "Degraded", Reasons: []string{"pod etherpad-0 failed"}},
+				appInfo.Health, "Health of app instance is wrong")
+		} else {
+			assert.Nil(t, appInfo.Health, "Health of app instance should be omitted")
+		}
+	}
+	assert.ElementsMatch(t, []string{appInstanceIdentifier, failingInstanceId}, healthQueries.getQueried(),
+		"Health of app instances which are not instantiated is queried")
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	beegoCtx "github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+const imageChunkSize = 4
+
+// Recorder of chunks from which image downloads are started
+type imageDownloadRecorder struct {
+	mutex     sync.Mutex
+	chunkNums []int32
+}
+
+func (r *imageDownloadRecorder) record(chunkNum int32) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.chunkNums = append(r.chunkNums, chunkNum)
+}
+
+func (r *imageDownloadRecorder) lastChunkNum() int32 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.chunkNums[len(r.chunkNums)-1]
+}
+
+// Image downloads of the running test, patched functions create the plugin clients so recorders are not captured
+// from the test
+var imageDownloads = &imageDownloadRecorder{}
+
+// Plugin client which streams images in chunks starting from the requested chunk
+type imageStreamClient struct {
+	mockClient
+	images map[string]string
+}
+
+func (ic *imageStreamClient) QueryVmImage(ctx context.Context, accessToken string, appInsId string,
+	hostIP string, imageId string) (response string, error error) {
+	size := int64(len(ic.images[imageId]))
+	info, _ := json.Marshal(models.VmImageInfo{ImageId: imageId, Status: "active", ChunkSize: imageChunkSize,
+		SumChunkNum: (size + imageChunkSize - 1) / imageChunkSize, ImageSize: size})
+	return string(info), nil
+}
+
+func (ic *imageStreamClient) DownloadVmImage(ctx context.Context, accessToken string, appInsId string,
+	hostIP string, imageId string, chunkNum int32, writer io.Writer) (written int64, error error) {
+	imageDownloads.record(chunkNum)
+	content := ic.images[imageId]
+	for i := int(chunkNum) * imageChunkSize; i < len(content); i += imageChunkSize {
+		end := i + imageChunkSize
+		if end > len(content) {
+			end = len(content)
+		}
+		// Chunks of concurrent downloads are interleaved
+		time.Sleep(time.Millisecond)
+		n, err := writer.Write([]byte(content[i:end]))
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// Create image controller for image file request
+func getImageFileController(testDb *mockDb, imageId string, headers map[string]string) *controllers.ImageController {
+	request, _ := getHttpRequest(tenantsPath+tenantIdentifier+"/app_instances/"+appInstanceIdentifier+"/images/"+
+		imageId+"/file", nil, "", "", "GET", nil)
+	request.Header.Del("chunk_num")
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}}
+	setParam(input)
+	input.SetParam(":imageId", imageId)
+
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+	return &controllers.ImageController{controllers.BaseController{Db: testDb, Controller: beegoController}}
+}
+
+// Download image file and get response
+func downloadImageFile(testDb *mockDb, imageId string, headers map[string]string) *httptest.ResponseRecorder {
+	controller := getImageFileController(testDb, imageId, headers)
+	controller.GetImageFile()
+	return controller.Ctx.ResponseWriter.ResponseWriter.(*httptest.ResponseRecorder)
+}
+
+func TestImageFileStreaming(t *testing.T) {
+	imageDownloads = &imageDownloadRecorder{}
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &imageStreamClient{images: map[string]string{
+			"image1": "0123456789abcdefghij",
+			"image2": "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+		}}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := &mockDb{appInstanceRecords: make(map[string]models.AppInfoRecord),
+		tenantRecords:  make(map[string]models.TenantInfoRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		pluginRecords:  make(map[string]models.PluginRecord)}
+	testDb.appInstanceRecords[appInstanceIdentifier] = models.AppInfoRecord{AppInstanceId: appInstanceIdentifier,
+		MecHost: ipAddress, TenantId: tenantIdentifier}
+	testDb.mecHostRecords[ipAddress] = models.MecHost{MecHostId: ipAddress, MechostIp: ipAddress, Vim: "k8s"}
+
+	// Concurrent downloads of different images are isolated
+	var wg sync.WaitGroup
+	responses := make([]*httptest.ResponseRecorder, 2)
+	for i, imageId := range []string{"image1", "image2"} {
+		wg.Add(1)
+		go func(i int, imageId string) {
+			defer wg.Done()
+			responses[i] = downloadImageFile(testDb, imageId, nil)
+		}(i, imageId)
+	}
+	wg.Wait()
+	assert.Equal(t, "0123456789abcdefghij", responses[0].Body.String(), "Download image1 failed")
+	assert.Equal(t, "ABCDEFGHIJKLMNOPQRSTUVWXYZ", responses[1].Body.String(), "Download image2 failed")
+
+	response := downloadImageFile(testDb, "image1", map[string]string{"Range": "bytes=6-13"})
+	assert.Equal(t, http.StatusPartialContent, response.Code, "Download image range failed")
+	assert.Equal(t, "6789abcd", response.Body.String(), "Download image range failed")
+	assert.Equal(t, "bytes 6-13/20", response.Header().Get("Content-Range"), "Download image range failed")
+	assert.Equal(t, int32(1), imageDownloads.lastChunkNum(), "Download image range is not started from its chunk")
+
+	response = downloadImageFile(testDb, "image1", map[string]string{"Range": "bytes=-3"})
+	assert.Equal(t, "hij", response.Body.String(), "Download image suffix range failed")
+	assert.Equal(t, int32(4), imageDownloads.lastChunkNum(), "Download image range is not started from its chunk")
+
+	response = downloadImageFile(testDb, "image1", map[string]string{"Range": "bytes=30-"})
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, response.Code, "Unsatisfiable range is not rejected")
+
+	// Chunk header of older clients returns single chunk
+	response = downloadImageFile(testDb, "image2", map[string]string{"chunk_num": "2"})
+	assert.Equal(t, http.StatusOK, response.Code, "Download image chunk failed")
+	assert.Equal(t, "IJKL", response.Body.String(), "Download image chunk failed")
+	assert.Equal(t, int32(2), imageDownloads.lastChunkNum(), "Download image chunk is not started from its chunk")
+
+	response = downloadImageFile(testDb, "image2", map[string]string{"chunk_num": "7"})
+	assert.Equal(t, http.StatusNotFound, response.Code, "Unknown chunk is not rejected")
+}
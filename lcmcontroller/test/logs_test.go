@@ -0,0 +1,137 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	beegoCtx "github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// Recorder of log options received by plugin client
+type logOptionsRecorder struct {
+	options pluginAdapter.LogOptions
+}
+
+func (r *logOptionsRecorder) record(options pluginAdapter.LogOptions) {
+	r.options = options
+}
+
+// Log options of the running test, patched functions create the plugin clients so recorders are not captured
+// from the test
+var logOptions = &logOptionsRecorder{}
+
+// Plugin client which streams pod logs in chunks which split lines
+type logStreamClient struct {
+	mockClient
+}
+
+func (lc *logStreamClient) WorkloadLogs(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	options pluginAdapter.LogOptions, writer io.Writer) error {
+	logOptions.record(options)
+	if options.PodName != "etherpad-0" {
+		return status.Error(codes.NotFound, "pod does not exist in application")
+	}
+	for _, chunk := range []string{"line 1\nli", "ne 2\n", "line 3"} {
+		_, err := writer.Write([]byte(chunk))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get workload logs with the query and accept header
+func getWorkloadLogs(testDb *mockDb, query string, accept string) *httptest.ResponseRecorder {
+	request, _ := getHttpRequest(tenantsPath+tenantIdentifier+"/app_instances/"+appInstanceIdentifier+
+		"/workload/logs?"+query, nil, "", "", "GET", nil)
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}}
+	setParam(input)
+
+	recorder := httptest.NewRecorder()
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: recorder}},
+		Data: make(map[interface{}]interface{})}
+	controller := &controllers.LcmController{BaseController: controllers.BaseController{Db: testDb,
+		Controller: beegoController}}
+	controller.WorkloadLogs()
+	return recorder
+}
+
+func TestWorkloadLogs(t *testing.T) {
+	logOptions = &logOptionsRecorder{}
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &logStreamClient{}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := &mockDb{appInstanceRecords: make(map[string]models.AppInfoRecord),
+		tenantRecords:  make(map[string]models.TenantInfoRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		pluginRecords:  make(map[string]models.PluginRecord)}
+	testDb.appInstanceRecords[appInstanceIdentifier] = models.AppInfoRecord{AppInstanceId: appInstanceIdentifier,
+		MecHost: ipAddress, TenantId: tenantIdentifier}
+	testDb.mecHostRecords[ipAddress] = models.MecHost{MecHostId: ipAddress, MechostIp: ipAddress, Vim: "k8s"}
+
+	// Logs are streamed as plain text by default
+	response := getWorkloadLogs(testDb, "pod=etherpad-0&container=etherpad&tail_lines=100"+
+		"&since_time=2020-10-01T08:00:00Z&follow=true", "")
+	assert.Equal(t, http.StatusOK, response.Code, "Workload logs failed")
+	assert.Equal(t, "line 1\nline 2\nline 3", response.Body.String(), "Workload logs failed")
+	assert.True(t, response.Flushed, "Workload logs are not flushed")
+	assert.Equal(t, pluginAdapter.LogOptions{PodName: "etherpad-0", ContainerName: "etherpad", TailLines: 100,
+		SinceTime: "2020-10-01T08:00:00Z", Follow: true}, logOptions.options, "Workload log options are wrong")
+
+	// Each line is an event of server-sent events
+	response = getWorkloadLogs(testDb, "pod=etherpad-0", "text/event-stream")
+	assert.Equal(t, "text/event-stream", response.Header().Get("Content-Type"), "Workload log events failed")
+	assert.Equal(t, "data: line 1\n\ndata: line 2\n\ndata: line 3\n\n", response.Body.String(),
+		"Workload log events failed")
+
+	response = getWorkloadLogs(testDb, "pod=unknown-0", "")
+	assert.Equal(t, http.StatusNotFound, response.Code, "Workload logs of unknown pod failed")
+
+	for _, query := range []string{"", "pod=Etherpad_0", "pod=etherpad-0&tail_lines=-1",
+		"pod=etherpad-0&since_time=yesterday", "pod=etherpad-0&follow=sometimes"} {
+		response = getWorkloadLogs(testDb, query, "")
+		assert.Equal(t, http.StatusBadRequest, response.Code, "Workload logs with invalid query failed: "+query)
+	}
+}
@@ -17,21 +17,66 @@
 package test
 
 import (
-	"bytes"
-	beegoCtx "github.com/astaxie/beego/context"
 	"context"
+	"io"
 	"lcmcontroller/config"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
 	"mime/multipart"
 )
 
 const SUCCESS_RETURN = "Success"
 
+// Release history with the installed and the upgraded revision
+const mockHistory = "[{\"revision\":1,\"status\":\"superseded\"},{\"revision\":2,\"status\":\"deployed\"}]"
+
 type mockClient struct{}
 
-func (mc *mockClient) Instantiate(ctx context.Context, tenantId string, host string, packageId string, accessToken string, akSkAppInfo config.AppAuthConfig) (status string, error error) {
+func (mc *mockClient) Instantiate(ctx context.Context, tenantId string, host string, packageId string, accessToken string, akSkAppInfo config.AppAuthConfig,
+	namespace string, parameters string, readyOptions pluginAdapter.ReadyOptions) (status string, error error) {
+	return SUCCESS_RETURN, nil
+}
+
+func (mc *mockClient) Upgrade(ctx context.Context, tenantId string, host string, packageId string, accessToken string, akSkAppInfo config.AppAuthConfig) (status string, error error) {
+	return SUCCESS_RETURN, nil
+}
+
+func (mc *mockClient) Rollback(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	revision int32) (restored *models.RestoredRevision, error error) {
+	return &models.RestoredRevision{}, nil
+}
+
+func (mc *mockClient) Scale(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	replicas int32) (status string, error error) {
+	return SUCCESS_RETURN, nil
+}
+
+func (mc *mockClient) SetAutoscaling(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	policy string) (status string, error error) {
+	return SUCCESS_RETURN, nil
+}
+
+func (mc *mockClient) DeleteAutoscaling(ctx context.Context, accessToken string, appInsId string,
+	hostIP string) (status string, error error) {
 	return SUCCESS_RETURN, nil
 }
 
+func (mc *mockClient) WorkloadLogs(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	options pluginAdapter.LogOptions, writer io.Writer) error {
+	_, err := writer.Write([]byte(SUCCESS_RETURN))
+	return err
+}
+
+func (mc *mockClient) History(ctx context.Context, accessToken string, appInsId string,
+	hostIP string) (response string, error error) {
+	return mockHistory, nil
+}
+
+func (mc *mockClient) QueryHealth(ctx context.Context, accessToken string, appInsId string,
+	hostIP string) (health *models.HealthSummary, error error) {
+	return &models.HealthSummary{Status: "Healthy"}, nil
+}
+
 func (mc *mockClient) CreateVmImage(ctx context.Context, accessToken string, appInsId string,
 	hostIP string, vmId string) (response string, error error) {
 	return SUCCESS_RETURN, nil
@@ -48,8 +93,8 @@ func (mc *mockClient) DeleteVmImage(ctx context.Context, accessToken string, app
 }
 
 func (mc *mockClient) DownloadVmImage(ctx context.Context, accessToken string, appInsId string, hostIP string,
-	imageId string, chunkNum int32, imgCtrlr *beegoCtx.Response) (buf *bytes.Buffer, error error) {
-	return &bytes.Buffer{}, nil
+	imageId string, chunkNum int32, writer io.Writer) (written int64, error error) {
+	return 0, nil
 }
 
 
@@ -73,8 +118,13 @@ func (mc *mockClient) RemoveConfig(ctx context.Context, hostIP string,
 	return SUCCESS_RETURN, nil
 }
 
+func (mc *mockClient) Endpoints(ctx context.Context, accessToken string, appInsId string,
+	hostIP string) (response string, error error) {
+	return SUCCESS_RETURN, nil
+}
+
 func (mc *mockClient) WorkloadDescription(ctx context.Context, accessToken string, hostIp string,
-	workloadName string) (response string, error error) {
+	workloadName string, filter pluginAdapter.EventFilter) (response string, error error) {
 	return SUCCESS_RETURN, nil
 }
 
@@ -87,4 +137,7 @@ func (mc *mockClient) DeletePackage(ctx context.Context, tenantId string, hostIP
 	return SUCCESS_RETURN, nil
 }
 
-
+func (mc *mockClient) GetCapabilities(ctx context.Context, accessToken string) (capabilities *pluginAdapter.Capabilities,
+	error error) {
+	return nil, nil
+}
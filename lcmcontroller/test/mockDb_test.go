@@ -21,6 +21,8 @@ import (
 	"lcmcontroller/models"
 	"lcmcontroller/util"
 	"reflect"
+	"strings"
+	"sync"
 )
 
 type mockDb struct {
@@ -29,7 +31,11 @@ type mockDb struct {
 	appPackageRecords  map[string]models.AppPackageRecord
 	appPackageHostRecords  map[string]models.AppPackageHostRecord
 	mecHostRecords     map[string]models.MecHost
-
+	lcmOperationRecords map[string]models.LcmOperation
+	packageUploadRecords map[string]models.PackageUploadSession
+	pluginRecords      map[string]models.PluginRecord
+	queryErr           error
+	mutex              sync.Mutex
 }
 
 func (db *mockDb) InitDatabase() error {
@@ -37,6 +43,9 @@ func (db *mockDb) InitDatabase() error {
 }
 
 func (db *mockDb) InsertOrUpdateData(data interface{}, cols ...string) (err error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
 	if cols[0] == util.AppInsId {
 		appInstance, ok := data.(*models.AppInfoRecord)
 		if ok {
@@ -70,10 +79,34 @@ func (db *mockDb) InsertOrUpdateData(data interface{}, cols ...string) (err erro
 			db.mecHostRecords[mecHost.MecHostId] = *mecHost
 		}
 	}
+
+	if cols[0] == util.OperationId {
+		operation, ok := data.(*models.LcmOperation)
+		if ok {
+			db.lcmOperationRecords[operation.OperationId] = *operation
+		}
+	}
+
+	if cols[0] == util.UploadId {
+		session, ok := data.(*models.PackageUploadSession)
+		if ok {
+			db.packageUploadRecords[session.UploadId] = *session
+		}
+	}
+
+	if cols[0] == util.PluginId {
+		plugin, ok := data.(*models.PluginRecord)
+		if ok {
+			db.pluginRecords[plugin.PluginId] = *plugin
+		}
+	}
 	return nil
 }
 
 func (db *mockDb) ReadData(data interface{}, cols ...string) (err error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
 	if cols[0] == util.AppInsId {
 		appInstance, ok := data.(*models.AppInfoRecord)
 		if ok {
@@ -85,6 +118,12 @@ func (db *mockDb) ReadData(data interface{}, cols ...string) (err error) {
 			appInstance.MecHost = readAppInstance.MecHost
 			appInstance.DeployType = readAppInstance.DeployType
 			appInstance.Origin     = readAppInstance.Origin
+			appInstance.AppPackageId = readAppInstance.AppPackageId
+			appInstance.AppName = readAppInstance.AppName
+			appInstance.SyncStatus = readAppInstance.SyncStatus
+			appInstance.InstanceState = readAppInstance.InstanceState
+			appInstance.AutoscalingPolicy = readAppInstance.AutoscalingPolicy
+			appInstance.MecHostRec = readAppInstance.MecHostRec
 		}
 	}
 	if cols[0] == util.TenantId {
@@ -121,6 +160,10 @@ func (db *mockDb) ReadData(data interface{}, cols ...string) (err error) {
 			appPackageHost.AppPkgId = readAppPackageHost.AppPkgId
 			appPackageHost.HostIp = readAppPackageHost.HostIp
 			appPackageHost.Status = readAppPackageHost.Status
+			appPackageHost.Error = readAppPackageHost.Error
+			appPackageHost.Origin = readAppPackageHost.Origin
+			appPackageHost.SyncStatus = readAppPackageHost.SyncStatus
+			appPackageHost.AppPackage = readAppPackageHost.AppPackage
 		}
 	}
 
@@ -138,6 +181,36 @@ func (db *mockDb) ReadData(data interface{}, cols ...string) (err error) {
 			mecHost.Origin     = readMecHost.Origin
 		}
 	}
+	if cols[0] == util.OperationId {
+		operation, ok := data.(*models.LcmOperation)
+		if ok {
+			readOperation, exists := db.lcmOperationRecords[operation.OperationId]
+			if !exists {
+				return errors.New("Lcm operation record not found")
+			}
+			*operation = readOperation
+		}
+	}
+	if cols[0] == util.UploadId {
+		session, ok := data.(*models.PackageUploadSession)
+		if ok {
+			readSession, exists := db.packageUploadRecords[session.UploadId]
+			if !exists {
+				return errors.New("Package upload session not found")
+			}
+			*session = readSession
+		}
+	}
+	if cols[0] == util.PluginId {
+		plugin, ok := data.(*models.PluginRecord)
+		if ok {
+			readPlugin, exists := db.pluginRecords[plugin.PluginId]
+			if !exists {
+				return errors.New("Plugin not found")
+			}
+			*plugin = readPlugin
+		}
+	}
 	if cols[0] == "app_pkg_name" {
 		return errors.New("record not found")
 	}
@@ -145,6 +218,9 @@ func (db *mockDb) ReadData(data interface{}, cols ...string) (err error) {
 }
 
 func (db *mockDb) DeleteData(data interface{}, cols ...string) (err error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
 	if cols[0] == util.AppInsId {
 		appInstance, ok := data.(*models.AppInfoRecord)
 		if ok {
@@ -197,6 +273,26 @@ func (db *mockDb) DeleteData(data interface{}, cols ...string) (err error) {
 			delete(db.mecHostRecords, readMecHost.MecHostId)
 		}
 	}
+
+	if cols[0] == util.UploadId {
+		session, ok := data.(*models.PackageUploadSession)
+		if ok {
+			if _, exists := db.packageUploadRecords[session.UploadId]; !exists {
+				return errors.New("Package upload session not found")
+			}
+			delete(db.packageUploadRecords, session.UploadId)
+		}
+	}
+
+	if cols[0] == util.PluginId {
+		plugin, ok := data.(*models.PluginRecord)
+		if ok {
+			if _, exists := db.pluginRecords[plugin.PluginId]; !exists {
+				return errors.New("Plugin not found")
+			}
+			delete(db.pluginRecords, plugin.PluginId)
+		}
+	}
 	return nil
 }
 
@@ -205,6 +301,9 @@ func (db *mockDb) QueryCount(tableName string) (int64, error) {
 }
 
 func (db *mockDb) QueryCountForTable(tableName, fieldName, fieldValue string) (int64, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
 	if tableName == "app_info_record" {
 		var count int64
 		for _, _ = range db.appInstanceRecords {
@@ -216,6 +315,34 @@ func (db *mockDb) QueryCountForTable(tableName, fieldName, fieldValue string) (i
 }
 
 func (db *mockDb) QueryTable(tableName string, container interface{}, field string, container1 ...interface{}) (int64, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.queryErr != nil {
+		return 0, db.queryErr
+	}
+
+	if operations, ok := container.(*[]*models.LcmOperation); ok && tableName == util.LcmOperationTable {
+		for _, operationRec := range db.lcmOperationRecords {
+			operation := operationRec
+			if len(container1) == 1 && operation.State == container1[0] {
+				*operations = append(*operations, &operation)
+			}
+		}
+		return int64(len(*operations)), nil
+	}
+
+	if appPkgHostRecords, ok := container.(*[]*models.AppPackageHostRecord); ok &&
+		tableName == util.AppPackageHostTable {
+		for _, appPkgHostRec := range db.appPackageHostRecords {
+			appPkgHostRecord := appPkgHostRec
+			if len(container1) == 1 && appPkgHostRecord.Status == container1[0] {
+				*appPkgHostRecords = append(*appPkgHostRecords, &appPkgHostRecord)
+			}
+		}
+		return int64(len(*appPkgHostRecords)), nil
+	}
+
 	if tableName == "app_info_record" {
 		for _, appInfoRec := range db.appInstanceRecords {
 			container = appInfoRec
@@ -230,6 +357,35 @@ func (db *mockDb) QueryTable(tableName string, container interface{}, field stri
 		}
 		return 1, nil
 	}
+
+	if tableName == util.PluginRecord {
+		plugins, ok := container.(*[]*models.PluginRecord)
+		if ok {
+			for _, pluginRec := range db.pluginRecords {
+				plugin := pluginRec
+				if field == util.PluginVimTypesFilter && (len(container1) != 1 ||
+					!strings.Contains(strings.ToLower(plugin.VimTypes), strings.ToLower(container1[0].(string)))) {
+					continue
+				}
+				*plugins = append(*plugins, &plugin)
+			}
+			return int64(len(*plugins)), nil
+		}
+		return int64(len(db.pluginRecords)), nil
+	}
+
+	if tableName == util.PackageUploadSessionTable {
+		sessions, ok := container.(*[]*models.PackageUploadSession)
+		if ok {
+			for _, sessionRec := range db.packageUploadRecords {
+				session := sessionRec
+				if field == "" || (len(container1) == 1 && session.TenantId == container1[0]) {
+					*sessions = append(*sessions, &session)
+				}
+			}
+		}
+		return int64(len(*sessions)), nil
+	}
 	return 0, nil
 }
 
@@ -0,0 +1,224 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"lcmcontroller/util"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// Create plugin controller for plugin request
+func getPluginController(testDb *mockDb, method string, pluginId string, body []byte) *controllers.PluginController {
+	request, _ := getHttpRequest("https://edgegallery:8094/lcmcontroller/v1/plugins", nil, "", "", method, body)
+
+	input := &context.BeegoInput{Context: &context.Context{Request: request}, RequestBody: body}
+	input.SetParam(":pluginId", pluginId)
+
+	beegoController := beego.Controller{Ctx: &context.Context{Input: input, Request: request,
+		ResponseWriter: &context.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+	return &controllers.PluginController{controllers.BaseController{Db: testDb, Controller: beegoController}}
+}
+
+// Register plugin and get registered plugin from response
+func registerPlugin(t *testing.T, testDb *mockDb, plugin models.PluginInfo) models.PluginInfo {
+	body, _ := json.Marshal(plugin)
+	controller := getPluginController(testDb, "POST", "", body)
+	controller.RegisterPlugin()
+	assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Register plugin failed")
+
+	var registered models.PluginInfo
+	response := controller.Ctx.ResponseWriter.ResponseWriter.(*httptest.ResponseRecorder)
+	_ = json.Unmarshal(response.Body.Bytes(), &registered)
+	return registered
+}
+
+func TestPluginRegistry(t *testing.T) {
+	var c *beego.Controller
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch1.Reset()
+
+	testDb := &mockDb{pluginRecords: make(map[string]models.PluginRecord)}
+	plugin := registerPlugin(t, testDb, models.PluginInfo{PluginName: "k8splugin", Address: "k8splugin:8095",
+		VimTypes: []string{"k8s"}, Capabilities: []string{"instantiate", "terminate"}})
+	assert.NotEmpty(t, plugin.PluginId, "Register plugin failed")
+
+	// Registration of same plugin name updates the existing plugin
+	registered := registerPlugin(t, testDb, models.PluginInfo{PluginName: "k8splugin", Address: "k8splugin:8096",
+		SslEnabled: true, ServerName: "edgegallery", VimTypes: []string{"k8s"}})
+	assert.Equal(t, plugin.PluginId, registered.PluginId, "Register plugin again failed")
+	assert.Equal(t, 1, len(testDb.pluginRecords), "Register plugin again failed")
+
+	controller := getPluginController(testDb, "GET", "", nil)
+	controller.GetPlugins()
+	var plugins []models.PluginInfo
+	response := controller.Ctx.ResponseWriter.ResponseWriter.(*httptest.ResponseRecorder)
+	_ = json.Unmarshal(response.Body.Bytes(), &plugins)
+	assert.Equal(t, []models.PluginInfo{{PluginId: plugin.PluginId, PluginName: "k8splugin",
+		Address: "k8splugin:8096", SslEnabled: true, ServerName: "edgegallery", VimTypes: []string{"k8s"},
+		Capabilities: []string{}}}, plugins, "Query plugins failed")
+
+	body, _ := json.Marshal(models.PluginInfo{PluginName: "k8splugin", Address: "k8splugin:8095",
+		VimTypes: []string{"k8s", "kubernetes"}})
+	controller = getPluginController(testDb, "PUT", plugin.PluginId, body)
+	controller.UpdatePlugin()
+	assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Update plugin failed")
+	assert.Equal(t, "k8s,kubernetes", testDb.pluginRecords[plugin.PluginId].VimTypes, "Update plugin failed")
+
+	controller = getPluginController(testDb, "DELETE", plugin.PluginId, nil)
+	controller.DeletePlugin()
+	assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Deregister plugin failed")
+	assert.Empty(t, testDb.pluginRecords, "Deregister plugin failed")
+
+	controller = getPluginController(testDb, "DELETE", plugin.PluginId, nil)
+	controller.DeletePlugin()
+	assert.Equal(t, util.StatusNotFound, controller.Ctx.ResponseWriter.Status, "Deregister unknown plugin failed")
+}
+
+func TestPluginRegistryInvalidRequest(t *testing.T) {
+	var c *beego.Controller
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch1.Reset()
+
+	testDb := &mockDb{pluginRecords: make(map[string]models.PluginRecord)}
+	for _, plugin := range []models.PluginInfo{
+		{PluginName: "k8splugin", Address: "k8splugin", VimTypes: []string{"k8s"}},
+		{PluginName: "k8s plugin", Address: "k8splugin:8095", VimTypes: []string{"k8s"}},
+		{PluginName: "k8splugin", Address: "k8splugin:8095"},
+		{PluginName: "k8splugin", Address: "k8splugin:8095", VimTypes: []string{"k8s"}, ServerName: "edge gallery"},
+	} {
+		body, _ := json.Marshal(plugin)
+		controller := getPluginController(testDb, "POST", "", body)
+		controller.RegisterPlugin()
+		assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status, "Register invalid plugin failed")
+	}
+	assert.Empty(t, testDb.pluginRecords, "Register invalid plugin failed")
+
+	controller := getPluginController(testDb, "PUT", "invalid", nil)
+	controller.UpdatePlugin()
+	assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status, "Update invalid plugin id failed")
+}
+
+// Recorder of plugins resolved for plugin clients
+type pluginResolutionRecorder struct {
+	mutex    sync.Mutex
+	resolved []string
+}
+
+func (r *pluginResolutionRecorder) record(pluginInfo string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.resolved = append(r.resolved, pluginInfo)
+}
+
+func (r *pluginResolutionRecorder) getResolved() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]string(nil), r.resolved...)
+}
+
+// Plugins resolved in the running test, patched functions record onto it so that recorders are not captured
+// from the test
+var pluginResolutions = &pluginResolutionRecorder{}
+
+func TestPluginResolvedFromRegistry(t *testing.T) {
+	pluginResolutions = &pluginResolutionRecorder{}
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(pluginInfo string) (pluginAdapter.ClientIntf, error) {
+		pluginResolutions.record(pluginInfo)
+		return &mockClient{}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := &mockDb{tenantRecords: make(map[string]models.TenantInfoRecord),
+		appPackageRecords: make(map[string]models.AppPackageRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		appPackageHostRecords: make(map[string]models.AppPackageHostRecord),
+		lcmOperationRecords: make(map[string]models.LcmOperation),
+		pluginRecords: make(map[string]models.PluginRecord)}
+	testDb.appPackageRecords[packageId+tenantIdentifier] = models.AppPackageRecord{
+		AppPkgId: packageId + tenantIdentifier, PackageId: packageId, TenantId: tenantIdentifier}
+	testDb.mecHostRecords[ipAddress] = models.MecHost{MecHostId: ipAddress, MechostIp: ipAddress, Vim: "K8S"}
+	registerPlugin(t, testDb, models.PluginInfo{PluginName: "k8splugin", Address: "k8splugin:8095",
+		VimTypes: []string{"k8s"}})
+
+	controller := getDistributeController(testDb, []string{ipAddress})
+	controller.DistributePackage()
+	operation := waitForOperation(t, testDb, controller)
+	assert.Equal(t, util.OperationSuccess, operation.State, "Distribute package failed")
+	assert.Equal(t, []string{"k8splugin:8095"}, pluginResolutions.getResolved(), "Plugin is not resolved from registry")
+}
+
+func TestPluginResolutionQueryFailure(t *testing.T) {
+	var c *beego.Controller
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch1.Reset()
+
+	testDb := &mockDb{tenantRecords: make(map[string]models.TenantInfoRecord),
+		appPackageRecords: make(map[string]models.AppPackageRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		appPackageHostRecords: make(map[string]models.AppPackageHostRecord),
+		lcmOperationRecords: make(map[string]models.LcmOperation),
+		pluginRecords: make(map[string]models.PluginRecord),
+		queryErr: errors.New("database is not reachable")}
+	testDb.appPackageRecords[packageId+tenantIdentifier] = models.AppPackageRecord{
+		AppPkgId: packageId + tenantIdentifier, PackageId: packageId, TenantId: tenantIdentifier}
+	testDb.mecHostRecords[ipAddress] = models.MecHost{MecHostId: ipAddress, MechostIp: ipAddress, Vim: "K8S"}
+
+	// Plugin is not resolved through environment variables when plugin records can not be queried
+	controller := getDistributeController(testDb, []string{ipAddress})
+	controller.DistributePackage()
+	assert.Equal(t, util.StatusInternalServerError, controller.Ctx.ResponseWriter.Status,
+		"Distribute package with plugin query failure failed")
+	assert.Empty(t, testDb.lcmOperationRecords, "Operation is created on plugin query failure")
+
+	controller2 := getPluginController(testDb, "GET", "", nil)
+	controller2.GetPlugins()
+	assert.Equal(t, util.StatusInternalServerError, controller2.Ctx.ResponseWriter.Status,
+		"Query plugins with database failure failed")
+}
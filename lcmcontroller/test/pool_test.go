@@ -0,0 +1,106 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"lcmcontroller/pkg/pluginAdapter"
+	"net"
+	"testing"
+)
+
+// Start plugin grpc server, health service is registered when health server is given
+func startPluginServer(t *testing.T, healthServer *health.Server) (*grpc.Server, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err, "listen plugin server")
+	server := grpc.NewServer()
+	if healthServer != nil {
+		healthpb.RegisterHealthServer(server, healthServer)
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return server, listener.Addr().String()
+}
+
+func TestConnectionPoolReuse(t *testing.T) {
+	server, address := startPluginServer(t, health.NewServer())
+	defer server.Stop()
+	pool := pluginAdapter.NewConnectionPool()
+	defer pool.Close()
+
+	client, err := pool.GetClient(pluginAdapter.ClientGRPCConfig{Address: address})
+	assert.Nil(t, err, "TestConnectionPoolReuse execution result")
+	reused, _ := pool.GetClient(pluginAdapter.ClientGRPCConfig{Address: address})
+	assert.True(t, client == reused, "TestConnectionPoolReuse connection is not reused")
+
+	pool.CheckHealth()
+	statuses := pool.Status()
+	assert.Equal(t, 1, len(statuses), "TestConnectionPoolReuse execution result")
+	assert.Equal(t, address, statuses[0].Address, "TestConnectionPoolReuse execution result")
+	assert.Equal(t, pluginAdapter.HealthServing, statuses[0].Health, "TestConnectionPoolReuse execution result")
+}
+
+func TestConnectionPoolUnhealthyPlugin(t *testing.T) {
+	healthServer := health.NewServer()
+	server, address := startPluginServer(t, healthServer)
+	defer server.Stop()
+	pool := pluginAdapter.NewConnectionPool()
+	defer pool.Close()
+
+	client, _ := pool.GetClient(pluginAdapter.ClientGRPCConfig{Address: address})
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	pool.CheckHealth()
+	statuses := pool.Status()
+	assert.Equal(t, "NOT_SERVING", statuses[0].Health, "TestConnectionPoolUnhealthyPlugin execution result")
+	assert.Equal(t, 1, statuses[0].HealthFailures, "TestConnectionPoolUnhealthyPlugin execution result")
+
+	// Connection is closed after consecutive failures and a new one is created on next use
+	pool.CheckHealth()
+	pool.CheckHealth()
+	assert.Empty(t, pool.Status(), "TestConnectionPoolUnhealthyPlugin connection is not closed")
+	redialed, err := pool.GetClient(pluginAdapter.ClientGRPCConfig{Address: address})
+	assert.Nil(t, err, "TestConnectionPoolUnhealthyPlugin execution result")
+	assert.True(t, client != redialed, "TestConnectionPoolUnhealthyPlugin connection is not recreated")
+}
+
+func TestConnectionPoolPluginWithoutHealthService(t *testing.T) {
+	server, address := startPluginServer(t, nil)
+	defer server.Stop()
+	pool := pluginAdapter.NewConnectionPool()
+	defer pool.Close()
+
+	_, _ = pool.GetClient(pluginAdapter.ClientGRPCConfig{Address: address})
+	pool.CheckHealth()
+	assert.Equal(t, pluginAdapter.HealthServing, pool.Status()[0].Health,
+		"TestConnectionPoolPluginWithoutHealthService execution result")
+}
+
+func TestConnectionPoolDeadPlugin(t *testing.T) {
+	server, address := startPluginServer(t, health.NewServer())
+	server.Stop()
+	pool := pluginAdapter.NewConnectionPool()
+	defer pool.Close()
+
+	_, _ = pool.GetClient(pluginAdapter.ClientGRPCConfig{Address: address})
+	pool.CheckHealth()
+	assert.Equal(t, pluginAdapter.HealthUnreachable, pool.Status()[0].Health,
+		"TestConnectionPoolDeadPlugin execution result")
+}
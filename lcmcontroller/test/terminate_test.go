@@ -0,0 +1,128 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	beegoCtx "github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"lcmcontroller/util"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// Plugin client which fails termination of the app instance
+type terminateFailClient struct {
+	mockClient
+}
+
+func (tc *terminateFailClient) Terminate(ctx context.Context, hostIP string, accessToken string,
+	appInsId string) (status string, error error) {
+	return "", errors.New("release can not be uninstalled")
+}
+
+// Terminate the app instance and get the completed operation
+func terminateAppInstance(t *testing.T, testDb *mockDb) models.LcmOperation {
+	request, _ := getHttpRequest(appUrlPathId+"/terminate", nil, "", "", "POST", []byte(""))
+
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}}
+	setParam(input)
+
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+	controller := &controllers.LcmController{BaseController: controllers.BaseController{Db: testDb,
+		Controller: beegoController}}
+	controller.Terminate()
+	assert.Equal(t, util.StatusAccepted, controller.Ctx.ResponseWriter.Status, "Terminate failed")
+	return waitForOperation(t, testDb, controller)
+}
+
+func TestTerminateFailureKeepsInstance(t *testing.T) {
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &terminateFailClient{}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := getUpgradeDb()
+	operation := terminateAppInstance(t, testDb)
+	assert.Equal(t, util.OperationFailed, operation.State, "Failed terminate operation state is wrong")
+	appInfoRecord, exists := testDb.appInstanceRecords[appInstanceIdentifier]
+	assert.True(t, exists, "Record of app instance is deleted for failed terminate")
+	assert.Equal(t, util.Instantiated, appInfoRecord.InstanceState,
+		"Running app instance is not instantiated after failed terminate")
+}
+
+func TestRecoverLcmOperations(t *testing.T) {
+	testDb := getUpgradeDb()
+	states := map[string]string{
+		"e921ce54-82c8-4532-b5c6-8516cf75f7b1": util.Instantiating,
+		"e921ce54-82c8-4532-b5c6-8516cf75f7b2": util.Terminating,
+		"e921ce54-82c8-4532-b5c6-8516cf75f7b3": util.Upgrading,
+		"e921ce54-82c8-4532-b5c6-8516cf75f7b4": util.Scaling,
+	}
+	for appInsId, state := range states {
+		testDb.appInstanceRecords[appInsId] = models.AppInfoRecord{AppInstanceId: appInsId, MecHost: ipAddress,
+			TenantId: tenantIdentifier, InstanceState: state}
+		testDb.lcmOperationRecords[appInsId] = models.LcmOperation{OperationId: appInsId, AppInstanceId: appInsId,
+			State: util.OperationProcessing}
+	}
+	testDb.lcmOperationRecords["completed"] = models.LcmOperation{OperationId: "completed",
+		State: util.OperationSuccess}
+	pkgHostKey := packageId + tenantIdentifier + ipAddress
+	appPkgHostRecord := testDb.appPackageHostRecords[pkgHostKey]
+	appPkgHostRecord.Status = util.Distributing
+	testDb.appPackageHostRecords[pkgHostKey] = appPkgHostRecord
+
+	controllers.RecoverLcmOperations(testDb)
+
+	for appInsId := range states {
+		operation := testDb.lcmOperationRecords[appInsId]
+		assert.Equal(t, util.OperationFailed, operation.State, "Interrupted operation is not failed")
+		assert.Equal(t, util.OperationInterrupted, operation.Error, "Interrupted operation error is wrong")
+	}
+	assert.Equal(t, util.OperationSuccess, testDb.lcmOperationRecords["completed"].State,
+		"Completed operation is changed")
+	expected := map[string]string{
+		appInstanceIdentifier:                  util.Instantiated,
+		"e921ce54-82c8-4532-b5c6-8516cf75f7b1": util.InstantiationFailed,
+		"e921ce54-82c8-4532-b5c6-8516cf75f7b2": util.InstantiationFailed,
+		"e921ce54-82c8-4532-b5c6-8516cf75f7b3": util.Instantiated,
+		"e921ce54-82c8-4532-b5c6-8516cf75f7b4": util.Instantiated,
+	}
+	for appInsId, state := range expected {
+		assert.Equal(t, state, testDb.appInstanceRecords[appInsId].InstanceState,
+			"Interrupted app instance is not recovered: "+appInsId)
+	}
+	assert.Equal(t, util.DistributionError, testDb.appPackageHostRecords[pkgHostKey].Status,
+		"Interrupted distribution is not failed")
+}
@@ -0,0 +1,292 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	beegoCtx "github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"lcmcontroller/config"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/pluginAdapter"
+	"lcmcontroller/util"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// Records of upgrade and auth config requests
+type upgradeRecorder struct {
+	mutex       sync.Mutex
+	upgrades    []config.AppAuthConfig
+	authConfigs []string
+}
+
+func (r *upgradeRecorder) addUpgrade(appAuthConfig config.AppAuthConfig) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.upgrades = append(r.upgrades, appAuthConfig)
+}
+
+func (r *upgradeRecorder) addAuthConfig(req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.authConfigs = append(r.authConfigs, string(body))
+}
+
+// Upgrade and auth config requests of the running test, patched functions record onto it so that recorders are
+// not captured from the test
+var upgradeRequests = &upgradeRecorder{}
+
+// Plugin client which records the upgrade requests and fails them with the given error
+type upgradeClient struct {
+	mockClient
+	err error
+}
+
+func (uc *upgradeClient) Upgrade(ctx context.Context, tenantId string, host string, packageId string,
+	accessToken string, akSkAppInfo config.AppAuthConfig) (status string, error error) {
+	upgradeRequests.addUpgrade(akSkAppInfo)
+	if uc.err != nil {
+		return "", uc.err
+	}
+	return SUCCESS_RETURN, nil
+}
+
+// Plugin client which restores the previous package with its ak sk on rollback
+type rollbackClient struct {
+	mockClient
+	err error
+}
+
+func (rc *rollbackClient) Rollback(ctx context.Context, accessToken string, appInsId string, hostIP string,
+	revision int32) (restored *models.RestoredRevision, error error) {
+	if rc.err != nil {
+		return nil, rc.err
+	}
+	return &models.RestoredRevision{PackageId: "previous", Ak: "previousAk", Sk: "previousSk"}, nil
+}
+
+// Upgrade the app instance to the package and get the completed operation
+func upgradeAppInstance(t *testing.T, testDb *mockDb) models.LcmOperation {
+	body, _ := json.Marshal(map[string]string{packageIdKey: packageId})
+	request, _ := getHttpRequest(appUrlPathId+"/upgrade", nil, "", "", "POST", body)
+
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}, RequestBody: body}
+	setParam(input)
+
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+	controller := &controllers.LcmController{BaseController: controllers.BaseController{Db: testDb,
+		Controller: beegoController}}
+	controller.Upgrade()
+	assert.Equal(t, util.StatusAccepted, controller.Ctx.ResponseWriter.Status, "Upgrade failed")
+	return waitForOperation(t, testDb, controller)
+}
+
+// Create LCM controller for rollback of the app instance to the revision
+func getRollbackController(testDb *mockDb, revision int32) *controllers.LcmController {
+	body, _ := json.Marshal(models.RollbackRequest{Revision: revision})
+	request, _ := getHttpRequest(appUrlPathId+"/rollback", nil, "", "", "POST", body)
+
+	input := &beegoCtx.BeegoInput{Context: &beegoCtx.Context{Request: request}, RequestBody: body}
+	setParam(input)
+
+	beegoController := beego.Controller{Ctx: &beegoCtx.Context{Input: input, Request: request,
+		ResponseWriter: &beegoCtx.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+	controller := &controllers.LcmController{BaseController: controllers.BaseController{Db: testDb,
+		Controller: beegoController}}
+	return controller
+}
+
+// Rollback the app instance to the revision and get the completed operation
+func rollbackAppInstance(t *testing.T, testDb *mockDb, revision int32) models.LcmOperation {
+	controller := getRollbackController(testDb, revision)
+	controller.Rollback()
+	assert.Equal(t, util.StatusAccepted, controller.Ctx.ResponseWriter.Status, "Rollback failed")
+	return waitForOperation(t, testDb, controller)
+}
+
+// Get database with an instantiated app instance and the distributed upgrade package
+func getUpgradeDb() *mockDb {
+	testDb := &mockDb{appInstanceRecords: make(map[string]models.AppInfoRecord),
+		tenantRecords:         make(map[string]models.TenantInfoRecord),
+		appPackageHostRecords: make(map[string]models.AppPackageHostRecord),
+		mecHostRecords:        make(map[string]models.MecHost),
+		lcmOperationRecords:   make(map[string]models.LcmOperation),
+		pluginRecords:         make(map[string]models.PluginRecord)}
+	testDb.appInstanceRecords[appInstanceIdentifier] = models.AppInfoRecord{AppInstanceId: appInstanceIdentifier,
+		MecHost: ipAddress, TenantId: tenantIdentifier, AppPackageId: "previous", AppName: appName,
+		InstanceState: util.Instantiated}
+	testDb.mecHostRecords[ipAddress] = models.MecHost{MecHostId: ipAddress, MechostIp: ipAddress, Vim: "k8s"}
+	testDb.appPackageHostRecords[packageId+tenantIdentifier+ipAddress] = models.AppPackageHostRecord{
+		PkgHostKey: packageId + tenantIdentifier + ipAddress, Status: util.Distributed}
+	return testDb
+}
+
+func TestUpgradeRegistersAuthConfigOnSuccess(t *testing.T) {
+	testUpgradeAuthConfig(t, nil)
+
+	recorder := upgradeRequests
+	assert.Equal(t, 1, len(recorder.upgrades), "Upgrade is not requested")
+	assert.NotEmpty(t, recorder.upgrades[0].Ak, "Upgrade is requested without ak sk")
+	assert.Equal(t, 1, len(recorder.authConfigs), "Auth config of upgraded instance is not registered")
+	assert.Contains(t, recorder.authConfigs[0], recorder.upgrades[0].Ak, "Ak of upgraded instance is not registered")
+}
+
+func TestUpgradeFailureKeepsInstance(t *testing.T) {
+	testUpgradeAuthConfig(t, errors.New("upgrade failed"))
+
+	assert.Equal(t, 1, len(upgradeRequests.upgrades), "Upgrade is not requested")
+	assert.Empty(t, upgradeRequests.authConfigs, "Auth config is rotated for failed upgrade")
+}
+
+// Upgrade the app instance with plugin which fails the upgrade with the error, patches are reset only once the
+// upgrade operation is completed as the background upgrade still calls the patched functions before
+func testUpgradeAuthConfig(t *testing.T, upgradeErr error) {
+	upgradeRequests = &upgradeRecorder{}
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &upgradeClient{err: upgradeErr}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	patch3 := gomonkey.ApplyFunc(util.DoRequest, func(req *http.Request) (*http.Response, error) {
+		upgradeRequests.addAuthConfig(req)
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+			StatusCode: http.StatusOK,
+		}, nil
+	})
+	defer patch3.Reset()
+
+	testDb := getUpgradeDb()
+	operation := upgradeAppInstance(t, testDb)
+	assert.NotEqual(t, util.OperationProcessing, operation.State, "Upgrade operation is not completed")
+
+	appInfoRecord := testDb.appInstanceRecords[appInstanceIdentifier]
+	assert.Equal(t, util.Instantiated, appInfoRecord.InstanceState, "App instance state is wrong after upgrade")
+	if upgradeErr != nil {
+		assert.Equal(t, util.OperationFailed, operation.State, "Failed upgrade operation state is wrong")
+		assert.Equal(t, "previous", appInfoRecord.AppPackageId, "Package is changed for failed upgrade")
+	} else {
+		assert.Equal(t, util.OperationSuccess, operation.State, "Upgrade operation state is wrong")
+		assert.Equal(t, packageId, appInfoRecord.AppPackageId, "Package is not changed by upgrade")
+	}
+}
+
+func TestRollbackRestoresRevision(t *testing.T) {
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &rollbackClient{}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	upgradeRequests = &upgradeRecorder{}
+	patch3 := gomonkey.ApplyFunc(util.DoRequest, func(req *http.Request) (*http.Response, error) {
+		upgradeRequests.addAuthConfig(req)
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+			StatusCode: http.StatusOK,
+		}, nil
+	})
+	defer patch3.Reset()
+
+	testDb := getUpgradeDb()
+	appInfoRecord := testDb.appInstanceRecords[appInstanceIdentifier]
+	appInfoRecord.AppPackageId = packageId
+	testDb.appInstanceRecords[appInstanceIdentifier] = appInfoRecord
+
+	operation := rollbackAppInstance(t, testDb, 1)
+	assert.Equal(t, util.OperationSuccess, operation.State, "Rollback operation state is wrong")
+	appInfoRecord = testDb.appInstanceRecords[appInstanceIdentifier]
+	assert.Equal(t, util.Instantiated, appInfoRecord.InstanceState, "App instance state is wrong after rollback")
+	assert.Equal(t, "previous", appInfoRecord.AppPackageId, "Package of restored revision is not restored")
+	assert.Equal(t, 1, len(upgradeRequests.authConfigs), "Auth config of restored revision is not registered")
+	assert.Contains(t, upgradeRequests.authConfigs[0], "previousAk", "Ak of restored revision is not registered")
+}
+
+func TestRollbackFailureKeepsInstance(t *testing.T) {
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &rollbackClient{err: errors.New("release has no revision 1")}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := getUpgradeDb()
+	operation := rollbackAppInstance(t, testDb, 1)
+	assert.Equal(t, util.OperationFailed, operation.State, "Failed rollback operation state is wrong")
+	appInfoRecord := testDb.appInstanceRecords[appInstanceIdentifier]
+	assert.Equal(t, util.Instantiated, appInfoRecord.InstanceState, "App instance state is wrong after failed rollback")
+	assert.Equal(t, "previous", appInfoRecord.AppPackageId, "Package is changed for failed rollback")
+}
+
+func TestRollbackUnknownRevision(t *testing.T) {
+	patch1 := gomonkey.ApplyFunc(pluginAdapter.GetClient, func(_ string) (pluginAdapter.ClientIntf, error) {
+		return &rollbackClient{}, nil
+	})
+	defer patch1.Reset()
+
+	var c *beego.Controller
+	patch2 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch2.Reset()
+
+	testDb := getUpgradeDb()
+	controller := getRollbackController(testDb, 3)
+	controller.Rollback()
+	assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status, "Rollback to unknown revision failed")
+	assert.Empty(t, testDb.lcmOperationRecords, "Operation is created for unknown revision")
+	assert.Equal(t, util.Instantiated, testDb.appInstanceRecords[appInstanceIdentifier].InstanceState,
+		"App instance state is changed for unknown revision")
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright 2020 Huawei Technologies Co., Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/agiledragon/gomonkey"
+	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"lcmcontroller/controllers"
+	"lcmcontroller/models"
+	"lcmcontroller/pkg/dbAdapter"
+	"lcmcontroller/util"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var uploadsPath = tenantsPath + tenantIdentifier + "/packages/uploads"
+
+// Create lcm controller for package upload request
+func getUploadController(testDb dbAdapter.Database, method string, uploadId string, chunkNumber int,
+	body []byte) *controllers.LcmController {
+
+	url := uploadsPath
+	if uploadId != "" {
+		url += "/" + uploadId
+	}
+	request, _ := getHttpRequest(url, nil, "", "", method, body)
+	digest := sha256.Sum256(body)
+	request.Header.Set(util.ChunkChecksum, hex.EncodeToString(digest[:]))
+
+	input := &context.BeegoInput{Context: &context.Context{Request: request}, RequestBody: body}
+	setParam(input)
+	input.SetParam(":uploadId", uploadId)
+	input.SetParam(":chunkNumber", strconv.Itoa(chunkNumber))
+
+	beegoController := beego.Controller{Ctx: &context.Context{Input: input, Request: request,
+		ResponseWriter: &context.Response{ResponseWriter: httptest.NewRecorder()}},
+		Data: make(map[interface{}]interface{})}
+	return &controllers.LcmController{controllers.BaseController{Db: testDb, Controller: beegoController}}
+}
+
+// Get package upload status from response
+func getUploadStatus(controller *controllers.LcmController) models.PackageUploadStatus {
+	var status models.PackageUploadStatus
+	response := controller.Ctx.ResponseWriter.ResponseWriter.(*httptest.ResponseRecorder)
+	_ = json.Unmarshal(response.Body.Bytes(), &status)
+	return status
+}
+
+// Create package upload session
+func createPackageUpload(t *testing.T, testDb dbAdapter.Database, pkg []byte, chunkSize int64) models.PackageUploadStatus {
+	digest := sha256.Sum256(pkg)
+	body, _ := json.Marshal(models.PackageUploadRequest{PackageId: packageId, Origin: originVal,
+		FileName: "positioning.csar", TotalSize: int64(len(pkg)), ChunkSize: chunkSize,
+		Checksum: hex.EncodeToString(digest[:])})
+
+	controller := getUploadController(testDb, "POST", "", 0, body)
+	controller.CreatePackageUpload()
+	assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Create package upload failed")
+	return getUploadStatus(controller)
+}
+
+func TestPackageUpload(t *testing.T) {
+	var c *beego.Controller
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch1.Reset()
+
+	baseDir, _ := os.Getwd()
+	controllers.PackageFolderPath = baseDir + directory
+	defer os.RemoveAll(baseDir + directory)
+
+	testDb := &mockDb{appInstanceRecords: make(map[string]models.AppInfoRecord),
+		tenantRecords: make(map[string]models.TenantInfoRecord),
+		appPackageRecords: make(map[string]models.AppPackageRecord),
+		mecHostRecords: make(map[string]models.MecHost),
+		appPackageHostRecords: make(map[string]models.AppPackageHostRecord),
+		lcmOperationRecords: make(map[string]models.LcmOperation),
+		packageUploadRecords: make(map[string]models.PackageUploadSession)}
+
+	pkg, _ := ioutil.ReadFile("positioning_with_mepagent_new.csar")
+	chunkSize := int64(len(pkg)/3 + 1)
+	chunks := [][]byte{pkg[:chunkSize], pkg[chunkSize : 2*chunkSize], pkg[2*chunkSize:]}
+
+	status := createPackageUpload(t, testDb, pkg, chunkSize)
+	uploadId := status.UploadId
+	assert.Equal(t, []int{0, 1, 2}, status.MissingChunks, "Create package upload failed")
+
+	// Chunks are received in any order
+	for _, chunkNumber := range []int{2, 0} {
+		controller := getUploadController(testDb, "PUT", uploadId, chunkNumber, chunks[chunkNumber])
+		controller.UploadPackageChunk()
+		assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Upload package chunk failed")
+	}
+
+	controller := getUploadController(testDb, "GET", uploadId, 0, nil)
+	controller.QueryPackageUpload()
+	status = getUploadStatus(controller)
+	assert.Equal(t, []int{1}, status.MissingChunks, "Query package upload failed")
+	assert.Equal(t, []models.UploadedChunk{{ChunkNumber: 0, Offset: 0, Size: chunkSize},
+		{ChunkNumber: 2, Offset: 2 * chunkSize, Size: int64(len(chunks[2]))}}, status.ReceivedChunks,
+		"Query package upload failed")
+
+	controller = getUploadController(testDb, "POST", uploadId, 0, nil)
+	controller.FinalizePackageUpload()
+	assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status, "Finalize incomplete upload failed")
+
+	// Chunk with mismatched checksum is rejected
+	controller = getUploadController(testDb, "PUT", uploadId, 1, chunks[1])
+	controller.Ctx.Request.Header.Set(util.ChunkChecksum, hex.EncodeToString(make([]byte, 32)))
+	controller.UploadPackageChunk()
+	assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status, "Upload invalid chunk failed")
+
+	controller = getUploadController(testDb, "PUT", uploadId, 3, chunks[2])
+	controller.UploadPackageChunk()
+	assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status, "Upload out of range chunk failed")
+
+	controller = getUploadController(testDb, "PUT", uploadId, 1, chunks[1])
+	controller.UploadPackageChunk()
+	assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Upload package chunk failed")
+
+	controller = getUploadController(testDb, "POST", uploadId, 0, nil)
+	controller.FinalizePackageUpload()
+	assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Finalize package upload failed")
+	var appPkgResp models.AppPackageResponse
+	response := controller.Ctx.ResponseWriter.ResponseWriter.(*httptest.ResponseRecorder)
+	_ = json.Unmarshal(response.Body.Bytes(), &appPkgResp)
+	assert.Equal(t, packageId, appPkgResp.PackageId, "Finalize package upload failed")
+	_, exists := testDb.appPackageRecords[packageId+tenantIdentifier]
+	assert.True(t, exists, "App package record is not added")
+	assert.Empty(t, testDb.packageUploadRecords, "Package upload session is not deleted")
+
+	controller = getUploadController(testDb, "GET", uploadId, 0, nil)
+	controller.QueryPackageUpload()
+	assert.Equal(t, util.StatusNotFound, controller.Ctx.ResponseWriter.Status, "Query finalized upload failed")
+}
+
+func TestPackageUploadAbort(t *testing.T) {
+	var c *beego.Controller
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch1.Reset()
+
+	baseDir, _ := os.Getwd()
+	controllers.PackageFolderPath = baseDir + directory
+	defer os.RemoveAll(baseDir + directory)
+
+	testDb := &mockDb{packageUploadRecords: make(map[string]models.PackageUploadSession)}
+	pkg, _ := ioutil.ReadFile("positioning_with_mepagent_new.csar")
+	status := createPackageUpload(t, testDb, pkg, 0)
+	assert.Equal(t, util.DefaultUploadChunkSize, status.ChunkSize, "Create package upload failed")
+
+	controller := getUploadController(testDb, "DELETE", status.UploadId, 0, nil)
+	controller.AbortPackageUpload()
+	assert.Equal(t, 0, controller.Ctx.ResponseWriter.Status, "Abort package upload failed")
+	assert.Empty(t, testDb.packageUploadRecords, "Package upload session is not deleted")
+
+	// Session with size above package limit is rejected
+	body, _ := json.Marshal(models.PackageUploadRequest{FileName: "positioning.csar",
+		TotalSize: util.MaxAppPackageFile})
+	controller = getUploadController(testDb, "POST", "", 0, body)
+	controller.CreatePackageUpload()
+	assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status, "Create package upload failed")
+}
+
+// Make package upload session stale by moving its creation time beyond the session ttl
+func expirePackageUpload(testDb *mockDb, uploadId string) {
+	testDb.mutex.Lock()
+	defer testDb.mutex.Unlock()
+	session := testDb.packageUploadRecords[uploadId]
+	session.CreateTime = time.Now().Add(-time.Duration(util.DefaultUploadSessionTtl+1) * time.Second)
+	testDb.packageUploadRecords[uploadId] = session
+}
+
+func TestPackageUploadSessionLimitAndSweep(t *testing.T) {
+	var c *beego.Controller
+	patch1 := gomonkey.ApplyMethod(reflect.TypeOf(c), "ServeJSON", func(*beego.Controller, ...bool) {
+		go func() {
+			// do nothing
+		}()
+	})
+	defer patch1.Reset()
+
+	baseDir, _ := os.Getwd()
+	controllers.PackageFolderPath = baseDir + directory
+	defer os.RemoveAll(baseDir + directory)
+
+	testDb := &mockDb{packageUploadRecords: make(map[string]models.PackageUploadSession)}
+	pkg, _ := ioutil.ReadFile("positioning_with_mepagent_new.csar")
+	uploadIds := make([]string, 0)
+	for i := 0; i < util.MaxNumberOfUploadSessions; i++ {
+		uploadIds = append(uploadIds, createPackageUpload(t, testDb, pkg, 0).UploadId)
+	}
+
+	body, _ := json.Marshal(models.PackageUploadRequest{FileName: "positioning.csar", TotalSize: int64(len(pkg))})
+	controller := getUploadController(testDb, "POST", "", 0, body)
+	controller.CreatePackageUpload()
+	assert.Equal(t, util.BadRequest, controller.Ctx.ResponseWriter.Status, "Package upload session limit failed")
+
+	// Stale session is deleted with its chunks and does not count for the limit
+	expirePackageUpload(testDb, uploadIds[0])
+	_ = createPackageUpload(t, testDb, pkg, 0)
+	_, exists := testDb.packageUploadRecords[uploadIds[0]]
+	assert.False(t, exists, "Stale package upload session is not deleted")
+	_, err := os.Stat(controllers.PackageFolderPath + "uploads/" + tenantIdentifier + "/" + uploadIds[0])
+	assert.True(t, os.IsNotExist(err), "Chunks of stale package upload session are not deleted")
+
+	// Stale session can not be used any more
+	expirePackageUpload(testDb, uploadIds[1])
+	controller = getUploadController(testDb, "GET", uploadIds[1], 0, nil)
+	controller.QueryPackageUpload()
+	assert.Equal(t, util.StatusNotFound, controller.Ctx.ResponseWriter.Status, "Query stale upload failed")
+
+	expirePackageUpload(testDb, uploadIds[2])
+	controllers.SweepPackageUploadSessions(testDb)
+	assert.Equal(t, util.MaxNumberOfUploadSessions-2, len(testDb.packageUploadRecords),
+		"Sweep package upload sessions failed")
+	_, exists = testDb.packageUploadRecords[uploadIds[2]]
+	assert.False(t, exists, "Stale package upload session is not swept")
+}
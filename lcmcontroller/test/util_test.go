@@ -201,3 +201,32 @@ func TestGetPluginInfo(t *testing.T) {
 	pluginInfo := util.GetPluginInfo("")
 	assert.Equal(t, "127.0.0.1:10001", pluginInfo, "Test get plugin info")
 }
+
+func TestValidateInstanceStateTransitionSuccess(t *testing.T) {
+	err := util.ValidateInstanceStateTransition(util.Instantiating, util.Instantiated)
+	assert.NoError(t, err, "TestValidateInstanceStateTransitionSuccess execution result")
+}
+
+func TestValidateInstanceStateTransitionInvalid(t *testing.T) {
+	err := util.ValidateInstanceStateTransition(util.Instantiating, util.Terminating)
+	assert.Error(t, err, "TestValidateInstanceStateTransitionInvalid execution result")
+}
+
+func TestValidateInstanceStateTransitionFailedTermination(t *testing.T) {
+	err := util.ValidateInstanceStateTransition(util.Terminating, util.Instantiated)
+	assert.NoError(t, err, "TestValidateInstanceStateTransitionFailedTermination execution result")
+}
+
+func TestValidateTenantNamespaceSuccess(t *testing.T) {
+	for _, namespace := range []string{tenantIdentifier, tenantIdentifier + "-dev"} {
+		err := util.ValidateTenantNamespace(namespace, tenantIdentifier)
+		assert.NoError(t, err, "TestValidateTenantNamespaceSuccess execution result")
+	}
+}
+
+func TestValidateTenantNamespaceInvalid(t *testing.T) {
+	for _, namespace := range []string{"kube-system", "default", tenantIdentifier + "dev", tenantIdentifier + "-Dev"} {
+		err := util.ValidateTenantNamespace(namespace, tenantIdentifier)
+		assert.Error(t, err, "TestValidateTenantNamespaceInvalid execution result")
+	}
+}
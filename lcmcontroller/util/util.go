@@ -64,10 +64,17 @@ const (
 	AppPkgId                        = "app_pkg_id"
 	AppPackageRecordId              = "app_package_record"
 	PkgHostKey                      = "pkg_host_key"
+	OperationId                     = "operation_id"
+	UploadId                        = "upload_id"
+	PluginId                        = "plugin_id"
+	PluginRecord                    = "plugin_record"
+	PluginVimTypesFilter            = "vim_types__icontains"
+	PackageUploadSessionTable       = "package_upload_session"
 	TenantId                        = "tenant_id"
 	HostIp                          = "mec_host_id"
 	Mec_Host                        = "mec_host"
 	FailedToGetClient               = "Failed to get client"
+	FailedToGetPluginInfo           = "Failed to get plugin info"
 	FailedToMakeDir                 = "failed to make directory"
 	FileNameNotFound                = "file name not found with "
 	AppNameIsNotValid               = "AppName is invalid"
@@ -85,19 +92,36 @@ const (
 	MaxNumberOfRecords              = 50
 	MaxNumberOfTenantRecords        = 20
 	MaxNumberOfHostRecords          = 20
+	MaxNumberOfPlugins              = 20
+	MaxNumberOfUploadSessions       = 5
 	MaxFileNameSize                 = 128
+	DefaultUploadChunkSize   int64  = 8388608
+	DefaultUploadSessionTtl         = 86400
+	MaxUploadChunkSize       int64  = 33554432
+	DefaultDistributionConcurrency  = 5
+	DefaultDistributionTimeout      = 1800
+	DefaultReadyTimeout             = 300
+	MaxReadyTimeout                 = 3600
+	HealthQueryTimeout              = 10
+	HealthQueryConcurrency          = 5
 
 	BadRequest                int = 400
 	StatusUnauthorized        int = 401
 	StatusInternalServerError int = 500
 	StatusNotFound            int = 404
 	StatusForbidden           int = 403
+	StatusAccepted            int = 202
+	StatusNotImplemented      int = 501
+	StatusConflict            int = 409
 	RequestBodyLength             = 4096
 
 	UuidRegex     = `^[a-fA-F0-9]{8}[a-fA-F0-9]{4}4[a-fA-F0-9]{3}[8|9|aA|bB][a-fA-F0-9]{3}[a-fA-F0-9]{12}$`
 	NameRegex     = "^[\\d\\p{L}]*$|^[\\d\\p{L}][\\d\\p{L}_\\-]*[\\d\\p{L}]$"
 	CityRegex     = "^[\\d\\p{L}]*$|^[\\d\\p{L}][\\d\\p{L}\\/\\s]*[\\d\\p{L}]$"
 	AffinityRegex = "^[\\d\\p{L}]*$|^[\\d\\p{L}][\\d\\p{L}_\\-\\,]*[\\d\\p{L}]$"
+	NamespaceRegex = "^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$"
+	PodNameRegex   = "^[a-z0-9]([-a-z0-9.]{0,251}[a-z0-9])?$"
+	ContainerNameRegex = "^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$"
 
 	minPasswordSize         = 8
 	maxPasswordSize         = 16
@@ -135,10 +159,12 @@ const (
 	Lcmcontroller        = "lcmcontroller/controllers:LcmController"
 	Imagecontroller      = "lcmcontroller/controllers:ImageController"
 	MecHostcontroller    = "lcmcontroller/controllers:MecHostController"
+	Plugincontroller     = "lcmcontroller/controllers:PluginController"
 	Hosts                = "/hosts"
 	DELETE               = "delete"
 	GET                  = "get"
 	POST                 = "post"
+	PUT                  = "put"
 	Operation            = "] Operation ["
 	Resource             = " Resource ["
 	TempFile             = "/usr/app/temp"
@@ -148,8 +174,76 @@ const (
 	MecHostInfo          = "MecHostInfo"
 	PkgId                = "package_id"
 	PkgUrlPath           = "/tenants/:tenantId/packages/:packageId"
+	OperationInstantiate = "Instantiate"
+	OperationTerminate   = "Terminate"
+	OperationDistribute  = "Distribute"
+	OperationUpgrade     = "Upgrade"
+	OperationRollback    = "Rollback"
+	OperationScale       = "Scale"
+	OperationProcessing  = "PROCESSING"
+	OperationSuccess     = "SUCCESS"
+	OperationFailed      = "FAILED"
+	OperationInterrupted = "Operation is interrupted by restart of lcm controller"
+	LcmOperationTable    = "lcm_operation"
+	AppPackageHostTable  = "app_package_host_record"
+	Distributing         = "Distributing"
+	Distributed          = "Distributed"
+	DistributionError    = "Error"
+	OperationIdIsInvalid = "Operation id is invalid"
+	Instantiating        = "INSTANTIATING"
+	Instantiated         = "INSTANTIATED"
+	InstantiationFailed  = "FAILED"
+	Terminating          = "TERMINATING"
+	Upgrading            = "UPGRADING"
+	RollingBack          = "ROLLING_BACK"
+	RevisionIsInvalid    = "Revision is invalid"
+	RevisionNotFound     = "Revision does not exist in release history"
+	Scaling              = "SCALING"
+	ReplicasIsInvalid    = "Replicas is invalid"
+	MaxReplicas          = 100
+	AutoscalingIsInvalid = "Autoscaling policy is invalid"
+	AutoscalingNotSet    = "Autoscaling policy is not set"
+	AutoscalingIsSet     = "Autoscaling policy is set, delete it before scaling"
+	InstanceNotInstantiated = "App instance is not instantiated"
+	PodNameIsInvalid     = "Pod name is invalid"
+	ContainerNameIsInvalid = "Container name is invalid"
+	TailLinesIsInvalid   = "Tail lines is invalid"
+	SinceTimeIsInvalid   = "Since time is invalid"
+	FollowIsInvalid      = "Follow is invalid"
+	HealthIsInvalid      = "Health is invalid"
+	EventTypeIsInvalid   = "Event type is invalid"
+	ReadyTimeoutIsInvalid = "Ready timeout is invalid"
+	EventTypeNormal      = "Normal"
+	EventTypeWarning     = "Warning"
+	EventStream          = "text/event-stream"
+	ParametersIsInvalid  = "Parameters is invalid"
+	NamespaceIsInvalid   = "Namespace is invalid"
+	PackageValidationFailed = "Application package validation failed"
+	PackageTrustStore    = "packageTrustStore"
+	PackageManifestNotEnforced = "packageManifestNotEnforced"
+	ChunkChecksum        = "chunk_checksum"
+	DistributionConcurrency = "distributionConcurrency"
+	DistributionTimeout  = "distributionTimeout"
+	UploadIdIsInvalid    = "Upload id is invalid"
+	UploadSessionTtl     = "uploadSessionTtl"
+	ChunkNumberIsInvalid = "Chunk number is invalid"
+	PackageUploadIncomplete = "Package upload is incomplete"
+	PluginIdIsInvalid    = "Plugin id is invalid"
+	PluginDoesNotExist   = "Plugin does not exist"
 )
-var VmImageMap       = make(map[int32][]byte, 150000)
+
+// Allowed app instance state transitions. Record of terminated app instance is deleted, so there is no terminated
+// state, failed termination returns the instance to its previous state or to failed when its records can not be
+// deleted
+var instanceStateTransitions = map[string][]string{
+	Instantiating:       {Instantiated, InstantiationFailed},
+	Instantiated:        {Terminating, Upgrading, RollingBack, Scaling},
+	InstantiationFailed: {Terminating, RollingBack},
+	Terminating:         {Instantiated, InstantiationFailed},
+	Upgrading:           {Instantiated, InstantiationFailed},
+	RollingBack:         {Instantiated, InstantiationFailed},
+	Scaling:             {Instantiated},
+}
 
 var cipherSuiteMap = map[string]uint16{
 	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256": tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
@@ -165,6 +259,15 @@ func GetAppConfig(k string) string {
 	return beego.AppConfig.String(k)
 }
 
+// Get positive integer app configuration, default value is used when it is not set or invalid
+func GetAppConfigPositiveInt(k string, defaultValue int) int {
+	value, err := beego.AppConfig.Int(k)
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
 // Validate UUID
 func ValidateUUID(id string) error {
 	if id == "" {
@@ -178,6 +281,35 @@ func ValidateUUID(id string) error {
 	return nil
 }
 
+// Validate kubernetes pod name
+func ValidatePodName(podName string) error {
+	match, err := regexp.MatchString(PodNameRegex, podName)
+	if err != nil || !match {
+		return errors.New("pod name validation failed")
+	}
+	return nil
+}
+
+// Validate container name of pod
+func ValidateContainerName(containerName string) error {
+	match, err := regexp.MatchString(ContainerNameRegex, containerName)
+	if err != nil || !match {
+		return errors.New("container name validation failed")
+	}
+	return nil
+}
+
+// Validate app instance state transition
+func ValidateInstanceStateTransition(currentState, nextState string) error {
+	for _, state := range instanceStateTransitions[currentState] {
+		if state == nextState {
+			return nil
+		}
+	}
+	return errors.New("app instance state transition from " + currentState + " to " + nextState +
+		" is not allowed")
+}
+
 // Validate IPv4 address
 func ValidateIpv4Address(id string) error {
 	if id == "" {
@@ -188,6 +320,18 @@ func ValidateIpv4Address(id string) error {
 	return validate.Var(id, "required,ipv4")
 }
 
+// Validate plugin address in host:port format
+func ValidatePluginAddress(address string) error {
+	validate := validator.New()
+	return validate.Var(address, "required,hostname_port")
+}
+
+// Validate TLS server name, empty server name is allowed
+func ValidateServerName(serverName string) error {
+	validate := validator.New()
+	return validate.Var(serverName, "omitempty,hostname_rfc1123")
+}
+
 // Validate source address
 func ValidateSrcAddress(id string) error {
 	if id == "" {
@@ -630,6 +774,19 @@ func ValidateName(name string, regex string) (bool, error) {
 	return regexp.MatchString(regex, name)
 }
 
+// Validate namespace is owned by the tenant, which is the tenant namespace or one prefixed with it
+func ValidateTenantNamespace(namespace string, tenantId string) error {
+	tenantNamespace := strings.ToLower(tenantId)
+	if namespace != tenantNamespace && !strings.HasPrefix(namespace, tenantNamespace+"-") {
+		return errors.New("namespace is not owned by tenant")
+	}
+	match, err := ValidateName(namespace, NamespaceRegex)
+	if err != nil || !match {
+		return errors.New(NamespaceIsInvalid)
+	}
+	return nil
+}
+
 // Handle number of REST requests per second
 func RateLimit(r *RateLimiter, ctx *context.Context) {
 	var (
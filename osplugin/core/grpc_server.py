@@ -29,6 +29,7 @@ from service.vm_image_service import VmImageService
 _ONE_DAY_IN_SECONDS = 60 * 60 * 24
 _LISTEN_PORT = 8234
 MAX_MESSAGE_LENGTH = 1024 * 1024 * 50
+KEEPALIVE_MIN_TIME_MS = 10 * 1000
 LOG = logger
 
 
@@ -39,6 +40,9 @@ def serve():
     options = [
         ('grpc.max_send_message_length', MAX_MESSAGE_LENGTH),
         ('grpc.max_receive_message_length', MAX_MESSAGE_LENGTH),
+        # keepalive pings of lcmcontroller connection pool are permitted on idle connections
+        ('grpc.keepalive_permit_without_calls', 1),
+        ('grpc.http2.min_ping_interval_without_data_ms', KEEPALIVE_MIN_TIME_MS),
     ]
 
     server = grpc.server(futures.ThreadPoolExecutor(max_workers=200), options=options)
@@ -19,7 +19,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=None,
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x10lcmservice.proto\x12\nlcmservice\"\x90\x01\n\x12InstantiateRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x10\n\x08tenantId\x18\x02 \x01(\t\x12\x15\n\rappInstanceId\x18\x03 \x01(\t\x12\x14\n\x0c\x61ppPackageId\x18\x04 \x01(\t\x12\x0e\n\x06hostIp\x18\x05 \x01(\t\x12\n\n\x02\x61k\x18\x06 \x01(\t\x12\n\n\x02sk\x18\x07 \x01(\t\"%\n\x13InstantiateResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"N\n\x10TerminateRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\"#\n\x11TerminateResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"J\n\x0cQueryRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\"!\n\rQueryResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"Y\n\x10UploadCfgRequest\x12\x15\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\tH\x00\x12\x10\n\x06hostIp\x18\x02 \x01(\tH\x00\x12\x14\n\nconfigFile\x18\x03 \x01(\x0cH\x00\x42\x06\n\x04\x64\x61ta\"#\n\x11UploadCfgResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"7\n\x10RemoveCfgRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x0e\n\x06hostIp\x18\x02 \x01(\t\"#\n\x11RemoveCfgResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"S\n\x15WorkloadEventsRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\"*\n\x16WorkloadEventsResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"`\n\x14\x43reateVmImageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0c\n\x04vmId\x18\x04 \x01(\t\")\n\x15\x43reateVmImageResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"b\n\x13QueryVmImageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0f\n\x07imageId\x18\x04 \x01(\t\"(\n\x14QueryVmImageResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"c\n\x14\x44\x65leteVmImageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0f\n\x07imageId\x18\x04 \x01(\t\")\n\x15\x44\x65leteVmImageResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"w\n\x16\x44ownloadVmImageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0f\n\x07imageId\x18\x04 \x01(\t\x12\x10\n\x08\x63hunkNum\x18\x05 \x01(\x05\"*\n\x17\x44ownloadVmImageResponse\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\x0c\"\x86\x01\n\x14UploadPackageRequest\x12\x15\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\tH\x00\x12\x16\n\x0c\x61ppPackageId\x18\x02 \x01(\tH\x00\x12\x10\n\x06hostIp\x18\x03 \x01(\tH\x00\x12\x12\n\x08tenantId\x18\x04 \x01(\tH\x00\x12\x11\n\x07package\x18\x05 \x01(\x0cH\x00\x42\x06\n\x04\x64\x61ta\"\'\n\x15UploadPackageResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"c\n\x14\x44\x65letePackageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x10\n\x08tenantId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x14\n\x0c\x61ppPackageId\x18\x04 \x01(\t\"\'\n\x15\x44\x65letePackageResponse\x12\x0e\n\x06status\x18\x01 \x01(\t2\x93\x05\n\x06\x41ppLCM\x12P\n\x0binstantiate\x12\x1e.lcmservice.InstantiateRequest\x1a\x1f.lcmservice.InstantiateResponse\"\x00\x12J\n\tterminate\x12\x1c.lcmservice.TerminateRequest\x1a\x1d.lcmservice.TerminateResponse\"\x00\x12>\n\x05query\x12\x18.lcmservice.QueryRequest\x1a\x19.lcmservice.QueryResponse\"\x00\x12O\n\x0cuploadConfig\x12\x1c.lcmservice.UploadCfgRequest\x1a\x1d.lcmservice.UploadCfgResponse\"\x00(\x01\x12M\n\x0cremoveConfig\x12\x1c.lcmservice.RemoveCfgRequest\x1a\x1d.lcmservice.RemoveCfgResponse\"\x00\x12Y\n\x0eworkloadEvents\x12!.lcmservice.WorkloadEventsRequest\x1a\".lcmservice.WorkloadEventsResponse\"\x00\x12X\n\ruploadPackage\x12 .lcmservice.UploadPackageRequest\x1a!.lcmservice.UploadPackageResponse\"\x00(\x01\x12V\n\rdeletePackage\x12 .lcmservice.DeletePackageRequest\x1a!.lcmservice.DeletePackageResponse\"\x00\x32\xee\x02\n\x07VmImage\x12V\n\rcreateVmImage\x12 .lcmservice.CreateVmImageRequest\x1a!.lcmservice.CreateVmImageResponse\"\x00\x12S\n\x0cqueryVmImage\x12\x1f.lcmservice.QueryVmImageRequest\x1a .lcmservice.QueryVmImageResponse\"\x00\x12V\n\rdeleteVmImage\x12 .lcmservice.DeleteVmImageRequest\x1a!.lcmservice.DeleteVmImageResponse\"\x00\x12^\n\x0f\x64ownloadVmImage\x12\".lcmservice.DownloadVmImageRequest\x1a#.lcmservice.DownloadVmImageResponse\"\x00\x30\x01\x62\x06proto3'
+  serialized_pb=b'\n\x10lcmservice.proto\x12\nlcmservice\"\xe3\x01\n\x12InstantiateRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x10\n\x08tenantId\x18\x02 \x01(\t\x12\x15\n\rappInstanceId\x18\x03 \x01(\t\x12\x14\n\x0c\x61ppPackageId\x18\x04 \x01(\t\x12\x0e\n\x06hostIp\x18\x05 \x01(\t\x12\n\n\x02\x61k\x18\x06 \x01(\t\x12\n\n\x02sk\x18\x07 \x01(\t\x12\x12\n\nparameters\x18\x08 \x01(\t\x12\x11\n\tnamespace\x18\t \x01(\t\x12\x14\n\x0cwaitForReady\x18\n \x01(\x08\x12\x14\n\x0creadyTimeout\x18\x0b \x01(\x05\"5\n\x13InstantiateResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\x12\x0e\n\x06reason\x18\x02 \x01(\t\"N\n\x10TerminateRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\"#\n\x11TerminateResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"\x8c\x01\n\x0eUpgradeRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x10\n\x08tenantId\x18\x02 \x01(\t\x12\x15\n\rappInstanceId\x18\x03 \x01(\t\x12\x14\n\x0c\x61ppPackageId\x18\x04 \x01(\t\x12\x0e\n\x06hostIp\x18\x05 \x01(\t\x12\n\n\x02\x61k\x18\x06 \x01(\t\x12\n\n\x02sk\x18\x07 \x01(\t\"!\n\x0fUpgradeResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"_\n\x0fRollbackRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x10\n\x08revision\x18\x04 \x01(\x05\"M\n\x10RollbackResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\x12\x11\n\tpackageId\x18\x02 \x01(\t\x12\n\n\x02\x61k\x18\x03 \x01(\t\x12\n\n\x02sk\x18\x04 \x01(\t\"\\\n\x0cScaleRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x10\n\x08replicas\x18\x04 \x01(\x05\"\x1f\n\rScaleResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"c\n\x15SetAutoscalingRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0e\n\x06policy\x18\x04 \x01(\t\"(\n\x16SetAutoscalingResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"V\n\x18\x44\x65leteAutoscalingRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\"+\n\x19\x44\x65leteAutoscalingResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"\xaf\x01\n\x13WorkloadLogsRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0f\n\x07podName\x18\x04 \x01(\t\x12\x15\n\rcontainerName\x18\x05 \x01(\t\x12\x11\n\ttailLines\x18\x06 \x01(\x03\x12\x11\n\tsinceTime\x18\x07 \x01(\t\x12\x0e\n\x06\x66ollow\x18\x08 \x01(\x08\"\'\n\x14WorkloadLogsResponse\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\x0c\"L\n\x0eHistoryRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\"#\n\x0fHistoryResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"^\n\x0cQueryRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x12\n\nhealthOnly\x18\x04 \x01(\x08\"0\n\rHealthSummary\x12\x0e\n\x06status\x18\x01 \x01(\t\x12\x0f\n\x07reasons\x18\x02 \x03(\t\"L\n\rQueryResponse\x12\x10\n\x08response\x18\x01 \x01(\t\x12)\n\x06health\x18\x02 \x01(\x0b\x32\x19.lcmservice.HealthSummary\"Y\n\x10UploadCfgRequest\x12\x15\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\tH\x00\x12\x10\n\x06hostIp\x18\x02 \x01(\tH\x00\x12\x14\n\nconfigFile\x18\x03 \x01(\x0cH\x00\x42\x06\n\x04\x64\x61ta\"#\n\x11UploadCfgResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"7\n\x10RemoveCfgRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x0e\n\x06hostIp\x18\x02 \x01(\t\"#\n\x11RemoveCfgResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"t\n\x15WorkloadEventsRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x11\n\tsinceTime\x18\x04 \x01(\t\x12\x0c\n\x04type\x18\x05 \x01(\t\"*\n\x16WorkloadEventsResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"N\n\x10\x45ndpointsRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\"%\n\x11\x45ndpointsResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"`\n\x14\x43reateVmImageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0c\n\x04vmId\x18\x04 \x01(\t\")\n\x15\x43reateVmImageResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"b\n\x13QueryVmImageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0f\n\x07imageId\x18\x04 \x01(\t\"(\n\x14QueryVmImageResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"c\n\x14\x44\x65leteVmImageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0f\n\x07imageId\x18\x04 \x01(\t\")\n\x15\x44\x65leteVmImageResponse\x12\x10\n\x08response\x18\x01 \x01(\t\"w\n\x16\x44ownloadVmImageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x15\n\rappInstanceId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x0f\n\x07imageId\x18\x04 \x01(\t\x12\x10\n\x08\x63hunkNum\x18\x05 \x01(\x05\"*\n\x17\x44ownloadVmImageResponse\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\x0c\"\x86\x01\n\x14UploadPackageRequest\x12\x15\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\tH\x00\x12\x16\n\x0c\x61ppPackageId\x18\x02 \x01(\tH\x00\x12\x10\n\x06hostIp\x18\x03 \x01(\tH\x00\x12\x12\n\x08tenantId\x18\x04 \x01(\tH\x00\x12\x11\n\x07package\x18\x05 \x01(\x0cH\x00\x42\x06\n\x04\x64\x61ta\"\'\n\x15UploadPackageResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"c\n\x14\x44\x65letePackageRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\x12\x10\n\x08tenantId\x18\x02 \x01(\t\x12\x0e\n\x06hostIp\x18\x03 \x01(\t\x12\x14\n\x0c\x61ppPackageId\x18\x04 \x01(\t\"\'\n\x15\x44\x65letePackageResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\"-\n\x16GetCapabilitiesRequest\x12\x13\n\x0b\x61\x63\x63\x65ssToken\x18\x01 \x01(\t\"y\n\x17GetCapabilitiesResponse\x12\x12\n\noperations\x18\x01 \x03(\t\x12\x17\n\x0f\x64\x65ploymentTypes\x18\x02 \x03(\t\x12\x16\n\x0emaxPackageSize\x18\x03 \x01(\x03\x12\x19\n\x11maxConfigFileSize\x18\x04 \x01(\x03\x32\xe8\n\n\x06\x41ppLCM\x12P\n\x0binstantiate\x12\x1e.lcmservice.InstantiateRequest\x1a\x1f.lcmservice.InstantiateResponse\"\x00\x12J\n\tterminate\x12\x1c.lcmservice.TerminateRequest\x1a\x1d.lcmservice.TerminateResponse\"\x00\x12>\n\x05query\x12\x18.lcmservice.QueryRequest\x1a\x19.lcmservice.QueryResponse\"\x00\x12\x44\n\x07upgrade\x12\x1a.lcmservice.UpgradeRequest\x1a\x1b.lcmservice.UpgradeResponse\"\x00\x12G\n\x08rollback\x12\x1b.lcmservice.RollbackRequest\x1a\x1c.lcmservice.RollbackResponse\"\x00\x12\x44\n\x07history\x12\x1a.lcmservice.HistoryRequest\x1a\x1b.lcmservice.HistoryResponse\"\x00\x12O\n\x0cuploadConfig\x12\x1c.lcmservice.UploadCfgRequest\x1a\x1d.lcmservice.UploadCfgResponse\"\x00(\x01\x12M\n\x0cremoveConfig\x12\x1c.lcmservice.RemoveCfgRequest\x1a\x1d.lcmservice.RemoveCfgResponse\"\x00\x12Y\n\x0eworkloadEvents\x12!.lcmservice.WorkloadEventsRequest\x1a\".lcmservice.WorkloadEventsResponse\"\x00\x12X\n\ruploadPackage\x12 .lcmservice.UploadPackageRequest\x1a!.lcmservice.UploadPackageResponse\"\x00(\x01\x12V\n\rdeletePackage\x12 .lcmservice.DeletePackageRequest\x1a!.lcmservice.DeletePackageResponse\"\x00\x12\\\n\x0fgetCapabilities\x12\".lcmservice.GetCapabilitiesRequest\x1a#.lcmservice.GetCapabilitiesResponse\"\x00\x12>\n\x05scale\x12\x18.lcmservice.ScaleRequest\x1a\x19.lcmservice.ScaleResponse\"\x00\x12Y\n\x0esetAutoscaling\x12!.lcmservice.SetAutoscalingRequest\x1a\".lcmservice.SetAutoscalingResponse\"\x00\x12\x62\n\x11\x64\x65leteAutoscaling\x12$.lcmservice.DeleteAutoscalingRequest\x1a%.lcmservice.DeleteAutoscalingResponse\"\x00\x12U\n\x0cworkloadLogs\x12\x1f.lcmservice.WorkloadLogsRequest\x1a .lcmservice.WorkloadLogsResponse\"\x00\x30\x01\x12J\n\tendpoints\x12\x1c.lcmservice.EndpointsRequest\x1a\x1d.lcmservice.EndpointsResponse\"\x00\x32\xee\x02\n\x07VmImage\x12V\n\rcreateVmImage\x12 .lcmservice.CreateVmImageRequest\x1a!.lcmservice.CreateVmImageResponse\"\x00\x12S\n\x0cqueryVmImage\x12\x1f.lcmservice.QueryVmImageRequest\x1a .lcmservice.QueryVmImageResponse\"\x00\x12V\n\rdeleteVmImage\x12 .lcmservice.DeleteVmImageRequest\x1a!.lcmservice.DeleteVmImageResponse\"\x00\x12^\n\x0f\x64ownloadVmImage\x12\".lcmservice.DownloadVmImageRequest\x1a#.lcmservice.DownloadVmImageResponse\"\x00\x30\x01\x62\x06proto3'
 )
 
 
@@ -82,6 +82,34 @@ _INSTANTIATEREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='parameters', full_name='lcmservice.InstantiateRequest.parameters', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='lcmservice.InstantiateRequest.namespace', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='waitForReady', full_name='lcmservice.InstantiateRequest.waitForReady', index=9,
+      number=10, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='readyTimeout', full_name='lcmservice.InstantiateRequest.readyTimeout', index=10,
+      number=11, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -95,7 +123,7 @@ _INSTANTIATEREQUEST = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=33,
-  serialized_end=177,
+  serialized_end=260,
 )
 
 
@@ -114,6 +142,13 @@ _INSTANTIATERESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='lcmservice.InstantiateResponse.reason', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -126,8 +161,8 @@ _INSTANTIATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=179,
-  serialized_end=216,
+  serialized_start=262,
+  serialized_end=315,
 )
 
 
@@ -172,8 +207,8 @@ _TERMINATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=218,
-  serialized_end=296,
+  serialized_start=317,
+  serialized_end=395,
 )
 
 
@@ -204,40 +239,737 @@ _TERMINATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=298,
-  serialized_end=333,
+  serialized_start=397,
+  serialized_end=432,
 )
 
 
-_QUERYREQUEST = _descriptor.Descriptor(
-  name='QueryRequest',
-  full_name='lcmservice.QueryRequest',
+_UPGRADEREQUEST = _descriptor.Descriptor(
+  name='UpgradeRequest',
+  full_name='lcmservice.UpgradeRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='accessToken', full_name='lcmservice.QueryRequest.accessToken', index=0,
+      name='accessToken', full_name='lcmservice.UpgradeRequest.accessToken', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='appInstanceId', full_name='lcmservice.QueryRequest.appInstanceId', index=1,
+      name='tenantId', full_name='lcmservice.UpgradeRequest.tenantId', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='hostIp', full_name='lcmservice.QueryRequest.hostIp', index=2,
+      name='appInstanceId', full_name='lcmservice.UpgradeRequest.appInstanceId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appPackageId', full_name='lcmservice.UpgradeRequest.appPackageId', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostIp', full_name='lcmservice.UpgradeRequest.hostIp', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ak', full_name='lcmservice.UpgradeRequest.ak', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sk', full_name='lcmservice.UpgradeRequest.sk', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=435,
+  serialized_end=575,
+)
+
+
+_UPGRADERESPONSE = _descriptor.Descriptor(
+  name='UpgradeResponse',
+  full_name='lcmservice.UpgradeResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='lcmservice.UpgradeResponse.status', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=577,
+  serialized_end=610,
+)
+
+
+_ROLLBACKREQUEST = _descriptor.Descriptor(
+  name='RollbackRequest',
+  full_name='lcmservice.RollbackRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='accessToken', full_name='lcmservice.RollbackRequest.accessToken', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appInstanceId', full_name='lcmservice.RollbackRequest.appInstanceId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostIp', full_name='lcmservice.RollbackRequest.hostIp', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='revision', full_name='lcmservice.RollbackRequest.revision', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=612,
+  serialized_end=707,
+)
+
+
+_ROLLBACKRESPONSE = _descriptor.Descriptor(
+  name='RollbackResponse',
+  full_name='lcmservice.RollbackResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='lcmservice.RollbackResponse.status', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='packageId', full_name='lcmservice.RollbackResponse.packageId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ak', full_name='lcmservice.RollbackResponse.ak', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sk', full_name='lcmservice.RollbackResponse.sk', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=709,
+  serialized_end=786,
+)
+
+
+_SCALEREQUEST = _descriptor.Descriptor(
+  name='ScaleRequest',
+  full_name='lcmservice.ScaleRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='accessToken', full_name='lcmservice.ScaleRequest.accessToken', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appInstanceId', full_name='lcmservice.ScaleRequest.appInstanceId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostIp', full_name='lcmservice.ScaleRequest.hostIp', index=2,
       number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='replicas', full_name='lcmservice.ScaleRequest.replicas', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=788,
+  serialized_end=880,
+)
+
+
+_SCALERESPONSE = _descriptor.Descriptor(
+  name='ScaleResponse',
+  full_name='lcmservice.ScaleResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='lcmservice.ScaleResponse.status', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=882,
+  serialized_end=913,
+)
+
+
+_SETAUTOSCALINGREQUEST = _descriptor.Descriptor(
+  name='SetAutoscalingRequest',
+  full_name='lcmservice.SetAutoscalingRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='accessToken', full_name='lcmservice.SetAutoscalingRequest.accessToken', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appInstanceId', full_name='lcmservice.SetAutoscalingRequest.appInstanceId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostIp', full_name='lcmservice.SetAutoscalingRequest.hostIp', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='policy', full_name='lcmservice.SetAutoscalingRequest.policy', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=915,
+  serialized_end=1014,
+)
+
+
+_SETAUTOSCALINGRESPONSE = _descriptor.Descriptor(
+  name='SetAutoscalingResponse',
+  full_name='lcmservice.SetAutoscalingResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='lcmservice.SetAutoscalingResponse.status', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1016,
+  serialized_end=1056,
+)
+
+
+_DELETEAUTOSCALINGREQUEST = _descriptor.Descriptor(
+  name='DeleteAutoscalingRequest',
+  full_name='lcmservice.DeleteAutoscalingRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='accessToken', full_name='lcmservice.DeleteAutoscalingRequest.accessToken', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appInstanceId', full_name='lcmservice.DeleteAutoscalingRequest.appInstanceId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostIp', full_name='lcmservice.DeleteAutoscalingRequest.hostIp', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1058,
+  serialized_end=1144,
+)
+
+
+_DELETEAUTOSCALINGRESPONSE = _descriptor.Descriptor(
+  name='DeleteAutoscalingResponse',
+  full_name='lcmservice.DeleteAutoscalingResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='lcmservice.DeleteAutoscalingResponse.status', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1146,
+  serialized_end=1189,
+)
+
+
+_WORKLOADLOGSREQUEST = _descriptor.Descriptor(
+  name='WorkloadLogsRequest',
+  full_name='lcmservice.WorkloadLogsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='accessToken', full_name='lcmservice.WorkloadLogsRequest.accessToken', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appInstanceId', full_name='lcmservice.WorkloadLogsRequest.appInstanceId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostIp', full_name='lcmservice.WorkloadLogsRequest.hostIp', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='podName', full_name='lcmservice.WorkloadLogsRequest.podName', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='containerName', full_name='lcmservice.WorkloadLogsRequest.containerName', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='tailLines', full_name='lcmservice.WorkloadLogsRequest.tailLines', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sinceTime', full_name='lcmservice.WorkloadLogsRequest.sinceTime', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='follow', full_name='lcmservice.WorkloadLogsRequest.follow', index=7,
+      number=8, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1192,
+  serialized_end=1367,
+)
+
+
+_WORKLOADLOGSRESPONSE = _descriptor.Descriptor(
+  name='WorkloadLogsResponse',
+  full_name='lcmservice.WorkloadLogsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='content', full_name='lcmservice.WorkloadLogsResponse.content', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1369,
+  serialized_end=1408,
+)
+
+
+_HISTORYREQUEST = _descriptor.Descriptor(
+  name='HistoryRequest',
+  full_name='lcmservice.HistoryRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='accessToken', full_name='lcmservice.HistoryRequest.accessToken', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appInstanceId', full_name='lcmservice.HistoryRequest.appInstanceId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostIp', full_name='lcmservice.HistoryRequest.hostIp', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1410,
+  serialized_end=1486,
+)
+
+
+_HISTORYRESPONSE = _descriptor.Descriptor(
+  name='HistoryResponse',
+  full_name='lcmservice.HistoryResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='response', full_name='lcmservice.HistoryResponse.response', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1488,
+  serialized_end=1523,
+)
+
+
+_QUERYREQUEST = _descriptor.Descriptor(
+  name='QueryRequest',
+  full_name='lcmservice.QueryRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='accessToken', full_name='lcmservice.QueryRequest.accessToken', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appInstanceId', full_name='lcmservice.QueryRequest.appInstanceId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostIp', full_name='lcmservice.QueryRequest.hostIp', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='healthOnly', full_name='lcmservice.QueryRequest.healthOnly', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1525,
+  serialized_end=1619,
+)
+
+
+_HEALTHSUMMARY = _descriptor.Descriptor(
+  name='HealthSummary',
+  full_name='lcmservice.HealthSummary',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='lcmservice.HealthSummary.status', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='reasons', full_name='lcmservice.HealthSummary.reasons', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -250,8 +982,8 @@ _QUERYREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=335,
-  serialized_end=409,
+  serialized_start=1621,
+  serialized_end=1669,
 )
 
 
@@ -270,6 +1002,13 @@ _QUERYRESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='health', full_name='lcmservice.QueryResponse.health', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -282,8 +1021,8 @@ _QUERYRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=411,
-  serialized_end=444,
+  serialized_start=1671,
+  serialized_end=1747,
 )
 
 
@@ -333,8 +1072,8 @@ _UPLOADCFGREQUEST = _descriptor.Descriptor(
       create_key=_descriptor._internal_create_key,
     fields=[]),
   ],
-  serialized_start=446,
-  serialized_end=535,
+  serialized_start=1749,
+  serialized_end=1838,
 )
 
 
@@ -365,8 +1104,8 @@ _UPLOADCFGRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=537,
-  serialized_end=572,
+  serialized_start=1840,
+  serialized_end=1875,
 )
 
 
@@ -404,8 +1143,8 @@ _REMOVECFGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=574,
-  serialized_end=629,
+  serialized_start=1877,
+  serialized_end=1932,
 )
 
 
@@ -436,8 +1175,8 @@ _REMOVECFGRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=631,
-  serialized_end=666,
+  serialized_start=1934,
+  serialized_end=1969,
 )
 
 
@@ -470,6 +1209,20 @@ _WORKLOADEVENTSREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sinceTime', full_name='lcmservice.WorkloadEventsRequest.sinceTime', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='lcmservice.WorkloadEventsRequest.type', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -482,8 +1235,8 @@ _WORKLOADEVENTSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=668,
-  serialized_end=751,
+  serialized_start=1971,
+  serialized_end=2087,
 )
 
 
@@ -514,8 +1267,86 @@ _WORKLOADEVENTSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=753,
-  serialized_end=795,
+  serialized_start=2089,
+  serialized_end=2131,
+)
+
+
+_ENDPOINTSREQUEST = _descriptor.Descriptor(
+  name='EndpointsRequest',
+  full_name='lcmservice.EndpointsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='accessToken', full_name='lcmservice.EndpointsRequest.accessToken', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appInstanceId', full_name='lcmservice.EndpointsRequest.appInstanceId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostIp', full_name='lcmservice.EndpointsRequest.hostIp', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2133,
+  serialized_end=2211,
+)
+
+
+_ENDPOINTSRESPONSE = _descriptor.Descriptor(
+  name='EndpointsResponse',
+  full_name='lcmservice.EndpointsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='response', full_name='lcmservice.EndpointsResponse.response', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2213,
+  serialized_end=2250,
 )
 
 
@@ -567,8 +1398,8 @@ _CREATEVMIMAGEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=797,
-  serialized_end=893,
+  serialized_start=2252,
+  serialized_end=2348,
 )
 
 
@@ -599,8 +1430,8 @@ _CREATEVMIMAGERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=895,
-  serialized_end=936,
+  serialized_start=2350,
+  serialized_end=2391,
 )
 
 
@@ -652,8 +1483,8 @@ _QUERYVMIMAGEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=938,
-  serialized_end=1036,
+  serialized_start=2393,
+  serialized_end=2491,
 )
 
 
@@ -684,8 +1515,8 @@ _QUERYVMIMAGERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1038,
-  serialized_end=1078,
+  serialized_start=2493,
+  serialized_end=2533,
 )
 
 
@@ -737,8 +1568,8 @@ _DELETEVMIMAGEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1080,
-  serialized_end=1179,
+  serialized_start=2535,
+  serialized_end=2634,
 )
 
 
@@ -769,8 +1600,8 @@ _DELETEVMIMAGERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1181,
-  serialized_end=1222,
+  serialized_start=2636,
+  serialized_end=2677,
 )
 
 
@@ -829,8 +1660,8 @@ _DOWNLOADVMIMAGEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1224,
-  serialized_end=1343,
+  serialized_start=2679,
+  serialized_end=2798,
 )
 
 
@@ -861,8 +1692,8 @@ _DOWNLOADVMIMAGERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1345,
-  serialized_end=1387,
+  serialized_start=2800,
+  serialized_end=2842,
 )
 
 
@@ -926,8 +1757,8 @@ _UPLOADPACKAGEREQUEST = _descriptor.Descriptor(
       create_key=_descriptor._internal_create_key,
     fields=[]),
   ],
-  serialized_start=1390,
-  serialized_end=1524,
+  serialized_start=2845,
+  serialized_end=2979,
 )
 
 
@@ -958,8 +1789,8 @@ _UPLOADPACKAGERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1526,
-  serialized_end=1565,
+  serialized_start=2981,
+  serialized_end=3020,
 )
 
 
@@ -1011,8 +1842,8 @@ _DELETEPACKAGEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1567,
-  serialized_end=1666,
+  serialized_start=3022,
+  serialized_end=3121,
 )
 
 
@@ -1043,10 +1874,96 @@ _DELETEPACKAGERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1668,
-  serialized_end=1707,
+  serialized_start=3123,
+  serialized_end=3162,
+)
+
+
+_GETCAPABILITIESREQUEST = _descriptor.Descriptor(
+  name='GetCapabilitiesRequest',
+  full_name='lcmservice.GetCapabilitiesRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='accessToken', full_name='lcmservice.GetCapabilitiesRequest.accessToken', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3164,
+  serialized_end=3209,
+)
+
+
+_GETCAPABILITIESRESPONSE = _descriptor.Descriptor(
+  name='GetCapabilitiesResponse',
+  full_name='lcmservice.GetCapabilitiesResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='operations', full_name='lcmservice.GetCapabilitiesResponse.operations', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='deploymentTypes', full_name='lcmservice.GetCapabilitiesResponse.deploymentTypes', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='maxPackageSize', full_name='lcmservice.GetCapabilitiesResponse.maxPackageSize', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='maxConfigFileSize', full_name='lcmservice.GetCapabilitiesResponse.maxConfigFileSize', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3211,
+  serialized_end=3332,
 )
 
+_QUERYRESPONSE.fields_by_name['health'].message_type = _HEALTHSUMMARY
 _UPLOADCFGREQUEST.oneofs_by_name['data'].fields.append(
   _UPLOADCFGREQUEST.fields_by_name['accessToken'])
 _UPLOADCFGREQUEST.fields_by_name['accessToken'].containing_oneof = _UPLOADCFGREQUEST.oneofs_by_name['data']
@@ -1075,7 +1992,22 @@ DESCRIPTOR.message_types_by_name['InstantiateRequest'] = _INSTANTIATEREQUEST
 DESCRIPTOR.message_types_by_name['InstantiateResponse'] = _INSTANTIATERESPONSE
 DESCRIPTOR.message_types_by_name['TerminateRequest'] = _TERMINATEREQUEST
 DESCRIPTOR.message_types_by_name['TerminateResponse'] = _TERMINATERESPONSE
+DESCRIPTOR.message_types_by_name['UpgradeRequest'] = _UPGRADEREQUEST
+DESCRIPTOR.message_types_by_name['UpgradeResponse'] = _UPGRADERESPONSE
+DESCRIPTOR.message_types_by_name['RollbackRequest'] = _ROLLBACKREQUEST
+DESCRIPTOR.message_types_by_name['RollbackResponse'] = _ROLLBACKRESPONSE
+DESCRIPTOR.message_types_by_name['ScaleRequest'] = _SCALEREQUEST
+DESCRIPTOR.message_types_by_name['ScaleResponse'] = _SCALERESPONSE
+DESCRIPTOR.message_types_by_name['SetAutoscalingRequest'] = _SETAUTOSCALINGREQUEST
+DESCRIPTOR.message_types_by_name['SetAutoscalingResponse'] = _SETAUTOSCALINGRESPONSE
+DESCRIPTOR.message_types_by_name['DeleteAutoscalingRequest'] = _DELETEAUTOSCALINGREQUEST
+DESCRIPTOR.message_types_by_name['DeleteAutoscalingResponse'] = _DELETEAUTOSCALINGRESPONSE
+DESCRIPTOR.message_types_by_name['WorkloadLogsRequest'] = _WORKLOADLOGSREQUEST
+DESCRIPTOR.message_types_by_name['WorkloadLogsResponse'] = _WORKLOADLOGSRESPONSE
+DESCRIPTOR.message_types_by_name['HistoryRequest'] = _HISTORYREQUEST
+DESCRIPTOR.message_types_by_name['HistoryResponse'] = _HISTORYRESPONSE
 DESCRIPTOR.message_types_by_name['QueryRequest'] = _QUERYREQUEST
+DESCRIPTOR.message_types_by_name['HealthSummary'] = _HEALTHSUMMARY
 DESCRIPTOR.message_types_by_name['QueryResponse'] = _QUERYRESPONSE
 DESCRIPTOR.message_types_by_name['UploadCfgRequest'] = _UPLOADCFGREQUEST
 DESCRIPTOR.message_types_by_name['UploadCfgResponse'] = _UPLOADCFGRESPONSE
@@ -1083,6 +2015,8 @@ DESCRIPTOR.message_types_by_name['RemoveCfgRequest'] = _REMOVECFGREQUEST
 DESCRIPTOR.message_types_by_name['RemoveCfgResponse'] = _REMOVECFGRESPONSE
 DESCRIPTOR.message_types_by_name['WorkloadEventsRequest'] = _WORKLOADEVENTSREQUEST
 DESCRIPTOR.message_types_by_name['WorkloadEventsResponse'] = _WORKLOADEVENTSRESPONSE
+DESCRIPTOR.message_types_by_name['EndpointsRequest'] = _ENDPOINTSREQUEST
+DESCRIPTOR.message_types_by_name['EndpointsResponse'] = _ENDPOINTSRESPONSE
 DESCRIPTOR.message_types_by_name['CreateVmImageRequest'] = _CREATEVMIMAGEREQUEST
 DESCRIPTOR.message_types_by_name['CreateVmImageResponse'] = _CREATEVMIMAGERESPONSE
 DESCRIPTOR.message_types_by_name['QueryVmImageRequest'] = _QUERYVMIMAGEREQUEST
@@ -1095,6 +2029,8 @@ DESCRIPTOR.message_types_by_name['UploadPackageRequest'] = _UPLOADPACKAGEREQUEST
 DESCRIPTOR.message_types_by_name['UploadPackageResponse'] = _UPLOADPACKAGERESPONSE
 DESCRIPTOR.message_types_by_name['DeletePackageRequest'] = _DELETEPACKAGEREQUEST
 DESCRIPTOR.message_types_by_name['DeletePackageResponse'] = _DELETEPACKAGERESPONSE
+DESCRIPTOR.message_types_by_name['GetCapabilitiesRequest'] = _GETCAPABILITIESREQUEST
+DESCRIPTOR.message_types_by_name['GetCapabilitiesResponse'] = _GETCAPABILITIESRESPONSE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 InstantiateRequest = _reflection.GeneratedProtocolMessageType('InstantiateRequest', (_message.Message,), {
@@ -1125,6 +2061,104 @@ TerminateResponse = _reflection.GeneratedProtocolMessageType('TerminateResponse'
   })
 _sym_db.RegisterMessage(TerminateResponse)
 
+UpgradeRequest = _reflection.GeneratedProtocolMessageType('UpgradeRequest', (_message.Message,), {
+  'DESCRIPTOR' : _UPGRADEREQUEST,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.UpgradeRequest)
+  })
+_sym_db.RegisterMessage(UpgradeRequest)
+
+UpgradeResponse = _reflection.GeneratedProtocolMessageType('UpgradeResponse', (_message.Message,), {
+  'DESCRIPTOR' : _UPGRADERESPONSE,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.UpgradeResponse)
+  })
+_sym_db.RegisterMessage(UpgradeResponse)
+
+RollbackRequest = _reflection.GeneratedProtocolMessageType('RollbackRequest', (_message.Message,), {
+  'DESCRIPTOR' : _ROLLBACKREQUEST,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.RollbackRequest)
+  })
+_sym_db.RegisterMessage(RollbackRequest)
+
+RollbackResponse = _reflection.GeneratedProtocolMessageType('RollbackResponse', (_message.Message,), {
+  'DESCRIPTOR' : _ROLLBACKRESPONSE,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.RollbackResponse)
+  })
+_sym_db.RegisterMessage(RollbackResponse)
+
+ScaleRequest = _reflection.GeneratedProtocolMessageType('ScaleRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SCALEREQUEST,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.ScaleRequest)
+  })
+_sym_db.RegisterMessage(ScaleRequest)
+
+ScaleResponse = _reflection.GeneratedProtocolMessageType('ScaleResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SCALERESPONSE,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.ScaleResponse)
+  })
+_sym_db.RegisterMessage(ScaleResponse)
+
+SetAutoscalingRequest = _reflection.GeneratedProtocolMessageType('SetAutoscalingRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SETAUTOSCALINGREQUEST,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.SetAutoscalingRequest)
+  })
+_sym_db.RegisterMessage(SetAutoscalingRequest)
+
+SetAutoscalingResponse = _reflection.GeneratedProtocolMessageType('SetAutoscalingResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SETAUTOSCALINGRESPONSE,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.SetAutoscalingResponse)
+  })
+_sym_db.RegisterMessage(SetAutoscalingResponse)
+
+DeleteAutoscalingRequest = _reflection.GeneratedProtocolMessageType('DeleteAutoscalingRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEAUTOSCALINGREQUEST,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.DeleteAutoscalingRequest)
+  })
+_sym_db.RegisterMessage(DeleteAutoscalingRequest)
+
+DeleteAutoscalingResponse = _reflection.GeneratedProtocolMessageType('DeleteAutoscalingResponse', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEAUTOSCALINGRESPONSE,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.DeleteAutoscalingResponse)
+  })
+_sym_db.RegisterMessage(DeleteAutoscalingResponse)
+
+WorkloadLogsRequest = _reflection.GeneratedProtocolMessageType('WorkloadLogsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _WORKLOADLOGSREQUEST,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.WorkloadLogsRequest)
+  })
+_sym_db.RegisterMessage(WorkloadLogsRequest)
+
+WorkloadLogsResponse = _reflection.GeneratedProtocolMessageType('WorkloadLogsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _WORKLOADLOGSRESPONSE,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.WorkloadLogsResponse)
+  })
+_sym_db.RegisterMessage(WorkloadLogsResponse)
+
+HistoryRequest = _reflection.GeneratedProtocolMessageType('HistoryRequest', (_message.Message,), {
+  'DESCRIPTOR' : _HISTORYREQUEST,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.HistoryRequest)
+  })
+_sym_db.RegisterMessage(HistoryRequest)
+
+HistoryResponse = _reflection.GeneratedProtocolMessageType('HistoryResponse', (_message.Message,), {
+  'DESCRIPTOR' : _HISTORYRESPONSE,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.HistoryResponse)
+  })
+_sym_db.RegisterMessage(HistoryResponse)
+
 QueryRequest = _reflection.GeneratedProtocolMessageType('QueryRequest', (_message.Message,), {
   'DESCRIPTOR' : _QUERYREQUEST,
   '__module__' : 'lcmservice_pb2'
@@ -1132,6 +2166,13 @@ QueryRequest = _reflection.GeneratedProtocolMessageType('QueryRequest', (_messag
   })
 _sym_db.RegisterMessage(QueryRequest)
 
+HealthSummary = _reflection.GeneratedProtocolMessageType('HealthSummary', (_message.Message,), {
+  'DESCRIPTOR' : _HEALTHSUMMARY,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.HealthSummary)
+  })
+_sym_db.RegisterMessage(HealthSummary)
+
 QueryResponse = _reflection.GeneratedProtocolMessageType('QueryResponse', (_message.Message,), {
   'DESCRIPTOR' : _QUERYRESPONSE,
   '__module__' : 'lcmservice_pb2'
@@ -1181,6 +2222,20 @@ WorkloadEventsResponse = _reflection.GeneratedProtocolMessageType('WorkloadEvent
   })
 _sym_db.RegisterMessage(WorkloadEventsResponse)
 
+EndpointsRequest = _reflection.GeneratedProtocolMessageType('EndpointsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _ENDPOINTSREQUEST,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.EndpointsRequest)
+  })
+_sym_db.RegisterMessage(EndpointsRequest)
+
+EndpointsResponse = _reflection.GeneratedProtocolMessageType('EndpointsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _ENDPOINTSRESPONSE,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.EndpointsResponse)
+  })
+_sym_db.RegisterMessage(EndpointsResponse)
+
 CreateVmImageRequest = _reflection.GeneratedProtocolMessageType('CreateVmImageRequest', (_message.Message,), {
   'DESCRIPTOR' : _CREATEVMIMAGEREQUEST,
   '__module__' : 'lcmservice_pb2'
@@ -1265,6 +2320,20 @@ DeletePackageResponse = _reflection.GeneratedProtocolMessageType('DeletePackageR
   })
 _sym_db.RegisterMessage(DeletePackageResponse)
 
+GetCapabilitiesRequest = _reflection.GeneratedProtocolMessageType('GetCapabilitiesRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETCAPABILITIESREQUEST,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.GetCapabilitiesRequest)
+  })
+_sym_db.RegisterMessage(GetCapabilitiesRequest)
+
+GetCapabilitiesResponse = _reflection.GeneratedProtocolMessageType('GetCapabilitiesResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETCAPABILITIESRESPONSE,
+  '__module__' : 'lcmservice_pb2'
+  # @@protoc_insertion_point(class_scope:lcmservice.GetCapabilitiesResponse)
+  })
+_sym_db.RegisterMessage(GetCapabilitiesResponse)
+
 
 
 _APPLCM = _descriptor.ServiceDescriptor(
@@ -1274,8 +2343,8 @@ _APPLCM = _descriptor.ServiceDescriptor(
   index=0,
   serialized_options=None,
   create_key=_descriptor._internal_create_key,
-  serialized_start=1710,
-  serialized_end=2369,
+  serialized_start=3335,
+  serialized_end=4719,
   methods=[
   _descriptor.MethodDescriptor(
     name='instantiate',
@@ -1307,10 +2376,40 @@ _APPLCM = _descriptor.ServiceDescriptor(
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='upgrade',
+    full_name='lcmservice.AppLCM.upgrade',
+    index=3,
+    containing_service=None,
+    input_type=_UPGRADEREQUEST,
+    output_type=_UPGRADERESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='rollback',
+    full_name='lcmservice.AppLCM.rollback',
+    index=4,
+    containing_service=None,
+    input_type=_ROLLBACKREQUEST,
+    output_type=_ROLLBACKRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='history',
+    full_name='lcmservice.AppLCM.history',
+    index=5,
+    containing_service=None,
+    input_type=_HISTORYREQUEST,
+    output_type=_HISTORYRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
   _descriptor.MethodDescriptor(
     name='uploadConfig',
     full_name='lcmservice.AppLCM.uploadConfig',
-    index=3,
+    index=6,
     containing_service=None,
     input_type=_UPLOADCFGREQUEST,
     output_type=_UPLOADCFGRESPONSE,
@@ -1320,7 +2419,7 @@ _APPLCM = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='removeConfig',
     full_name='lcmservice.AppLCM.removeConfig',
-    index=4,
+    index=7,
     containing_service=None,
     input_type=_REMOVECFGREQUEST,
     output_type=_REMOVECFGRESPONSE,
@@ -1330,7 +2429,7 @@ _APPLCM = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='workloadEvents',
     full_name='lcmservice.AppLCM.workloadEvents',
-    index=5,
+    index=8,
     containing_service=None,
     input_type=_WORKLOADEVENTSREQUEST,
     output_type=_WORKLOADEVENTSRESPONSE,
@@ -1340,7 +2439,7 @@ _APPLCM = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='uploadPackage',
     full_name='lcmservice.AppLCM.uploadPackage',
-    index=6,
+    index=9,
     containing_service=None,
     input_type=_UPLOADPACKAGEREQUEST,
     output_type=_UPLOADPACKAGERESPONSE,
@@ -1350,13 +2449,73 @@ _APPLCM = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='deletePackage',
     full_name='lcmservice.AppLCM.deletePackage',
-    index=7,
+    index=10,
     containing_service=None,
     input_type=_DELETEPACKAGEREQUEST,
     output_type=_DELETEPACKAGERESPONSE,
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='getCapabilities',
+    full_name='lcmservice.AppLCM.getCapabilities',
+    index=11,
+    containing_service=None,
+    input_type=_GETCAPABILITIESREQUEST,
+    output_type=_GETCAPABILITIESRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='scale',
+    full_name='lcmservice.AppLCM.scale',
+    index=12,
+    containing_service=None,
+    input_type=_SCALEREQUEST,
+    output_type=_SCALERESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='setAutoscaling',
+    full_name='lcmservice.AppLCM.setAutoscaling',
+    index=13,
+    containing_service=None,
+    input_type=_SETAUTOSCALINGREQUEST,
+    output_type=_SETAUTOSCALINGRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='deleteAutoscaling',
+    full_name='lcmservice.AppLCM.deleteAutoscaling',
+    index=14,
+    containing_service=None,
+    input_type=_DELETEAUTOSCALINGREQUEST,
+    output_type=_DELETEAUTOSCALINGRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='workloadLogs',
+    full_name='lcmservice.AppLCM.workloadLogs',
+    index=15,
+    containing_service=None,
+    input_type=_WORKLOADLOGSREQUEST,
+    output_type=_WORKLOADLOGSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='endpoints',
+    full_name='lcmservice.AppLCM.endpoints',
+    index=16,
+    containing_service=None,
+    input_type=_ENDPOINTSREQUEST,
+    output_type=_ENDPOINTSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_APPLCM)
 
@@ -1370,8 +2529,8 @@ _VMIMAGE = _descriptor.ServiceDescriptor(
   index=1,
   serialized_options=None,
   create_key=_descriptor._internal_create_key,
-  serialized_start=2372,
-  serialized_end=2738,
+  serialized_start=4722,
+  serialized_end=5088,
   methods=[
   _descriptor.MethodDescriptor(
     name='createVmImage',
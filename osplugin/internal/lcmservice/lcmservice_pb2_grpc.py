@@ -29,6 +29,21 @@ class AppLCMStub(object):
                 request_serializer=lcmservice__pb2.QueryRequest.SerializeToString,
                 response_deserializer=lcmservice__pb2.QueryResponse.FromString,
                 )
+        self.upgrade = channel.unary_unary(
+                '/lcmservice.AppLCM/upgrade',
+                request_serializer=lcmservice__pb2.UpgradeRequest.SerializeToString,
+                response_deserializer=lcmservice__pb2.UpgradeResponse.FromString,
+                )
+        self.rollback = channel.unary_unary(
+                '/lcmservice.AppLCM/rollback',
+                request_serializer=lcmservice__pb2.RollbackRequest.SerializeToString,
+                response_deserializer=lcmservice__pb2.RollbackResponse.FromString,
+                )
+        self.history = channel.unary_unary(
+                '/lcmservice.AppLCM/history',
+                request_serializer=lcmservice__pb2.HistoryRequest.SerializeToString,
+                response_deserializer=lcmservice__pb2.HistoryResponse.FromString,
+                )
         self.uploadConfig = channel.stream_unary(
                 '/lcmservice.AppLCM/uploadConfig',
                 request_serializer=lcmservice__pb2.UploadCfgRequest.SerializeToString,
@@ -54,6 +69,36 @@ class AppLCMStub(object):
                 request_serializer=lcmservice__pb2.DeletePackageRequest.SerializeToString,
                 response_deserializer=lcmservice__pb2.DeletePackageResponse.FromString,
                 )
+        self.getCapabilities = channel.unary_unary(
+                '/lcmservice.AppLCM/getCapabilities',
+                request_serializer=lcmservice__pb2.GetCapabilitiesRequest.SerializeToString,
+                response_deserializer=lcmservice__pb2.GetCapabilitiesResponse.FromString,
+                )
+        self.scale = channel.unary_unary(
+                '/lcmservice.AppLCM/scale',
+                request_serializer=lcmservice__pb2.ScaleRequest.SerializeToString,
+                response_deserializer=lcmservice__pb2.ScaleResponse.FromString,
+                )
+        self.setAutoscaling = channel.unary_unary(
+                '/lcmservice.AppLCM/setAutoscaling',
+                request_serializer=lcmservice__pb2.SetAutoscalingRequest.SerializeToString,
+                response_deserializer=lcmservice__pb2.SetAutoscalingResponse.FromString,
+                )
+        self.deleteAutoscaling = channel.unary_unary(
+                '/lcmservice.AppLCM/deleteAutoscaling',
+                request_serializer=lcmservice__pb2.DeleteAutoscalingRequest.SerializeToString,
+                response_deserializer=lcmservice__pb2.DeleteAutoscalingResponse.FromString,
+                )
+        self.workloadLogs = channel.unary_stream(
+                '/lcmservice.AppLCM/workloadLogs',
+                request_serializer=lcmservice__pb2.WorkloadLogsRequest.SerializeToString,
+                response_deserializer=lcmservice__pb2.WorkloadLogsResponse.FromString,
+                )
+        self.endpoints = channel.unary_unary(
+                '/lcmservice.AppLCM/endpoints',
+                request_serializer=lcmservice__pb2.EndpointsRequest.SerializeToString,
+                response_deserializer=lcmservice__pb2.EndpointsResponse.FromString,
+                )
 
 
 class AppLCMServicer(object):
@@ -77,6 +122,24 @@ class AppLCMServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def upgrade(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def rollback(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def history(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def uploadConfig(self, request_iterator, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -107,6 +170,42 @@ class AppLCMServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def getCapabilities(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def scale(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def setAutoscaling(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def deleteAutoscaling(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def workloadLogs(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def endpoints(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_AppLCMServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -125,6 +224,21 @@ def add_AppLCMServicer_to_server(servicer, server):
                     request_deserializer=lcmservice__pb2.QueryRequest.FromString,
                     response_serializer=lcmservice__pb2.QueryResponse.SerializeToString,
             ),
+            'upgrade': grpc.unary_unary_rpc_method_handler(
+                    servicer.upgrade,
+                    request_deserializer=lcmservice__pb2.UpgradeRequest.FromString,
+                    response_serializer=lcmservice__pb2.UpgradeResponse.SerializeToString,
+            ),
+            'rollback': grpc.unary_unary_rpc_method_handler(
+                    servicer.rollback,
+                    request_deserializer=lcmservice__pb2.RollbackRequest.FromString,
+                    response_serializer=lcmservice__pb2.RollbackResponse.SerializeToString,
+            ),
+            'history': grpc.unary_unary_rpc_method_handler(
+                    servicer.history,
+                    request_deserializer=lcmservice__pb2.HistoryRequest.FromString,
+                    response_serializer=lcmservice__pb2.HistoryResponse.SerializeToString,
+            ),
             'uploadConfig': grpc.stream_unary_rpc_method_handler(
                     servicer.uploadConfig,
                     request_deserializer=lcmservice__pb2.UploadCfgRequest.FromString,
@@ -150,6 +264,36 @@ def add_AppLCMServicer_to_server(servicer, server):
                     request_deserializer=lcmservice__pb2.DeletePackageRequest.FromString,
                     response_serializer=lcmservice__pb2.DeletePackageResponse.SerializeToString,
             ),
+            'getCapabilities': grpc.unary_unary_rpc_method_handler(
+                    servicer.getCapabilities,
+                    request_deserializer=lcmservice__pb2.GetCapabilitiesRequest.FromString,
+                    response_serializer=lcmservice__pb2.GetCapabilitiesResponse.SerializeToString,
+            ),
+            'scale': grpc.unary_unary_rpc_method_handler(
+                    servicer.scale,
+                    request_deserializer=lcmservice__pb2.ScaleRequest.FromString,
+                    response_serializer=lcmservice__pb2.ScaleResponse.SerializeToString,
+            ),
+            'setAutoscaling': grpc.unary_unary_rpc_method_handler(
+                    servicer.setAutoscaling,
+                    request_deserializer=lcmservice__pb2.SetAutoscalingRequest.FromString,
+                    response_serializer=lcmservice__pb2.SetAutoscalingResponse.SerializeToString,
+            ),
+            'deleteAutoscaling': grpc.unary_unary_rpc_method_handler(
+                    servicer.deleteAutoscaling,
+                    request_deserializer=lcmservice__pb2.DeleteAutoscalingRequest.FromString,
+                    response_serializer=lcmservice__pb2.DeleteAutoscalingResponse.SerializeToString,
+            ),
+            'workloadLogs': grpc.unary_stream_rpc_method_handler(
+                    servicer.workloadLogs,
+                    request_deserializer=lcmservice__pb2.WorkloadLogsRequest.FromString,
+                    response_serializer=lcmservice__pb2.WorkloadLogsResponse.SerializeToString,
+            ),
+            'endpoints': grpc.unary_unary_rpc_method_handler(
+                    servicer.endpoints,
+                    request_deserializer=lcmservice__pb2.EndpointsRequest.FromString,
+                    response_serializer=lcmservice__pb2.EndpointsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'lcmservice.AppLCM', rpc_method_handlers)
@@ -211,6 +355,57 @@ class AppLCM(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def upgrade(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/lcmservice.AppLCM/upgrade',
+            lcmservice__pb2.UpgradeRequest.SerializeToString,
+            lcmservice__pb2.UpgradeResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def rollback(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/lcmservice.AppLCM/rollback',
+            lcmservice__pb2.RollbackRequest.SerializeToString,
+            lcmservice__pb2.RollbackResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def history(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/lcmservice.AppLCM/history',
+            lcmservice__pb2.HistoryRequest.SerializeToString,
+            lcmservice__pb2.HistoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def uploadConfig(request_iterator,
             target,
@@ -296,6 +491,108 @@ class AppLCM(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def getCapabilities(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/lcmservice.AppLCM/getCapabilities',
+            lcmservice__pb2.GetCapabilitiesRequest.SerializeToString,
+            lcmservice__pb2.GetCapabilitiesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def scale(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/lcmservice.AppLCM/scale',
+            lcmservice__pb2.ScaleRequest.SerializeToString,
+            lcmservice__pb2.ScaleResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def setAutoscaling(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/lcmservice.AppLCM/setAutoscaling',
+            lcmservice__pb2.SetAutoscalingRequest.SerializeToString,
+            lcmservice__pb2.SetAutoscalingResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def deleteAutoscaling(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/lcmservice.AppLCM/deleteAutoscaling',
+            lcmservice__pb2.DeleteAutoscalingRequest.SerializeToString,
+            lcmservice__pb2.DeleteAutoscalingResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def workloadLogs(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/lcmservice.AppLCM/workloadLogs',
+            lcmservice__pb2.WorkloadLogsRequest.SerializeToString,
+            lcmservice__pb2.WorkloadLogsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def endpoints(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/lcmservice.AppLCM/endpoints',
+            lcmservice__pb2.EndpointsRequest.SerializeToString,
+            lcmservice__pb2.EndpointsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
 
 class VmImageStub(object):
     """Missing associated documentation comment in .proto file."""
@@ -168,7 +168,8 @@ class VmImageService(lcmservice_pb2_grpc.VmImageServicer):
             "appInstanceId": vm_info.app_instance_id,
             "status": image_info.status,
             "sumChunkNum": get_chunk_num(size=image_info.size, chunk_size=int(config.chunk_size)),
-            "chunkSize": config.chunk_size
+            "chunkSize": config.chunk_size,
+            "imageSize": image_info.size
         })
 
         return res